@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// BatchCache 支持批量读写的缓存，减少批量同步数据时的网络往返
+type BatchCache interface {
+	Cache
+	// MGet 批量获取，返回结果中不包含不存在的 key
+	MGet(ctx context.Context, keys []string) (map[string][]byte, error)
+	// MSet 批量设置，所有 key 使用相同的过期时间
+	MSet(ctx context.Context, entries map[string][]byte, ttl time.Duration) error
+}
+
+// MGet 批量获取，cache 未实现 BatchCache 时逐个获取
+// 逐个获取时仅返回 []byte 及 string 类型的值
+func MGet(ctx context.Context, cache Cache, keys []string) (map[string][]byte, error) {
+	if cache, ok := cache.(BatchCache); ok {
+		return cache.MGet(ctx, keys)
+	}
+	result := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if val, ok := toBytes(GetContext(ctx, cache, key)); ok {
+			result[key] = val
+		}
+	}
+	return result, nil
+}
+
+// MSet 批量设置，cache 未实现 BatchCache 时逐个设置
+func MSet(ctx context.Context, cache Cache, entries map[string][]byte, ttl time.Duration) error {
+	if cache, ok := cache.(BatchCache); ok {
+		return cache.MSet(ctx, entries, ttl)
+	}
+	for key, val := range entries {
+		if err := SetContext(ctx, cache, key, val, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toBytes 将缓存中的值转换为 []byte
+func toBytes(val interface{}) ([]byte, bool) {
+	switch v := val.(type) {
+	case []byte:
+		return v, true
+	case string:
+		return []byte(v), true
+	}
+	return nil, false
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// plainCache 仅实现 Cache 接口，用于测试逐个读写的回退逻辑
+type plainCache struct {
+	Cache
+}
+
+func TestBatchCache(t *testing.T) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatal("miniredis.Run Error", err)
+	}
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	for name, c := range map[string]Cache{
+		"memory": NewMemory(),
+		"redis":  NewRedis(ctx, &RedisOpts{Host: server.Addr()}),
+		"plain":  plainCache{NewMemory()},
+	} {
+		entries := map[string][]byte{
+			"session_key_openid1": []byte("key1"),
+			"session_key_openid2": []byte("key2"),
+		}
+		assert.Nil(t, MSet(ctx, c, entries, time.Minute), name)
+
+		result, err := MGet(ctx, c, []string{"session_key_openid1", "session_key_openid2", "session_key_openid3"})
+		assert.Nil(t, err, name)
+		assert.Equal(t, entries, result, name)
+
+		result, err = MGet(ctx, c, nil)
+		assert.Nil(t, err, name)
+		assert.Len(t, result, 0, name)
+	}
+}
+
+func TestMemoryMGetExpired(t *testing.T) {
+	mem := NewMemory()
+	assert.Nil(t, mem.MSet(context.Background(), map[string][]byte{"key": []byte("val")}, -time.Second))
+	result, err := mem.MGet(context.Background(), []string{"key"})
+	assert.Nil(t, err)
+	assert.Len(t, result, 0)
+	assert.False(t, mem.IsExist("key"))
+}
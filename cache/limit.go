@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrValueTooLarge 写入的值超过 SizeLimitCache 的大小限制，可通过 errors.Is 判断
+var ErrValueTooLarge = errors.New("cache value too large")
+
+// SizeLimitCache 限制写入值大小的缓存包装，避免异常的大数据（如错误的证书内容）占满内存或缓存服务
+type SizeLimitCache struct {
+	Cache
+	maxValueSize int
+}
+
+// NewSizeLimitCache 包装 cache，写入大小超过 maxValueSize 字节的值时返回 ErrValueTooLarge，maxValueSize 不大于 0 时不限制
+func NewSizeLimitCache(cache Cache, maxValueSize int) *SizeLimitCache {
+	return &SizeLimitCache{Cache: cache, maxValueSize: maxValueSize}
+}
+
+// Set 设置缓存，值超过大小限制时返回 ErrValueTooLarge
+func (c *SizeLimitCache) Set(key string, val interface{}, timeout time.Duration) error {
+	if err := c.checkSize(key, val); err != nil {
+		return err
+	}
+	return c.Cache.Set(key, val, timeout)
+}
+
+// GetContext 获取缓存
+func (c *SizeLimitCache) GetContext(ctx context.Context, key string) interface{} {
+	return GetContext(ctx, c.Cache, key)
+}
+
+// SetContext 设置缓存，值超过大小限制时返回 ErrValueTooLarge
+func (c *SizeLimitCache) SetContext(ctx context.Context, key string, val interface{}, timeout time.Duration) error {
+	if err := c.checkSize(key, val); err != nil {
+		return err
+	}
+	return SetContext(ctx, c.Cache, key, val, timeout)
+}
+
+// IsExistContext 判断缓存是否存在
+func (c *SizeLimitCache) IsExistContext(ctx context.Context, key string) bool {
+	return IsExistContext(ctx, c.Cache, key)
+}
+
+// DeleteContext 删除缓存
+func (c *SizeLimitCache) DeleteContext(ctx context.Context, key string) error {
+	return DeleteContext(ctx, c.Cache, key)
+}
+
+// MGet 批量获取
+func (c *SizeLimitCache) MGet(ctx context.Context, keys []string) (map[string][]byte, error) {
+	return MGet(ctx, c.Cache, keys)
+}
+
+// MSet 批量设置，任一值超过大小限制时不写入任何值并返回 ErrValueTooLarge
+func (c *SizeLimitCache) MSet(ctx context.Context, entries map[string][]byte, ttl time.Duration) error {
+	for key, val := range entries {
+		if err := c.checkSize(key, val); err != nil {
+			return err
+		}
+	}
+	return MSet(ctx, c.Cache, entries, ttl)
+}
+
+// checkSize 检查值的大小，[]byte 及 string 以长度计算，其他类型以 json 序列化后的长度计算
+func (c *SizeLimitCache) checkSize(key string, val interface{}) error {
+	if c.maxValueSize <= 0 {
+		return nil
+	}
+	size := 0
+	if data, ok := toBytes(val); ok {
+		size = len(data)
+	} else if data, err := json.Marshal(val); err == nil {
+		size = len(data)
+	}
+	if size > c.maxValueSize {
+		return fmt.Errorf("%w: key=%s, size=%d, limit=%d", ErrValueTooLarge, key, size, c.maxValueSize)
+	}
+	return nil
+}
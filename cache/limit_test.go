@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSizeLimitCache(t *testing.T) {
+	c := NewSizeLimitCache(NewMemory(), 8)
+
+	// 未超过限制
+	assert.NoError(t, c.Set("small", "12345678", time.Minute))
+	assert.Equal(t, "12345678", c.Get("small"))
+	assert.NoError(t, SetContext(context.Background(), c, "struct", struct{ A int }{1}, time.Minute))
+
+	// 超过限制
+	err := c.Set("large", strings.Repeat("x", 9), time.Minute)
+	assert.True(t, errors.Is(err, ErrValueTooLarge))
+	assert.EqualError(t, err, "cache value too large: key=large, size=9, limit=8")
+	assert.False(t, c.IsExist("large"))
+
+	err = SetContext(context.Background(), c, "large", []byte(strings.Repeat("x", 9)), time.Minute)
+	assert.True(t, errors.Is(err, ErrValueTooLarge))
+
+	err = MSet(context.Background(), c, map[string][]byte{"a": []byte("1"), "b": []byte(strings.Repeat("x", 9))}, time.Minute)
+	assert.True(t, errors.Is(err, ErrValueTooLarge))
+	assert.False(t, c.IsExist("a"))
+
+	// 不限制
+	assert.NoError(t, NewSizeLimitCache(NewMemory(), 0).Set("large", strings.Repeat("x", 9), time.Minute))
+}
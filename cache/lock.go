@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Lock 分布式锁，用于多实例部署时互斥刷新 access_token 等凭证，可自行实现 etcd、Consul 等后端
+type Lock interface {
+	// Acquire 尝试获取锁，不阻塞；获取成功时 acquired 为 true，使用完毕后调用 release 释放
+	// ttl 为锁的最长持有时间，持有者异常退出时锁会在 ttl 后自动失效
+	Acquire(ctx context.Context, key string, ttl time.Duration) (release func(), acquired bool, err error)
+}
+
+// MemoryLock 进程内锁
+type MemoryLock struct {
+	mu    sync.Mutex
+	locks map[string]memoryLockItem
+}
+
+type memoryLockItem struct {
+	token    string
+	expireAt time.Time
+}
+
+// NewMemoryLock 实例化进程内锁
+func NewMemoryLock() *MemoryLock {
+	return &MemoryLock{locks: make(map[string]memoryLockItem)}
+}
+
+// Acquire 尝试获取锁
+func (l *MemoryLock) Acquire(_ context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	token, err := newLockToken()
+	if err != nil {
+		return nil, false, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if item, ok := l.locks[key]; ok && time.Now().Before(item.expireAt) {
+		return nil, false, nil
+	}
+	l.locks[key] = memoryLockItem{token: token, expireAt: time.Now().Add(ttl)}
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if item, ok := l.locks[key]; ok && item.token == token {
+			delete(l.locks, key)
+		}
+	}, true, nil
+}
+
+// releaseScript 仅在锁仍由自己持有时删除
+var releaseScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`)
+
+// RedisLock 基于 redis SET NX 的分布式锁
+type RedisLock struct {
+	conn redis.UniversalClient
+}
+
+// NewRedisLock 使用 Redis 缓存的连接实例化分布式锁
+func NewRedisLock(r *Redis) *RedisLock {
+	return &RedisLock{conn: r.conn}
+}
+
+// Acquire 尝试获取锁
+func (l *RedisLock) Acquire(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	token, err := newLockToken()
+	if err != nil {
+		return nil, false, err
+	}
+	acquired, err := l.conn.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !acquired {
+		return nil, false, err
+	}
+	return func() {
+		// 使用独立的 context，避免调用方 context 取消后锁无法释放
+		releaseScript.Run(context.Background(), l.conn, []string{key}, token)
+	}, true, nil
+}
+
+// newLockToken 生成锁持有者标识
+func newLockToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
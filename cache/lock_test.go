@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testLockMutualExclusion(t *testing.T, lock Lock) {
+	ctx := context.Background()
+	release, acquired, err := lock.Acquire(ctx, "mock_lock", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	_, acquired, err = lock.Acquire(ctx, "mock_lock", time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, acquired)
+
+	release()
+	release2, acquired, err := lock.Acquire(ctx, "mock_lock", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+	// 重复释放旧锁不影响新的持有者
+	release()
+	_, acquired, _ = lock.Acquire(ctx, "mock_lock", time.Minute)
+	assert.False(t, acquired)
+	release2()
+}
+
+func TestMemoryLock(t *testing.T) {
+	lock := NewMemoryLock()
+	testLockMutualExclusion(t, lock)
+
+	// 并发获取时只有一个成功
+	var (
+		wg       sync.WaitGroup
+		acquired int32
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, ok, _ := lock.Acquire(context.Background(), "concurrent_lock", time.Minute); ok {
+				atomic.AddInt32(&acquired, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), acquired)
+
+	// 超过 ttl 后锁自动失效
+	_, ok, _ := lock.Acquire(context.Background(), "expire_lock", time.Millisecond)
+	assert.True(t, ok)
+	time.Sleep(5 * time.Millisecond)
+	_, ok, _ = lock.Acquire(context.Background(), "expire_lock", time.Minute)
+	assert.True(t, ok)
+}
+
+func TestRedisLock(t *testing.T) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	testLockMutualExclusion(t, NewRedisLock(NewRedis(context.Background(), &RedisOpts{Host: server.Addr()})))
+}
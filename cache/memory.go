@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -72,3 +73,41 @@ func (mem *Memory) deleteKey(key string) {
 	defer mem.Unlock()
 	delete(mem.data, key)
 }
+
+// MGet 批量获取
+func (mem *Memory) MGet(_ context.Context, keys []string) (map[string][]byte, error) {
+	mem.Lock()
+	defer mem.Unlock()
+
+	now := time.Now()
+	result := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		ret, ok := mem.data[key]
+		if !ok {
+			continue
+		}
+		if ret.Expired.Before(now) {
+			delete(mem.data, key)
+			continue
+		}
+		if val, ok := toBytes(ret.Data); ok {
+			result[key] = val
+		}
+	}
+	return result, nil
+}
+
+// MSet 批量设置
+func (mem *Memory) MSet(_ context.Context, entries map[string][]byte, ttl time.Duration) error {
+	mem.Lock()
+	defer mem.Unlock()
+
+	expired := time.Now().Add(ttl)
+	for key, val := range entries {
+		mem.data[key] = &data{
+			Data:    val,
+			Expired: expired,
+		}
+	}
+	return nil
+}
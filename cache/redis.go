@@ -107,3 +107,34 @@ func (r *Redis) Delete(key string) error {
 func (r *Redis) DeleteContext(ctx context.Context, key string) error {
 	return r.conn.Del(ctx, key).Err()
 }
+
+// MGet 批量获取
+func (r *Redis) MGet(ctx context.Context, keys []string) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(keys))
+	if len(keys) == 0 {
+		return result, nil
+	}
+	values, err := r.conn.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, val := range values {
+		if val, ok := toBytes(val); ok {
+			result[keys[i]] = val
+		}
+	}
+	return result, nil
+}
+
+// MSet 批量设置，通过 pipeline 一次往返设置所有 key 及过期时间
+func (r *Redis) MSet(ctx context.Context, entries map[string][]byte, ttl time.Duration) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	pipe := r.conn.Pipeline()
+	for key, val := range entries {
+		pipe.SetEX(ctx, key, val, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
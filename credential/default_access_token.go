@@ -3,7 +3,9 @@ package credential
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -26,29 +28,82 @@ const (
 	CacheKeyWorkPrefix = "gowechat_work_"
 )
 
+// ErrTokenNotCached 缓存中没有 access_token
+var ErrTokenNotCached = errors.New("access_token not cached")
+
 // DefaultAccessToken 默认AccessToken 获取
 type DefaultAccessToken struct {
 	appID           string
-	appSecret       string
+	secretProvider  SecretProvider
 	cacheKeyPrefix  string
 	cache           cache.Cache
 	accessTokenLock *sync.Mutex
+	lock            cache.Lock
+
+	minRefreshInterval time.Duration
+	refreshHook        TokenRefreshHook
+
+	shutdown *shutdownSignal
+}
+
+// TokenLocker 支持设置分布式锁的 access_token 获取方式
+type TokenLocker interface {
+	SetLock(lock cache.Lock)
+}
+
+var (
+	// tokenLockTTL 刷新 access_token 时分布式锁的最长持有时间
+	tokenLockTTL = 10 * time.Second
+	// tokenLockPollInterval 未获取到分布式锁时，轮询缓存等待其他实例刷新的间隔
+	tokenLockPollInterval = 50 * time.Millisecond
+)
+
+// defaultMinRefreshInterval 默认两次从微信服务器刷新 access_token 的最小间隔
+const defaultMinRefreshInterval = time.Second
+
+// SetLock 设置分布式锁，多实例共享缓存时保证同一时间只有一个实例从微信服务器刷新 access_token
+func (ak *DefaultAccessToken) SetLock(lock cache.Lock) {
+	ak.lock = lock
+}
+
+// SetMinRefreshInterval 设置两次从微信服务器刷新 access_token 的最小间隔，默认 1s，小于等于 0 时不限制
+// 间隔内的刷新直接返回缓存中的 access_token，避免异常的调用方反复强制刷新耗尽每日调用次数
+func (ak *DefaultAccessToken) SetMinRefreshInterval(interval time.Duration) {
+	ak.minRefreshInterval = interval
+}
+
+// SetRefreshHook 设置刷新回调，每次从微信服务器刷新 access_token 后调用
+func (ak *DefaultAccessToken) SetRefreshHook(hook TokenRefreshHook) {
+	ak.refreshHook = hook
 }
 
 // NewDefaultAccessToken new DefaultAccessToken
 func NewDefaultAccessToken(appID, appSecret, cacheKeyPrefix string, cache cache.Cache) AccessTokenContextHandle {
+	return NewDefaultAccessTokenWithSecretProvider(appID, StaticSecret(appSecret), cacheKeyPrefix, cache)
+}
+
+// NewDefaultAccessTokenWithSecretProvider new DefaultAccessToken，刷新 access_token 时通过 secretProvider 获取当前 appSecret
+func NewDefaultAccessTokenWithSecretProvider(appID string, secretProvider SecretProvider, cacheKeyPrefix string, cache cache.Cache) AccessTokenContextHandle {
 	if cache == nil {
 		panic("cache is ineed")
 	}
 	return &DefaultAccessToken{
 		appID:           appID,
-		appSecret:       appSecret,
+		secretProvider:  secretProvider,
 		cache:           cache,
 		cacheKeyPrefix:  cacheKeyPrefix,
 		accessTokenLock: new(sync.Mutex),
+
+		minRefreshInterval: defaultMinRefreshInterval,
+		shutdown:           newShutdownSignal(),
 	}
 }
 
+// Close 中止正在进行的刷新并拒绝之后的刷新，用于服务优雅退出，缓存中的 access_token 仍可获取
+func (ak *DefaultAccessToken) Close() error {
+	return ak.shutdown.close()
+}
+
 // ResAccessToken struct
 type ResAccessToken struct {
 	util.CommonError
@@ -65,7 +120,7 @@ func (ak *DefaultAccessToken) GetAccessToken() (accessToken string, err error) {
 // GetAccessTokenContext 获取access_token,先从cache中获取，没有则从服务端获取
 func (ak *DefaultAccessToken) GetAccessTokenContext(ctx context.Context) (accessToken string, err error) {
 	// 先从cache中取
-	accessTokenCacheKey := fmt.Sprintf("%s_access_token_%s", ak.cacheKeyPrefix, ak.appID)
+	accessTokenCacheKey := ak.cacheKey()
 
 	if val := ak.cache.Get(accessTokenCacheKey); val != nil {
 		if accessToken = val.(string); accessToken != "" {
@@ -84,44 +139,184 @@ func (ak *DefaultAccessToken) GetAccessTokenContext(ctx context.Context) (access
 		}
 	}
 
+	ctx, cancel := ak.shutdown.withShutdown(ctx)
+	defer cancel()
+
+	var release func()
+	if accessToken, release, err = lockForRefresh(ctx, ak.lock, ak.cache, accessTokenCacheKey); err != nil || accessToken != "" {
+		return
+	}
+	defer release()
+
 	// cache失效，从微信服务器获取
+	return ak.refresh(ctx, accessTokenCacheKey)
+}
+
+// RefreshAccessToken 强制从微信服务器刷新 access_token
+// 距上次刷新不足最小刷新间隔时不请求微信服务器，直接返回缓存中的 access_token
+func (ak *DefaultAccessToken) RefreshAccessToken(ctx context.Context) (accessToken string, err error) {
+	ak.accessTokenLock.Lock()
+	defer ak.accessTokenLock.Unlock()
+
+	ctx, cancel := ak.shutdown.withShutdown(ctx)
+	defer cancel()
+	return ak.refresh(ctx, ak.cacheKey())
+}
+
+// refresh 从微信服务器获取 access_token 并写入缓存，调用方需持有 accessTokenLock
+func (ak *DefaultAccessToken) refresh(ctx context.Context, accessTokenCacheKey string) (accessToken string, err error) {
+	refreshedAtKey := accessTokenCacheKey + "_refreshed_at"
+	if ak.minRefreshInterval > 0 {
+		if val, ok := ak.cache.Get(refreshedAtKey).(string); ok {
+			if unixNano, parseErr := strconv.ParseInt(val, 10, 64); parseErr == nil && time.Since(time.Unix(0, unixNano)) < ak.minRefreshInterval {
+				if val, ok := ak.cache.Get(accessTokenCacheKey).(string); ok && val != "" {
+					return val, nil
+				}
+			}
+		}
+	}
+
 	var resAccessToken ResAccessToken
-	if resAccessToken, err = GetTokenFromServerContext(ctx, fmt.Sprintf(accessTokenURL, ak.appID, ak.appSecret)); err != nil {
+	resAccessToken, err = GetTokenFromServerContext(ctx, fmt.Sprintf(accessTokenURL, ak.appID, ak.secretProvider()))
+	notifyRefresh(ak.refreshHook, ak.appID, resAccessToken, err)
+	if err != nil {
 		return
 	}
 
-	expires := resAccessToken.ExpiresIn - 1500
-	err = ak.cache.Set(accessTokenCacheKey, resAccessToken.AccessToken, time.Duration(expires)*time.Second)
+	now := time.Now()
+	expires := time.Duration(resAccessToken.ExpiresIn-1500) * time.Second
+	if err = ak.cache.Set(accessTokenCacheKey, resAccessToken.AccessToken, expires); err != nil {
+		return
+	}
+	if err = ak.cache.Set(accessTokenCacheKey+"_expires_at", strconv.FormatInt(now.Add(expires).Unix(), 10), expires); err != nil {
+		return
+	}
+	if ak.minRefreshInterval > 0 {
+		if err = ak.cache.Set(refreshedAtKey, strconv.FormatInt(now.UnixNano(), 10), ak.minRefreshInterval); err != nil {
+			return
+		}
+	}
 
 	accessToken = resAccessToken.AccessToken
 	return
 }
 
+// lockForRefresh 使用分布式锁保证同一时间只有一个实例刷新 access_token，lock 为 nil 时不加锁
+// 其他实例已完成刷新时返回缓存中的 accessToken，无需刷新；否则调用方刷新后需调用 release 释放锁
+func lockForRefresh(ctx context.Context, lock cache.Lock, c cache.Cache, accessTokenCacheKey string) (accessToken string, release func(), err error) {
+	release = func() {}
+	if lock == nil {
+		return
+	}
+	unlock, acquired, err := lock.Acquire(ctx, accessTokenCacheKey+"_lock", tokenLockTTL)
+	if err != nil {
+		return
+	}
+	if !acquired {
+		// 其他实例正在刷新，等待其写入缓存，超时仍未写入时不加锁刷新
+		accessToken, err = waitForToken(ctx, c, accessTokenCacheKey)
+		return
+	}
+	// 获取锁期间其他实例可能已完成刷新
+	if val, ok := c.Get(accessTokenCacheKey).(string); ok && val != "" {
+		unlock()
+		return val, release, nil
+	}
+	return "", unlock, nil
+}
+
+// waitForToken 等待持有锁的实例将 access_token 写入缓存，超过 tokenLockTTL 仍未写入时返回空字符串
+func waitForToken(ctx context.Context, c cache.Cache, accessTokenCacheKey string) (string, error) {
+	ticker := time.NewTicker(tokenLockPollInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(tokenLockTTL)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-timeout.C:
+			return "", nil
+		case <-ticker.C:
+			if val, ok := c.Get(accessTokenCacheKey).(string); ok && val != "" {
+				return val, nil
+			}
+		}
+	}
+}
+
+// cacheKey access_token 缓存的 key
+func (ak *DefaultAccessToken) cacheKey() string {
+	return fmt.Sprintf("%s_access_token_%s", ak.cacheKeyPrefix, ak.appID)
+}
+
+// TokenInfo 返回缓存中的 access_token 及其过期时间，不会触发刷新
+// 缓存中没有 access_token 时返回 ErrTokenNotCached；未记录过期时间（如由旧版本写入）时 expiresAt 为零值
+func (ak *DefaultAccessToken) TokenInfo(_ context.Context) (token string, expiresAt time.Time, err error) {
+	accessTokenCacheKey := ak.cacheKey()
+	if val, ok := ak.cache.Get(accessTokenCacheKey).(string); ok {
+		token = val
+	}
+	if token == "" {
+		return "", time.Time{}, ErrTokenNotCached
+	}
+	if val, ok := ak.cache.Get(accessTokenCacheKey + "_expires_at").(string); ok {
+		if unix, parseErr := strconv.ParseInt(val, 10, 64); parseErr == nil {
+			expiresAt = time.Unix(unix, 0)
+		}
+	}
+	return
+}
+
 // StableAccessToken 获取稳定版接口调用凭据(与getAccessToken获取的调用凭证完全隔离，互不影响)
 // 不强制更新access_token,可用于不同环境不同服务而不需要分布式锁以及公用缓存，避免access_token争抢
 // https://developers.weixin.qq.com/miniprogram/dev/OpenApiDoc/mp-access-token/getStableAccessToken.html
 type StableAccessToken struct {
 	appID           string
-	appSecret       string
+	secretProvider  SecretProvider
 	cacheKeyPrefix  string
 	cache           cache.Cache
 	accessTokenLock *sync.Mutex
+	lock            cache.Lock
+	refreshHook     TokenRefreshHook
+	shutdown        *shutdownSignal
+}
+
+// SetLock 设置分布式锁，多实例共享缓存时保证同一时间只有一个实例从微信服务器获取稳定版 access_token
+func (ak *StableAccessToken) SetLock(lock cache.Lock) {
+	ak.lock = lock
+}
+
+// SetRefreshHook 设置刷新回调，每次从微信服务器获取稳定版 access_token 后调用
+func (ak *StableAccessToken) SetRefreshHook(hook TokenRefreshHook) {
+	ak.refreshHook = hook
 }
 
 // NewStableAccessToken new StableAccessToken
 func NewStableAccessToken(appID, appSecret, cacheKeyPrefix string, cache cache.Cache) AccessTokenContextHandle {
+	return NewStableAccessTokenWithSecretProvider(appID, StaticSecret(appSecret), cacheKeyPrefix, cache)
+}
+
+// NewStableAccessTokenWithSecretProvider new StableAccessToken，刷新 access_token 时通过 secretProvider 获取当前 appSecret
+func NewStableAccessTokenWithSecretProvider(appID string, secretProvider SecretProvider, cacheKeyPrefix string, cache cache.Cache) AccessTokenContextHandle {
 	if cache == nil {
 		panic("cache is need")
 	}
 	return &StableAccessToken{
 		appID:           appID,
-		appSecret:       appSecret,
+		secretProvider:  secretProvider,
 		cache:           cache,
 		cacheKeyPrefix:  cacheKeyPrefix,
 		accessTokenLock: new(sync.Mutex),
+		shutdown:        newShutdownSignal(),
 	}
 }
 
+// Close 中止正在进行的刷新并拒绝之后的刷新，用于服务优雅退出，缓存中的 access_token 仍可获取
+func (ak *StableAccessToken) Close() error {
+	return ak.shutdown.close()
+}
+
 // GetAccessToken 获取access_token,先从cache中获取，没有则从服务端获取
 func (ak *StableAccessToken) GetAccessToken() (accessToken string, err error) {
 	return ak.GetAccessTokenContext(context.Background())
@@ -148,6 +343,15 @@ func (ak *StableAccessToken) GetAccessTokenContext(ctx context.Context) (accessT
 		}
 	}
 
+	ctx, cancel := ak.shutdown.withShutdown(ctx)
+	defer cancel()
+
+	var release func()
+	if accessToken, release, err = lockForRefresh(ctx, ak.lock, ak.cache, accessTokenCacheKey); err != nil || accessToken != "" {
+		return
+	}
+	defer release()
+
 	// cache失效，从微信服务器获取
 	var resAccessToken ResAccessToken
 	resAccessToken, err = ak.GetAccessTokenDirectly(ctx, false)
@@ -164,10 +368,13 @@ func (ak *StableAccessToken) GetAccessTokenContext(ctx context.Context) (accessT
 
 // GetAccessTokenDirectly 从微信获取access_token
 func (ak *StableAccessToken) GetAccessTokenDirectly(ctx context.Context, forceRefresh bool) (resAccessToken ResAccessToken, err error) {
-	b, err := util.PostJSONContext(ctx, stableAccessTokenURL, map[string]interface{}{
+	defer func() {
+		notifyRefresh(ak.refreshHook, ak.appID, resAccessToken, err)
+	}()
+	b, err := util.PostJSONContext(util.WithOperation(ctx, "credential.GetAccessTokenDirectly"), stableAccessTokenURL, map[string]interface{}{
 		"grant_type":    "client_credential",
 		"appid":         ak.appID,
-		"secret":        ak.appSecret,
+		"secret":        ak.secretProvider(),
 		"force_refresh": forceRefresh,
 	})
 	if err != nil {
@@ -269,7 +476,7 @@ func GetTokenFromServer(url string) (resAccessToken ResAccessToken, err error) {
 // GetTokenFromServerContext 强制从微信服务器获取token
 func GetTokenFromServerContext(ctx context.Context, url string) (resAccessToken ResAccessToken, err error) {
 	var body []byte
-	body, err = util.HTTPGetContext(ctx, url)
+	body, err = util.HTTPGetContext(util.WithOperation(ctx, "credential.GetTokenFromServerContext"), url)
 	if err != nil {
 		return
 	}
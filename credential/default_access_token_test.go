@@ -1,10 +1,15 @@
 package credential
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
 )
 
 // TestGetTicketFromServer .
@@ -13,7 +18,81 @@ func TestGetTicketFromServer(t *testing.T) {
 	gock.New(getTicketURL).Reply(200).JSON(&ResTicket{Ticket: "mock-ticket", ExpiresIn: 10})
 	ticket, err := GetTicketFromServer("arg-ak")
 	assert.Nil(t, err)
-	assert.Equal(t, int64(0), ticket.ErrCode)
+	assert.Equal(t, int64(0), ticket.ErrCode.Int64())
 	assert.Equal(t, "mock-ticket", ticket.Ticket, "they should be equal")
 	assert.Equal(t, int64(10), ticket.ExpiresIn, "they should be equal")
 }
+
+// TestDefaultAccessTokenSecretProvider 轮换 appSecret 后，下一次刷新使用新的 appSecret
+func TestDefaultAccessTokenSecretProvider(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").MatchParam("secret", "old-secret").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "old-token", ExpiresIn: 7200})
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").MatchParam("secret", "new-secret").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "new-token", ExpiresIn: 7200})
+
+	secret := "old-secret"
+	memCache := cache.NewMemory()
+	ak := NewDefaultAccessTokenWithSecretProvider("mock-appid", func() string { return secret }, CacheKeyOfficialAccountPrefix, memCache)
+
+	token, err := ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "old-token", token)
+
+	// 轮换 appSecret 并使缓存失效
+	secret = "new-secret"
+	assert.Nil(t, memCache.Delete(fmt.Sprintf("%s_access_token_%s", CacheKeyOfficialAccountPrefix, "mock-appid")))
+
+	token, err = ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "new-token", token)
+	assert.True(t, gock.IsDone())
+}
+
+// TestDefaultAccessTokenTokenInfo 返回缓存中的 access_token 及过期时间
+func TestDefaultAccessTokenTokenInfo(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "mock-token", ExpiresIn: 7200})
+
+	ak := NewDefaultAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, cache.NewMemory()).(*DefaultAccessToken)
+
+	_, _, err := ak.TokenInfo(context.Background())
+	assert.Equal(t, ErrTokenNotCached, err)
+
+	_, err = ak.GetAccessToken()
+	assert.Nil(t, err)
+
+	token, expiresAt, err := ak.TokenInfo(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-token", token)
+	assert.WithinDuration(t, time.Now().Add((7200-1500)*time.Second), expiresAt, 2*time.Second)
+	assert.True(t, gock.IsDone())
+}
+
+// TestDefaultAccessTokenRefreshThrottle 最小刷新间隔内的多次强制刷新只请求一次微信服务器
+func TestDefaultAccessTokenRefreshThrottle(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "mock-token", ExpiresIn: 7200})
+
+	ak := NewDefaultAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, cache.NewMemory()).(*DefaultAccessToken)
+	ak.SetMinRefreshInterval(time.Minute)
+
+	for i := 0; i < 10; i++ {
+		token, err := ak.RefreshAccessToken(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-token", token)
+	}
+	// mock 只匹配一次，多余的请求会返回错误
+	assert.True(t, gock.IsDone())
+
+	// 不限制刷新间隔时每次都请求微信服务器
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "new-token", ExpiresIn: 7200})
+	ak.SetMinRefreshInterval(0)
+	token, err := ak.RefreshAccessToken(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "new-token", token)
+	assert.True(t, gock.IsDone())
+}
@@ -82,7 +82,7 @@ func (js *DefaultJsTicket) GetTicketContext(ctx context2.Context, accessToken st
 func GetTicketFromServerContext(ctx context2.Context, accessToken string) (ticket ResTicket, err error) {
 	var response []byte
 	url := fmt.Sprintf(getTicketURL, accessToken)
-	response, err = util.HTTPGetContext(ctx, url)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "credential.GetTicketFromServerContext"), url)
 	if err != nil {
 		return
 	}
@@ -16,7 +16,7 @@ func TestGetTicketFromServerContext(t *testing.T) {
 
 	ticket, err := GetTicketFromServerContext(context.Background(), "arg-ak")
 	assert.Nil(t, err)
-	assert.Equal(t, int64(0), ticket.ErrCode)
+	assert.Equal(t, int64(0), ticket.ErrCode.Int64())
 	assert.Equal(t, "mock-ticket", ticket.Ticket, "they should be equal")
 	assert.Equal(t, int64(10), ticket.ExpiresIn, "they should be equal")
 }
@@ -0,0 +1,52 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// FallbackAccessToken 依次尝试主、备 AccessTokenHandle 获取 access_token
+// 适用于在不同获取方式之间平滑迁移（如普通 access_token 迁移至稳定版 access_token）
+type FallbackAccessToken struct {
+	primary   AccessTokenHandle
+	secondary AccessTokenHandle
+}
+
+// NewFallbackAccessToken new FallbackAccessToken
+func NewFallbackAccessToken(primary, secondary AccessTokenHandle) AccessTokenContextHandle {
+	if primary == nil || secondary == nil {
+		panic("primary and secondary access token handle are needed")
+	}
+	return &FallbackAccessToken{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+// GetAccessToken 先从 primary 获取 access_token，失败时从 secondary 获取
+func (ak *FallbackAccessToken) GetAccessToken() (accessToken string, err error) {
+	return ak.GetAccessTokenContext(context.Background())
+}
+
+// GetAccessTokenContext 先从 primary 获取 access_token，失败时从 secondary 获取
+func (ak *FallbackAccessToken) GetAccessTokenContext(ctx context.Context) (accessToken string, err error) {
+	accessToken, primaryErr := getAccessTokenContext(ctx, ak.primary)
+	if primaryErr == nil {
+		return
+	}
+	log.Warnf("get access_token from primary handle failed, fallback to secondary: %v", primaryErr)
+	if accessToken, err = getAccessTokenContext(ctx, ak.secondary); err != nil {
+		err = fmt.Errorf("get access_token failed, primary error: %v, secondary error: %w", primaryErr, err)
+	}
+	return
+}
+
+// getAccessTokenContext 优先使用 AccessTokenContextHandle 获取 access_token
+func getAccessTokenContext(ctx context.Context, handle AccessTokenHandle) (string, error) {
+	if c, ok := handle.(AccessTokenContextHandle); ok {
+		return c.GetAccessTokenContext(ctx)
+	}
+	return handle.GetAccessToken()
+}
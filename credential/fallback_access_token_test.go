@@ -0,0 +1,49 @@
+package credential
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockAccessTokenHandle struct {
+	token string
+	err   error
+	calls int
+}
+
+func (m *mockAccessTokenHandle) GetAccessToken() (string, error) {
+	m.calls++
+	return m.token, m.err
+}
+
+func TestFallbackAccessToken(t *testing.T) {
+	t.Run("primary success", func(t *testing.T) {
+		primary := &mockAccessTokenHandle{token: "primary-token"}
+		secondary := &mockAccessTokenHandle{token: "secondary-token"}
+		token, err := NewFallbackAccessToken(primary, secondary).GetAccessToken()
+		assert.Nil(t, err)
+		assert.Equal(t, "primary-token", token)
+		assert.Equal(t, 0, secondary.calls)
+	})
+
+	t.Run("primary fail secondary success", func(t *testing.T) {
+		primary := &mockAccessTokenHandle{err: errors.New("primary error")}
+		secondary := &mockAccessTokenHandle{token: "secondary-token"}
+		token, err := NewFallbackAccessToken(primary, secondary).GetAccessToken()
+		assert.Nil(t, err)
+		assert.Equal(t, "secondary-token", token)
+		assert.Equal(t, 1, secondary.calls)
+	})
+
+	t.Run("both fail", func(t *testing.T) {
+		secondaryErr := errors.New("secondary error")
+		primary := &mockAccessTokenHandle{err: errors.New("primary error")}
+		secondary := &mockAccessTokenHandle{err: secondaryErr}
+		token, err := NewFallbackAccessToken(primary, secondary).GetAccessToken()
+		assert.Equal(t, "", token)
+		assert.ErrorIs(t, err, secondaryErr)
+		assert.Contains(t, err.Error(), "primary error")
+	})
+}
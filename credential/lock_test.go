@@ -0,0 +1,117 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+)
+
+// mockDistributedLock 模拟分布式锁，held 为 true 时表示锁由其他实例持有
+type mockDistributedLock struct {
+	held     bool
+	acquired int
+	released int
+}
+
+func (l *mockDistributedLock) Acquire(_ context.Context, _ string, _ time.Duration) (func(), bool, error) {
+	if l.held {
+		return nil, false, nil
+	}
+	l.acquired++
+	return func() { l.released++ }, true, nil
+}
+
+// syncCache 并发安全的缓存，模拟多个实例共享的缓存
+type syncCache struct {
+	mu sync.Mutex
+	cache.Cache
+}
+
+func (c *syncCache) Get(key string) interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.Cache.Get(key)
+}
+
+func (c *syncCache) Set(key string, val interface{}, timeout time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.Cache.Set(key, val, timeout)
+}
+
+func TestDefaultAccessTokenLockAcquired(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "mock-token", ExpiresIn: 7200})
+
+	lock := &mockDistributedLock{}
+	ak := NewDefaultAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, cache.NewMemory())
+	ak.(TokenLocker).SetLock(lock)
+
+	for i := 0; i < 2; i++ {
+		token, err := ak.GetAccessToken()
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-token", token)
+	}
+	assert.Equal(t, 1, lock.acquired)
+	assert.Equal(t, 1, lock.released)
+	assert.True(t, gock.IsDone())
+}
+
+// TestDefaultAccessTokenLockHeldByOther 锁由其他实例持有时，等待其写入缓存而不请求微信服务器
+func TestDefaultAccessTokenLockHeldByOther(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "should-not-fetch", ExpiresIn: 7200})
+
+	sharedCache := &syncCache{Cache: cache.NewMemory()}
+	ak := NewDefaultAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, sharedCache)
+	ak.(TokenLocker).SetLock(&mockDistributedLock{held: true})
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = sharedCache.Set(fmt.Sprintf("%s_access_token_%s", CacheKeyOfficialAccountPrefix, "mock-appid"), "other-instance-token", time.Hour)
+	}()
+	token, err := ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "other-instance-token", token)
+	assert.False(t, gock.IsDone())
+}
+
+// TestStableAccessTokenLock 稳定版 access_token 同样支持分布式锁
+func TestStableAccessTokenLock(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/stable_token").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "mock-stable-token", ExpiresIn: 7200})
+
+	lock := &mockDistributedLock{}
+	ak := NewStableAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, cache.NewMemory())
+	ak.(TokenLocker).SetLock(lock)
+	for i := 0; i < 2; i++ {
+		token, err := ak.GetAccessToken()
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-stable-token", token)
+	}
+	assert.Equal(t, 1, lock.acquired)
+	assert.Equal(t, 1, lock.released)
+	assert.True(t, gock.IsDone())
+
+	// 锁由其他实例持有时等待其写入缓存
+	sharedCache := &syncCache{Cache: cache.NewMemory()}
+	ak = NewStableAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, sharedCache)
+	ak.(TokenLocker).SetLock(&mockDistributedLock{held: true})
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = sharedCache.Set(fmt.Sprintf("%s_stable_access_token_%s", CacheKeyOfficialAccountPrefix, "mock-appid"), "other-instance-token", time.Hour)
+	}()
+	token, err := ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "other-instance-token", token)
+}
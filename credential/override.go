@@ -0,0 +1,42 @@
+package credential
+
+import "context"
+
+// accessTokenOverrideKey context 中保存单次调用 access_token 覆盖的 key
+type accessTokenOverrideKey struct{}
+
+// WithAccessToken 返回指定了 access_token 的 ctx，使用该 ctx 调用支持 context 的接口时，
+// 使用 accessToken 代替客户端默认的获取方式，用于同一客户端以其他账号身份发起单次调用
+func WithAccessToken(ctx context.Context, accessToken string) context.Context {
+	return WithAccessTokenHandle(ctx, staticAccessToken(accessToken))
+}
+
+// WithAccessTokenHandle 返回指定了 access_token 获取方式的 ctx，作用同 WithAccessToken
+func WithAccessTokenHandle(ctx context.Context, handle AccessTokenHandle) context.Context {
+	return context.WithValue(ctx, accessTokenOverrideKey{}, handle)
+}
+
+// AccessTokenHandleFromContext 返回 ctx 中指定的 access_token 获取方式，未指定时 ok 为 false
+func AccessTokenHandleFromContext(ctx context.Context) (handle AccessTokenHandle, ok bool) {
+	handle, ok = ctx.Value(accessTokenOverrideKey{}).(AccessTokenHandle)
+	return
+}
+
+// GetAccessTokenContext 优先使用 ctx 中指定的 access_token 获取方式，未指定时使用 handle
+// handle 实现了 AccessTokenContextHandle 时调用其 GetAccessTokenContext 方法
+func GetAccessTokenContext(ctx context.Context, handle AccessTokenHandle) (string, error) {
+	if override, ok := AccessTokenHandleFromContext(ctx); ok {
+		handle = override
+	}
+	if ctxHandle, ok := handle.(AccessTokenContextHandle); ok {
+		return ctxHandle.GetAccessTokenContext(ctx)
+	}
+	return handle.GetAccessToken()
+}
+
+// staticAccessToken 固定的 access_token
+type staticAccessToken string
+
+func (token staticAccessToken) GetAccessToken() (string, error) {
+	return string(token), nil
+}
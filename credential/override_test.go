@@ -0,0 +1,31 @@
+package credential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockHandle string
+
+func (m mockHandle) GetAccessToken() (string, error) {
+	return string(m), nil
+}
+
+// TestGetAccessTokenContextOverride ctx 中指定的 access_token 优先于客户端默认的获取方式
+func TestGetAccessTokenContextOverride(t *testing.T) {
+	token, err := GetAccessTokenContext(context.Background(), mockHandle("client-token"))
+	assert.NoError(t, err)
+	assert.Equal(t, "client-token", token)
+
+	ctx := WithAccessToken(context.Background(), "override-token")
+	token, err = GetAccessTokenContext(ctx, mockHandle("client-token"))
+	assert.NoError(t, err)
+	assert.Equal(t, "override-token", token)
+
+	ctx = WithAccessTokenHandle(context.Background(), mockHandle("handle-token"))
+	token, err = GetAccessTokenContext(ctx, mockHandle("client-token"))
+	assert.NoError(t, err)
+	assert.Equal(t, "handle-token", token)
+}
@@ -0,0 +1,45 @@
+package credential
+
+import "strings"
+
+// TokenRefreshHook 每次从微信服务器刷新 access_token 后的回调，err 不为空时表示刷新失败，此时 token 为空
+// 回调在持有刷新锁时同步执行，不应阻塞
+type TokenRefreshHook func(appID string, token string, expiresIn int64, err error)
+
+// TokenRefreshObserver 支持设置刷新回调的 access_token 获取方式
+type TokenRefreshObserver interface {
+	SetRefreshHook(hook TokenRefreshHook)
+}
+
+// redactKeepLength 脱敏时首尾各保留的字符数
+const redactKeepLength = 4
+
+// RedactToken 对 token 脱敏，仅保留首尾各 4 个字符，用于日志及监控上报
+func RedactToken(token string) string {
+	if len(token) <= redactKeepLength*2 {
+		return strings.Repeat("*", len(token))
+	}
+	return token[:redactKeepLength] + strings.Repeat("*", len(token)-redactKeepLength*2) + token[len(token)-redactKeepLength:]
+}
+
+// RedactRefreshHook 返回将 token 脱敏后再调用 hook 的回调
+func RedactRefreshHook(hook TokenRefreshHook) TokenRefreshHook {
+	if hook == nil {
+		return nil
+	}
+	return func(appID string, token string, expiresIn int64, err error) {
+		hook(appID, RedactToken(token), expiresIn, err)
+	}
+}
+
+// notifyRefresh 调用刷新回调
+func notifyRefresh(hook TokenRefreshHook, appID string, res ResAccessToken, err error) {
+	if hook == nil {
+		return
+	}
+	if err != nil {
+		hook(appID, "", 0, err)
+		return
+	}
+	hook(appID, res.AccessToken, res.ExpiresIn, nil)
+}
@@ -0,0 +1,56 @@
+package credential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+)
+
+type refreshRecord struct {
+	appID     string
+	token     string
+	expiresIn int64
+	err       error
+}
+
+// TestDefaultAccessTokenRefreshHook 每次从微信服务器刷新后调用回调，命中缓存时不调用
+func TestDefaultAccessTokenRefreshHook(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(&ResAccessToken{AccessToken: "mock-token", ExpiresIn: 7200})
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(map[string]interface{}{"errcode": 40001, "errmsg": "invalid credential"})
+
+	var records []refreshRecord
+	ak := NewDefaultAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, cache.NewMemory()).(*DefaultAccessToken)
+	ak.SetMinRefreshInterval(0)
+	ak.SetRefreshHook(func(appID string, token string, expiresIn int64, err error) {
+		records = append(records, refreshRecord{appID, token, expiresIn, err})
+	})
+
+	for i := 0; i < 2; i++ {
+		token, err := ak.GetAccessToken()
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-token", token)
+	}
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, refreshRecord{"mock-appid", "mock-token", 7200, nil}, records[0])
+	}
+
+	_, err := ak.RefreshAccessToken(context.Background())
+	assert.NotNil(t, err)
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, "", records[1].token)
+		assert.Equal(t, err, records[1].err)
+	}
+}
+
+func TestRedactToken(t *testing.T) {
+	assert.Equal(t, "abcd****wxyz", RedactToken("abcd1234wxyz"))
+	assert.Equal(t, "********", RedactToken("12345678"))
+	assert.Equal(t, "", RedactToken(""))
+}
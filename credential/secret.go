@@ -0,0 +1,12 @@
+package credential
+
+// SecretProvider 返回当前生效的 appSecret
+// 每次从微信服务器刷新 access_token 时都会调用，用于在不重启服务的情况下轮换 appSecret
+type SecretProvider func() string
+
+// StaticSecret 返回固定 appSecret 的 SecretProvider
+func StaticSecret(appSecret string) SecretProvider {
+	return func() string {
+		return appSecret
+	}
+}
@@ -0,0 +1,43 @@
+package credential
+
+import (
+	"context"
+	"sync"
+)
+
+// shutdownSignal 关闭信号，关闭后取消所有通过 withShutdown 派生的 context
+type shutdownSignal struct {
+	ch   chan struct{}
+	once sync.Once
+}
+
+func newShutdownSignal() *shutdownSignal {
+	return &shutdownSignal{ch: make(chan struct{})}
+}
+
+// close 发出关闭信号，可重复调用
+func (s *shutdownSignal) close() error {
+	s.once.Do(func() {
+		close(s.ch)
+	})
+	return nil
+}
+
+// withShutdown 返回在 ctx 结束或发出关闭信号时取消的 context
+func (s *shutdownSignal) withShutdown(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	select {
+	case <-s.ch:
+		cancel()
+		return ctx, cancel
+	default:
+	}
+	go func() {
+		select {
+		case <-s.ch:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
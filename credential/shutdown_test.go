@@ -0,0 +1,54 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// TestDefaultAccessTokenClose 刷新过程中调用 Close，正在进行的请求被及时中止
+func TestDefaultAccessTokenClose(t *testing.T) {
+	received := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	defer server.Close()
+	util.SetURIModifier(func(uri string) string {
+		return strings.Replace(uri, "https://api.weixin.qq.com", server.URL, 1)
+	})
+	defer util.SetURIModifier(nil)
+
+	ak := NewDefaultAccessToken("mock-appid", "mock-secret", CacheKeyOfficialAccountPrefix, cache.NewMemory()).(*DefaultAccessToken)
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := ak.GetAccessTokenContext(context.Background())
+		errCh <- err
+	}()
+
+	<-received
+	assert.Nil(t, ak.Close())
+	select {
+	case err := <-errCh:
+		assert.True(t, errors.Is(err, context.Canceled), "%v", err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("refresh should return promptly after Close")
+	}
+
+	// 关闭后不再刷新
+	_, err := ak.RefreshAccessToken(context.Background())
+	assert.True(t, errors.Is(err, context.Canceled), "%v", err)
+	assert.Nil(t, ak.Close())
+}
@@ -5,7 +5,6 @@ go 1.16
 require (
 	github.com/alicebob/miniredis/v2 v2.30.0
 	github.com/bradfitz/gomemcache v0.0.0-20220106215444-fb4bf637b56d
-	github.com/fatih/structs v1.1.0
 	github.com/go-redis/redis/v8 v8.11.5
 	github.com/sirupsen/logrus v1.9.0
 	github.com/spf13/cast v1.4.1
@@ -1,6 +1,7 @@
 package openapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -40,7 +41,7 @@ func (o *OpenAPI) ClearQuota() error {
 	}{
 		AppID: appID,
 	}
-	res, err := o.doPostRequest(clearQuotaURL, payload)
+	res, err := o.doPostRequest(clearQuotaURL, payload, "ClearQuota")
 	if err != nil {
 		return err
 	}
@@ -51,7 +52,7 @@ func (o *OpenAPI) ClearQuota() error {
 // GetAPIQuota 查询API调用额度
 // https://developers.weixin.qq.com/miniprogram/dev/OpenApiDoc/openApi-mgnt/getApiQuota.html
 func (o *OpenAPI) GetAPIQuota(params openapi.GetAPIQuotaParams) (quota openapi.APIQuota, err error) {
-	res, err := o.doPostRequest(getAPIQuotaURL, params)
+	res, err := o.doPostRequest(getAPIQuotaURL, params, "GetAPIQuota")
 	if err != nil {
 		return
 	}
@@ -60,10 +61,22 @@ func (o *OpenAPI) GetAPIQuota(params openapi.GetAPIQuotaParams) (quota openapi.A
 	return
 }
 
+// NewQuotaThrottle 创建根据 cgiPath 接口剩余额度自动调整调用速率的限速器
+// cgiPath 格式同 GetAPIQuotaParams.CgiPath，如 "/cgi-bin/message/template/send"
+func (o *OpenAPI) NewQuotaThrottle(cgiPath string, opts util.QuotaThrottleOpts) *util.QuotaThrottle {
+	return util.NewQuotaThrottle(func(context.Context) (util.QuotaReading, error) {
+		quota, err := o.GetAPIQuota(openapi.GetAPIQuotaParams{CgiPath: cgiPath})
+		if err != nil {
+			return util.QuotaReading{}, err
+		}
+		return util.QuotaReading{DailyLimit: quota.Quota.DailyLimit, Remain: quota.Quota.Remain}, nil
+	}, opts)
+}
+
 // GetRidInfo 查询rid信息
 // https://developers.weixin.qq.com/miniprogram/dev/OpenApiDoc/openApi-mgnt/getRidInfo.html
 func (o *OpenAPI) GetRidInfo(params openapi.GetRidInfoParams) (r openapi.RidInfo, err error) {
-	res, err := o.doPostRequest(getRidInfoURL, params)
+	res, err := o.doPostRequest(getRidInfoURL, params, "GetRidInfo")
 	if err != nil {
 		return
 	}
@@ -81,7 +94,7 @@ func (o *OpenAPI) ClearQuotaByAppSecret() error {
 	}
 
 	uri := fmt.Sprintf("%s?appid=%s&appsecret=%s", clearQuotaByAppSecretURL, id, secret)
-	res, err := util.HTTPPost(uri, "")
+	res, err := util.HTTPPostContext(util.WithOperation(context.Background(), "openapi.ClearQuotaByAppSecret"), uri, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -116,12 +129,12 @@ func (o *OpenAPI) getAccessToken() (string, error) {
 }
 
 // 创建 POST 请求
-func (o *OpenAPI) doPostRequest(uri string, payload interface{}) ([]byte, error) {
+func (o *OpenAPI) doPostRequest(uri string, payload interface{}, apiName string) ([]byte, error) {
 	ak, err := o.getAccessToken()
 	if err != nil {
 		return nil, err
 	}
 
 	uri = fmt.Sprintf("%s?access_token=%s", uri, ak)
-	return util.PostJSON(uri, payload)
+	return util.PostJSONContext(util.WithOperation(context.Background(), "openapi."+apiName), uri, payload)
 }
@@ -0,0 +1,96 @@
+// Package wxapath 小程序页面路径及版本参数校验，供 shortlink、urllink、urlscheme、qrcode 等共用
+package wxapath
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// EnvVersionRelease 正式版
+	EnvVersionRelease = "release"
+	// EnvVersionTrial 体验版
+	EnvVersionTrial = "trial"
+	// EnvVersionDevelop 开发版
+	EnvVersionDevelop = "develop"
+)
+
+// ValidateEnvVersion 校验要打开的小程序版本，为空时默认正式版
+func ValidateEnvVersion(envVersion string) error {
+	switch envVersion {
+	case "", EnvVersionRelease, EnvVersionTrial, EnvVersionDevelop:
+		return nil
+	default:
+		return fmt.Errorf("invalid env_version %q, must be one of release, trial, develop", envVersion)
+	}
+}
+
+// ValidatePage 校验不携带 query 的页面路径，如 "pages/index/index"
+// 路径由非空的段组成，允许以单个 / 开头，不能包含空白字符、? 及 #
+func ValidatePage(page string) error {
+	if page == "" {
+		return fmt.Errorf("invalid page %q: page is empty", page)
+	}
+	for _, segment := range strings.Split(strings.TrimPrefix(page, "/"), "/") {
+		if segment == "" {
+			return fmt.Errorf("invalid page %q: empty path segment", page)
+		}
+		if i := strings.IndexFunc(segment, isInvalidPageRune); i >= 0 {
+			r, _ := utf8.DecodeRuneInString(segment[i:])
+			return fmt.Errorf("invalid page %q: unexpected character %q", page, r)
+		}
+	}
+	return nil
+}
+
+// maxQueryLength query 的最大长度
+const maxQueryLength = 1024
+
+// queryPunctuation query 中允许的特殊字符，其余只能为数字及大小写英文
+const queryPunctuation = "!#$&'()*+,/:;=?@-._~%"
+
+// ValidateQuery 校验页面 query，如 "id=1&from=share"，不能以 ? 开头
+// 最大 1024 个字符，只支持数字、大小写英文及 !#$&'()*+,/:;=?@-._~% 等特殊字符
+func ValidateQuery(query string) error {
+	if query == "" {
+		return nil
+	}
+	if strings.HasPrefix(query, "?") {
+		return fmt.Errorf("invalid query %q: should not start with ?", query)
+	}
+	if len(query) > maxQueryLength {
+		return fmt.Errorf("invalid query %q: exceeds %d characters", query, maxQueryLength)
+	}
+	if i := strings.IndexFunc(query, isInvalidQueryRune); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(query[i:])
+		return fmt.Errorf("invalid query %q: unexpected character %q", query, r)
+	}
+	return nil
+}
+
+// ValidatePageWithQuery 校验可携带 query 的页面路径，如 "pages/index/index?id=1"
+func ValidatePageWithQuery(pageURL string) error {
+	page, query := pageURL, ""
+	if i := strings.IndexByte(pageURL, '?'); i >= 0 {
+		page, query = pageURL[:i], pageURL[i+1:]
+	}
+	if err := ValidatePage(page); err != nil {
+		return err
+	}
+	return ValidateQuery(query)
+}
+
+func isInvalidQueryRune(r rune) bool {
+	switch {
+	case r >= '0' && r <= '9', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		return false
+	default:
+		return !strings.ContainsRune(queryPunctuation, r)
+	}
+}
+
+func isInvalidPageRune(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsControl(r) || r == '?' || r == '#'
+}
@@ -0,0 +1,64 @@
+package wxapath
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePage(t *testing.T) {
+	for _, page := range []string{"pages/index/index", "/pages/index/index", "index"} {
+		assert.NoError(t, ValidatePage(page), page)
+	}
+	for page, msg := range map[string]string{
+		"":                     `invalid page "": page is empty`,
+		"/":                    `invalid page "/": empty path segment`,
+		"pages//index":         `invalid page "pages//index": empty path segment`,
+		"pages/index/":         `invalid page "pages/index/": empty path segment`,
+		"pages/index?id=1":     `invalid page "pages/index?id=1": unexpected character '?'`,
+		"pages/my index/index": `invalid page "pages/my index/index": unexpected character ' '`,
+	} {
+		assert.EqualError(t, ValidatePage(page), msg)
+	}
+}
+
+func TestValidatePageWithQuery(t *testing.T) {
+	for _, pageURL := range []string{"pages/index/index", "pages/index/index?id=1&from=share", "pages/index/index?", "pages/index/index?id=1#top"} {
+		assert.NoError(t, ValidatePageWithQuery(pageURL), pageURL)
+	}
+	for _, pageURL := range []string{"?id=1", "pages/index/index??id=1", "pages/index/index?id=1 2"} {
+		assert.Error(t, ValidatePageWithQuery(pageURL), pageURL)
+	}
+}
+
+func TestValidateQuery(t *testing.T) {
+	for _, query := range []string{
+		"",
+		"id=1&from=share",
+		"a=1;b=2",
+		"id=1#top",
+		"name=%E4%B8%AD%E6%96%87",
+		"path=/pages/index?x=1",
+		"!$'()*+,:@-._~=1",
+		strings.Repeat("a", 1024),
+	} {
+		assert.NoError(t, ValidateQuery(query), query)
+	}
+	for query, msg := range map[string]string{
+		"?id=1":   `invalid query "?id=1": should not start with ?`,
+		"id=1 2":  `invalid query "id=1 2": unexpected character ' '`,
+		"name=中文": `invalid query "name=中文": unexpected character '中'`,
+		"a=<b>":   `invalid query "a=<b>": unexpected character '<'`,
+	} {
+		assert.EqualError(t, ValidateQuery(query), msg)
+	}
+	assert.EqualError(t, ValidateQuery(strings.Repeat("a", 1025)), `invalid query "`+strings.Repeat("a", 1025)+`": exceeds 1024 characters`)
+}
+
+func TestValidateEnvVersion(t *testing.T) {
+	for _, envVersion := range []string{"", EnvVersionRelease, EnvVersionTrial, EnvVersionDevelop} {
+		assert.NoError(t, ValidateEnvVersion(envVersion))
+	}
+	assert.EqualError(t, ValidateEnvVersion("beta"), `invalid env_version "beta", must be one of release, trial, develop`)
+}
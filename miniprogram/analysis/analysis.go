@@ -42,6 +42,22 @@ func NewAnalysis(ctx *context.Context) *Analysis {
 	return &Analysis{ctx}
 }
 
+// dateBody 校验起止日期并生成请求参数，接口限制的最大时间跨度为 maxDays 天
+func dateBody(beginDate, endDate string, maxDays int) (map[string]string, error) {
+	dateRange, err := util.ParseDateRange(beginDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	if err = dateRange.Validate(maxDays); err != nil {
+		return nil, err
+	}
+	begin, end := dateRange.Format()
+	return map[string]string{
+		"begin_date": begin,
+		"end_date":   end,
+	}, nil
+}
+
 // fetchData 拉取统计数据
 func (analysis *Analysis) fetchData(urlStr string, body interface{}) (response []byte, err error) {
 	var accessToken string
@@ -69,10 +85,10 @@ type ResAnalysisRetain struct {
 }
 
 // getAnalysisRetain 获取用户访问小程序留存数据(日、月、周)
-func (analysis *Analysis) getAnalysisRetain(urlStr string, beginDate, endDate string) (result ResAnalysisRetain, err error) {
-	body := map[string]string{
-		"begin_date": beginDate,
-		"end_date":   endDate,
+func (analysis *Analysis) getAnalysisRetain(urlStr string, beginDate, endDate string, maxDays int) (result ResAnalysisRetain, err error) {
+	body, err := dateBody(beginDate, endDate, maxDays)
+	if err != nil {
+		return
 	}
 	response, err := analysis.fetchData(urlStr, body)
 	if err != nil {
@@ -91,17 +107,17 @@ func (analysis *Analysis) getAnalysisRetain(urlStr string, beginDate, endDate st
 
 // GetAnalysisDailyRetain 获取用户访问小程序日留存
 func (analysis *Analysis) GetAnalysisDailyRetain(beginDate, endDate string) (result ResAnalysisRetain, err error) {
-	return analysis.getAnalysisRetain(getAnalysisDailyRetainURL, beginDate, endDate)
+	return analysis.getAnalysisRetain(getAnalysisDailyRetainURL, beginDate, endDate, 1)
 }
 
 // GetAnalysisMonthlyRetain 获取用户访问小程序月留存
 func (analysis *Analysis) GetAnalysisMonthlyRetain(beginDate, endDate string) (result ResAnalysisRetain, err error) {
-	return analysis.getAnalysisRetain(getAnalysisMonthlyRetainURL, beginDate, endDate)
+	return analysis.getAnalysisRetain(getAnalysisMonthlyRetainURL, beginDate, endDate, 31)
 }
 
 // GetAnalysisWeeklyRetain 获取用户访问小程序周留存
 func (analysis *Analysis) GetAnalysisWeeklyRetain(beginDate, endDate string) (result ResAnalysisRetain, err error) {
-	return analysis.getAnalysisRetain(getAnalysisWeeklyRetainURL, beginDate, endDate)
+	return analysis.getAnalysisRetain(getAnalysisWeeklyRetainURL, beginDate, endDate, 7)
 }
 
 // ResAnalysisDailySummary 小程序访问数据概况
@@ -117,9 +133,9 @@ type ResAnalysisDailySummary struct {
 
 // GetAnalysisDailySummary 获取用户访问小程序数据概况
 func (analysis *Analysis) GetAnalysisDailySummary(beginDate, endDate string) (result ResAnalysisDailySummary, err error) {
-	body := map[string]string{
-		"begin_date": beginDate,
-		"end_date":   endDate,
+	body, err := dateBody(beginDate, endDate, 1)
+	if err != nil {
+		return
 	}
 	response, err := analysis.fetchData(getAnalysisDailySummaryURL, body)
 	if err != nil {
@@ -153,10 +169,10 @@ type ResAnalysisVisitTrend struct {
 }
 
 // getAnalysisRetain 获取小程序访问数据趋势(日、月、周)
-func (analysis *Analysis) getAnalysisVisitTrend(urlStr string, beginDate, endDate string) (result ResAnalysisVisitTrend, err error) {
-	body := map[string]string{
-		"begin_date": beginDate,
-		"end_date":   endDate,
+func (analysis *Analysis) getAnalysisVisitTrend(urlStr string, beginDate, endDate string, maxDays int) (result ResAnalysisVisitTrend, err error) {
+	body, err := dateBody(beginDate, endDate, maxDays)
+	if err != nil {
+		return
 	}
 	response, err := analysis.fetchData(urlStr, body)
 	if err != nil {
@@ -175,17 +191,17 @@ func (analysis *Analysis) getAnalysisVisitTrend(urlStr string, beginDate, endDat
 
 // GetAnalysisDailyVisitTrend 获取用户访问小程序数据日趋势
 func (analysis *Analysis) GetAnalysisDailyVisitTrend(beginDate, endDate string) (result ResAnalysisVisitTrend, err error) {
-	return analysis.getAnalysisVisitTrend(getAnalysisDailyVisitTrendURL, beginDate, endDate)
+	return analysis.getAnalysisVisitTrend(getAnalysisDailyVisitTrendURL, beginDate, endDate, 1)
 }
 
 // GetAnalysisMonthlyVisitTrend 获取用户访问小程序数据月趋势
 func (analysis *Analysis) GetAnalysisMonthlyVisitTrend(beginDate, endDate string) (result ResAnalysisVisitTrend, err error) {
-	return analysis.getAnalysisVisitTrend(getAnalysisMonthlyVisitTrendURL, beginDate, endDate)
+	return analysis.getAnalysisVisitTrend(getAnalysisMonthlyVisitTrendURL, beginDate, endDate, 31)
 }
 
 // GetAnalysisWeeklyVisitTrend 获取用户访问小程序数据周趋势
 func (analysis *Analysis) GetAnalysisWeeklyVisitTrend(beginDate, endDate string) (result ResAnalysisVisitTrend, err error) {
-	return analysis.getAnalysisVisitTrend(getAnalysisWeeklyVisitTrendURL, beginDate, endDate)
+	return analysis.getAnalysisVisitTrend(getAnalysisWeeklyVisitTrendURL, beginDate, endDate, 7)
 }
 
 // UserPortraitItem 用户画像项目
@@ -216,9 +232,9 @@ type ResAnalysisUserPortrait struct {
 
 // GetAnalysisUserPortrait 获取小程序新增或活跃用户的画像分布数据
 func (analysis *Analysis) GetAnalysisUserPortrait(beginDate, endDate string) (result ResAnalysisUserPortrait, err error) {
-	body := map[string]string{
-		"begin_date": beginDate,
-		"end_date":   endDate,
+	body, err := dateBody(beginDate, endDate, 30)
+	if err != nil {
+		return
 	}
 	response, err := analysis.fetchData(getAnalysisUserPortraitURL, body)
 	if err != nil {
@@ -257,9 +273,9 @@ type ResAnalysisVisitDistribution struct {
 
 // GetAnalysisVisitDistribution 获取用户小程序访问分布数据
 func (analysis *Analysis) GetAnalysisVisitDistribution(beginDate, endDate string) (result ResAnalysisVisitDistribution, err error) {
-	body := map[string]string{
-		"begin_date": beginDate,
-		"end_date":   endDate,
+	body, err := dateBody(beginDate, endDate, 1)
+	if err != nil {
+		return
 	}
 	response, err := analysis.fetchData(getAnalysisVisitDistributionURL, body)
 	if err != nil {
@@ -297,9 +313,9 @@ type ResAnalysisVisitPage struct {
 
 // GetAnalysisVisitPage 获取小程序页面访问数据
 func (analysis *Analysis) GetAnalysisVisitPage(beginDate, endDate string) (result ResAnalysisVisitPage, err error) {
-	body := map[string]string{
-		"begin_date": beginDate,
-		"end_date":   endDate,
+	body, err := dateBody(beginDate, endDate, 1)
+	if err != nil {
+		return
 	}
 	response, err := analysis.fetchData(getAnalysisVisitPageURL, body)
 	if err != nil {
@@ -0,0 +1,158 @@
+package analysis
+
+import (
+	context2 "context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// getPerformanceDataURL 获取小程序性能数据
+// https://developers.weixin.qq.com/miniprogram/dev/OpenApiDoc/data-analysis/others/getPerformanceData.html
+const getPerformanceDataURL = "https://api.weixin.qq.com/wxa/business/performance/boot?access_token=%s"
+
+// PerformanceModule 性能数据模块
+type PerformanceModule string
+
+const (
+	// PerformanceModuleOpenRate 打开率
+	PerformanceModuleOpenRate PerformanceModule = "10016"
+	// PerformanceModuleBoot 启动各阶段耗时
+	PerformanceModuleBoot PerformanceModule = "10017"
+	// PerformanceModulePageSwitch 页面切换耗时
+	PerformanceModulePageSwitch PerformanceModule = "10021"
+	// PerformanceModuleMemory 内存指标
+	PerformanceModuleMemory PerformanceModule = "10022"
+	// PerformanceModuleMemoryAlarm 内存异常
+	PerformanceModuleMemoryAlarm PerformanceModule = "10023"
+)
+
+const (
+	// PerformanceFieldNetworkType 网络类型，取值见 NetworkType 系列常量
+	PerformanceFieldNetworkType = "networktype"
+	// PerformanceFieldDeviceLevel 机型档位，-1 表示全部
+	PerformanceFieldDeviceLevel = "device_level"
+	// PerformanceFieldDevice 平台，-1 表示全部
+	PerformanceFieldDevice = "device"
+)
+
+const (
+	// NetworkTypeAll 全部网络类型
+	NetworkTypeAll = "-1"
+	// NetworkType3G 3G
+	NetworkType3G = "1"
+	// NetworkType4G 4G
+	NetworkType4G = "2"
+	// NetworkTypeWifi WIFI
+	NetworkTypeWifi = "3"
+)
+
+// PerformanceTime 查询的时间范围，unix 时间戳，单位秒
+type PerformanceTime struct {
+	BeginTimestamp int64 `json:"begin_timestamp"`
+	EndTimestamp   int64 `json:"end_timestamp"`
+}
+
+// PerformanceParam 查询条件
+type PerformanceParam struct {
+	Field string `json:"field"` // 查询条件，如 PerformanceFieldNetworkType
+	Value string `json:"value"` // 查询条件值
+}
+
+// PerformanceRequest 获取性能数据请求参数
+type PerformanceRequest struct {
+	Time   PerformanceTime    `json:"time"`
+	Module PerformanceModule  `json:"module"`
+	Params []PerformanceParam `json:"params,omitempty"`
+}
+
+// PerformanceField 时间序列中的一个数据点
+type PerformanceField struct {
+	RefDate string      `json:"refdate"` // 日期，如 20220101
+	Value   json.Number `json:"value"`   // 指标值
+}
+
+// PerformanceLine 时间序列
+type PerformanceLine struct {
+	Fields []PerformanceField `json:"fields"`
+}
+
+// PerformanceTable 指标数据
+type PerformanceTable struct {
+	ID    string            `json:"id"` // 指标 id
+	Zh    string            `json:"zh"` // 指标中文名
+	Lines []PerformanceLine `json:"lines"`
+}
+
+// PerformanceData 性能数据
+type PerformanceData struct {
+	Body struct {
+		Tables []PerformanceTable `json:"tables"`
+		Count  int                `json:"count"`
+	} `json:"body"`
+}
+
+// GetPerformanceData 获取小程序性能数据
+func (analysis *Analysis) GetPerformanceData(req *PerformanceRequest) (*PerformanceData, error) {
+	return analysis.GetPerformanceDataContext(context2.Background(), req)
+}
+
+// GetPerformanceDataContext 获取小程序性能数据
+func (analysis *Analysis) GetPerformanceDataContext(ctx context2.Context, req *PerformanceRequest) (*PerformanceData, error) {
+	if req.Module == "" {
+		return nil, fmt.Errorf("performance module is empty")
+	}
+	if req.Time.BeginTimestamp > req.Time.EndTimestamp {
+		return nil, fmt.Errorf("begin_timestamp %d is after end_timestamp %d", req.Time.BeginTimestamp, req.Time.EndTimestamp)
+	}
+	accessToken, err := analysis.GetAccessTokenContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := util.PostJSONContext(util.WithOperation(ctx, "analysis.GetPerformanceData"), fmt.Sprintf(getPerformanceDataURL, accessToken), req)
+	if err != nil {
+		return nil, err
+	}
+
+	// data 为 JSON 字符串
+	var res struct {
+		util.CommonError
+		Data string `json:"data"`
+	}
+	if err = util.DecodeWithError(response, &res, "GetPerformanceData"); err != nil {
+		return nil, err
+	}
+	data := new(PerformanceData)
+	if res.Data == "" {
+		return data, nil
+	}
+	if err = json.Unmarshal([]byte(res.Data), data); err != nil {
+		return nil, fmt.Errorf("GetPerformanceData unmarshal data error: %v", err)
+	}
+	return data, nil
+}
+
+// GetBootPerformance 获取启动各阶段耗时
+func (analysis *Analysis) GetBootPerformance(ctx context2.Context, beginTimestamp, endTimestamp int64, params ...PerformanceParam) (*PerformanceData, error) {
+	return analysis.getModulePerformance(ctx, PerformanceModuleBoot, beginTimestamp, endTimestamp, params)
+}
+
+// GetPageSwitchPerformance 获取页面切换耗时
+func (analysis *Analysis) GetPageSwitchPerformance(ctx context2.Context, beginTimestamp, endTimestamp int64, params ...PerformanceParam) (*PerformanceData, error) {
+	return analysis.getModulePerformance(ctx, PerformanceModulePageSwitch, beginTimestamp, endTimestamp, params)
+}
+
+// GetNetworkPerformance 获取指定网络类型下的性能数据，networkType 取值见 NetworkType 系列常量
+func (analysis *Analysis) GetNetworkPerformance(ctx context2.Context, module PerformanceModule, networkType string, beginTimestamp, endTimestamp int64) (*PerformanceData, error) {
+	params := []PerformanceParam{{Field: PerformanceFieldNetworkType, Value: networkType}}
+	return analysis.getModulePerformance(ctx, module, beginTimestamp, endTimestamp, params)
+}
+
+func (analysis *Analysis) getModulePerformance(ctx context2.Context, module PerformanceModule, beginTimestamp, endTimestamp int64, params []PerformanceParam) (*PerformanceData, error) {
+	return analysis.GetPerformanceDataContext(ctx, &PerformanceRequest{
+		Time:   PerformanceTime{BeginTimestamp: beginTimestamp, EndTimestamp: endTimestamp},
+		Module: module,
+		Params: params,
+	})
+}
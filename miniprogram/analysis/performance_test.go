@@ -0,0 +1,80 @@
+package analysis
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/credential"
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (m mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestAnalysis() *Analysis {
+	return NewAnalysis(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestGetBootPerformance(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/business/performance/boot").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"time":\{"begin_timestamp":1609603200,"end_timestamp":1609689600\},"module":"10017","params":\[\{"field":"networktype","value":"3"\}\]`).
+		Reply(200).JSON(map[string]interface{}{
+		"errcode": 0,
+		"errmsg":  "ok",
+		"data": `{"body":{"tables":[{"id":"startup_total_cost","lines":[{"fields":[{"refdate":"20210103","value":"1021"},` +
+			`{"refdate":"20210104","value":987.5}]}],"zh":"启动总耗时"}],"count":1}}`,
+	})
+
+	data, err := newTestAnalysis().GetBootPerformance(context2.Background(), 1609603200, 1609689600,
+		PerformanceParam{Field: PerformanceFieldNetworkType, Value: NetworkTypeWifi})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, data.Body.Count)
+	if assert.Len(t, data.Body.Tables, 1) {
+		table := data.Body.Tables[0]
+		assert.Equal(t, "startup_total_cost", table.ID)
+		assert.Equal(t, "启动总耗时", table.Zh)
+		if assert.Len(t, table.Lines, 1) && assert.Len(t, table.Lines[0].Fields, 2) {
+			assert.Equal(t, "20210103", table.Lines[0].Fields[0].RefDate)
+			value, _ := table.Lines[0].Fields[0].Value.Int64()
+			assert.Equal(t, int64(1021), value)
+			assert.Equal(t, "987.5", table.Lines[0].Fields[1].Value.String())
+		}
+	}
+}
+
+func TestGetPerformanceDataInvalid(t *testing.T) {
+	_, err := newTestAnalysis().GetPerformanceData(&PerformanceRequest{Time: PerformanceTime{BeginTimestamp: 1, EndTimestamp: 2}})
+	assert.Error(t, err)
+
+	_, err = newTestAnalysis().GetPageSwitchPerformance(context2.Background(), 2, 1)
+	assert.Error(t, err)
+}
+
+// TestGetPerformanceDataAccessTokenOverride 通过 ctx 指定的 access_token 代替客户端默认的 access_token
+func TestGetPerformanceDataAccessTokenOverride(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/business/performance/boot").
+		MatchParam("access_token", "override-token").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	ctx := credential.WithAccessToken(context2.Background(), "override-token")
+	_, err := newTestAnalysis().GetBootPerformance(ctx, 1609603200, 1609689600)
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+}
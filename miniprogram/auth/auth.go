@@ -2,8 +2,13 @@ package auth
 
 import (
 	context2 "context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/util"
@@ -31,11 +36,13 @@ const (
 // Auth 登录/用户信息
 type Auth struct {
 	*context.Context
+
+	sessionTTL time.Duration
 }
 
 // NewAuth new auth
 func NewAuth(ctx *context.Context) *Auth {
-	return &Auth{ctx}
+	return &Auth{Context: ctx}
 }
 
 // ResCode2Session 登录凭证校验的返回结果
@@ -63,7 +70,7 @@ func (auth *Auth) Code2Session(jsCode string) (result ResCode2Session, err error
 // Code2SessionContext 登录凭证校验。
 func (auth *Auth) Code2SessionContext(ctx context2.Context, jsCode string) (result ResCode2Session, err error) {
 	var response []byte
-	if response, err = util.HTTPGetContext(ctx, fmt.Sprintf(code2SessionURL, auth.AppID, auth.AppSecret, jsCode)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(ctx, "auth.Code2SessionContext"), fmt.Sprintf(code2SessionURL, auth.AppID, auth.AppSecret, jsCode)); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &result); err != nil {
@@ -109,7 +116,7 @@ func (auth *Auth) GetPaidUnionID(req *GetPaidUnionIDRequest) (string, error) {
 		url = fmt.Sprintf("%s?access_token=%s&openid=%s&mch_id=%s&out_trade_no=%s", getPaidUnionIDURL, accessToken, req.OpenID, req.MchID, req.OutTradeNo)
 	}
 	var response []byte
-	if response, err = util.HTTPGet(url); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "auth.GetPaidUnionID"), url); err != nil {
 		return "", err
 	}
 	result := &GetPaidUnionIDResponse{}
@@ -133,7 +140,7 @@ func (auth *Auth) CheckEncryptedDataContext(ctx context2.Context, encryptedMsgHa
 	}
 
 	// 由于GetPhoneNumberContext需要传入JSON，所以HTTPPostContext入参改为[]byte
-	if response, err = util.HTTPPostContext(ctx, fmt.Sprintf(checkEncryptedDataURL, at), []byte("encrypted_msg_hash="+encryptedMsgHash), nil); err != nil {
+	if response, err = util.HTTPPostContext(util.WithOperation(ctx, "auth.CheckEncryptedDataAuth"), fmt.Sprintf(checkEncryptedDataURL, at), []byte("encrypted_msg_hash="+encryptedMsgHash), nil); err != nil {
 		return
 	}
 	if err = util.DecodeWithError(response, &result, "CheckEncryptedDataAuth"); err != nil {
@@ -180,7 +187,7 @@ func (auth *Auth) GetPhoneNumberContext(ctx context2.Context, code string) (*Get
 	}
 
 	header := map[string]string{"Content-Type": "application/json;charset=utf-8"}
-	if response, err = util.HTTPPostContext(ctx, fmt.Sprintf(getPhoneNumber, at), bodyBytes, header); err != nil {
+	if response, err = util.HTTPPostContext(util.WithOperation(ctx, "auth.phonenumber.getPhoneNumber"), fmt.Sprintf(getPhoneNumber, at), bodyBytes, header); err != nil {
 		return nil, err
 	}
 
@@ -197,15 +204,42 @@ func (auth *Auth) GetPhoneNumber(code string) (*GetPhoneNumberResponse, error) {
 // CheckSession 检验登录态
 // see https://developers.weixin.qq.com/miniprogram/dev/OpenApiDoc/user-login/checkSessionKey.html
 func (auth *Auth) CheckSession(signature, openID string) error {
-	var (
-		accessToken string
-		err         error
-	)
-	if accessToken, err = auth.GetAccessToken(); err != nil {
+	return auth.checkSessionContext(context2.Background(), signature, openID)
+}
+
+// errCodeInvalidSignature 登录态签名错误，即 session_key 已失效
+const errCodeInvalidSignature = 87009
+
+// ErrSessionExpired session_key 已失效，需要小程序重新调用 wx.login
+var ErrSessionExpired = errors.New("session_key expired")
+
+// CheckSessionKey 检验服务端保存的 session_key 是否仍然有效，失效时返回的错误可通过 errors.Is(err, ErrSessionExpired) 判断
+func (auth *Auth) CheckSessionKey(openID, sessionKey string) error {
+	return auth.CheckSessionKeyContext(context2.Background(), openID, sessionKey)
+}
+
+// CheckSessionKeyContext 检验服务端保存的 session_key 是否仍然有效，失效时返回的错误可通过 errors.Is(err, ErrSessionExpired) 判断
+func (auth *Auth) CheckSessionKeyContext(ctx context2.Context, openID, sessionKey string) error {
+	err := auth.checkSessionContext(ctx, sessionKeySignature(sessionKey), openID)
+	var commonErr *util.CommonError
+	if errors.As(err, &commonErr) && commonErr.ErrCode == errCodeInvalidSignature {
+		return &util.MappedError{Err: ErrSessionExpired, Cause: commonErr}
+	}
+	return err
+}
+
+// sessionKeySignature 用 session_key 对空字符串签名，即 hmac_sha256(session_key, "")
+func sessionKeySignature(sessionKey string) string {
+	return hex.EncodeToString(hmac.New(sha256.New, []byte(sessionKey)).Sum(nil))
+}
+
+func (auth *Auth) checkSessionContext(ctx context2.Context, signature, openID string) error {
+	accessToken, err := auth.GetAccessTokenContext(ctx)
+	if err != nil {
 		return err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(checkSessionURL, accessToken, signature, openID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(ctx, "auth.CheckSession"), fmt.Sprintf(checkSessionURL, accessToken, signature, openID)); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "CheckSession")
@@ -229,7 +263,7 @@ func (auth *Auth) ResetUserSessionKey(signature, openID string) (*ResetUserSessi
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(resetUserSessionKeyURL, accessToken, signature, openID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "auth.ResetUserSessionKey"), fmt.Sprintf(resetUserSessionKeyURL, accessToken, signature, openID)); err != nil {
 		return nil, err
 	}
 	result := &ResetUserSessionKeyResponse{}
@@ -264,7 +298,7 @@ func (auth *Auth) GetPluginOpenPID(code string) (string, error) {
 		Code: code,
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getPluginOpenPIDURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "auth.GetPluginOpenPID"), fmt.Sprintf(getPluginOpenPIDURL, accessToken), req); err != nil {
 		return "", err
 	}
 	result := &GetPluginOpenPIDResponse{}
@@ -298,7 +332,7 @@ func (auth *Auth) GetUserEncryptKey(signature, openID string) (*GetUserEncryptKe
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(getUserEncryptKeyURL, accessToken, signature, openID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "auth.GetUserEncryptKey"), fmt.Sprintf(getUserEncryptKeyURL, accessToken, signature, openID)); err != nil {
 		return nil, err
 	}
 	result := &GetUserEncryptKeyResponse{}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	context2 "context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func TestCheckSessionKey(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxa/checksession").
+		MatchParam("access_token", "mock-access-token").
+		MatchParam("openid", "mock-openid").
+		MatchParam("signature", "74a72783649f5db99f12288fc63af57c0ca57e8d7750b3c18e11e1625a0f3e80").
+		MatchParam("sig_method", "hmac_sha256").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+	gock.New("https://api.weixin.qq.com").Get("/wxa/checksession").
+		Reply(200).JSON(map[string]interface{}{"errcode": 87009, "errmsg": "invalid signature"})
+
+	auth := NewAuth(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+	assert.Nil(t, auth.CheckSessionKey("mock-openid", "mock-session-key"))
+
+	err := auth.CheckSessionKey("mock-openid", "expired-session-key")
+	assert.True(t, errors.Is(err, ErrSessionExpired))
+	var commonErr *util.CommonError
+	if assert.True(t, errors.As(err, &commonErr)) {
+		assert.Equal(t, int64(87009), commonErr.ErrCode.Int64())
+	}
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	context2 "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/credential"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// DefaultSessionTTL 登录态默认有效期
+const DefaultSessionTTL = 24 * time.Hour
+
+// sessionIDLength 登录态 sessionID 的长度
+const sessionIDLength = 32
+
+// ErrSessionNotFound 登录态不存在或已过期，需要小程序重新调用 wx.login
+var ErrSessionNotFound = errors.New("session not found or expired")
+
+// LoginResult 登录结果，可直接返回给小程序，不包含 session_key
+type LoginResult struct {
+	SessionID string `json:"session_id"` // 服务端生成的登录态标识，小程序后续请求携带该值
+	OpenID    string `json:"openid"`
+	UnionID   string `json:"unionid,omitempty"`
+}
+
+// Session 服务端缓存的登录态
+type Session struct {
+	OpenID     string `json:"openid"`
+	UnionID    string `json:"unionid"`
+	SessionKey string `json:"session_key"` // 会话密钥，用于解密 wx.getUserInfo 等接口返回的加密数据，不可下发给小程序
+}
+
+// SetSessionTTL 设置登录态有效期，d <= 0 时使用 DefaultSessionTTL
+func (auth *Auth) SetSessionTTL(d time.Duration) {
+	auth.sessionTTL = d
+}
+
+func (auth *Auth) getSessionTTL() time.Duration {
+	if auth.sessionTTL <= 0 {
+		return DefaultSessionTTL
+	}
+	return auth.sessionTTL
+}
+
+// Login 使用 wx.login 获取的 code 完成登录：调用 code2Session 换取 session_key，
+// 将其缓存在服务端并返回不透明的 sessionID，后续通过 GetSession 取回 session_key 解密数据
+func (auth *Auth) Login(ctx context2.Context, jsCode string) (*LoginResult, error) {
+	if auth.Cache == nil {
+		return nil, errors.New("Login: cache is nil")
+	}
+	res, err := auth.Code2SessionContext(util.WithOperation(ctx, "auth.Login"), jsCode)
+	if err != nil {
+		return nil, err
+	}
+	val, err := json.Marshal(&Session{OpenID: res.OpenID, UnionID: res.UnionID, SessionKey: res.SessionKey})
+	if err != nil {
+		return nil, err
+	}
+	// sessionID 是取回 session_key 的凭证，随机源不可用时直接失败，不退化为可预测的随机数
+	sessionID, err := util.RandomString(sessionIDLength)
+	if err != nil {
+		return nil, fmt.Errorf("Login: generate session id error: %v", err)
+	}
+	if err = cache.SetContext(ctx, auth.Cache, auth.sessionCacheKey(sessionID), string(val), auth.getSessionTTL()); err != nil {
+		return nil, fmt.Errorf("Login: save session error: %v", err)
+	}
+	return &LoginResult{SessionID: sessionID, OpenID: res.OpenID, UnionID: res.UnionID}, nil
+}
+
+// GetSession 根据 Login 返回的 sessionID 获取登录态，不存在或已过期时返回 ErrSessionNotFound
+func (auth *Auth) GetSession(sessionID string) (*Session, error) {
+	return auth.GetSessionContext(context2.Background(), sessionID)
+}
+
+// GetSessionContext 根据 Login 返回的 sessionID 获取登录态，不存在或已过期时返回 ErrSessionNotFound
+func (auth *Auth) GetSessionContext(ctx context2.Context, sessionID string) (*Session, error) {
+	if auth.Cache == nil {
+		return nil, errors.New("GetSession: cache is nil")
+	}
+	if sessionID == "" {
+		return nil, ErrSessionNotFound
+	}
+	val, ok := cache.GetContext(ctx, auth.Cache, auth.sessionCacheKey(sessionID)).(string)
+	if !ok || val == "" {
+		return nil, ErrSessionNotFound
+	}
+	session := new(Session)
+	if err := json.Unmarshal([]byte(val), session); err != nil {
+		return nil, fmt.Errorf("GetSession: unmarshal session error: %v", err)
+	}
+	return session, nil
+}
+
+func (auth *Auth) sessionCacheKey(sessionID string) string {
+	return fmt.Sprintf("%ssession_%s_%s", credential.CacheKeyMiniProgramPrefix, auth.AppID, sessionID)
+}
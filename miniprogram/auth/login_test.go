@@ -0,0 +1,83 @@
+package auth
+
+import (
+	context2 "context"
+	"errors"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func newTestLoginAuth() *Auth {
+	return NewAuth(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid", AppSecret: "mock-secret", Cache: cache.NewMemory()},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestLogin(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/sns/jscode2session").
+		MatchParam("appid", "mock-appid").
+		MatchParam("js_code", "mock-code").
+		Reply(200).JSON(map[string]string{"openid": "mock-openid", "unionid": "mock-unionid", "session_key": "mock-session-key"})
+
+	auth := newTestLoginAuth()
+	result, err := auth.Login(context2.Background(), "mock-code")
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-openid", result.OpenID)
+	assert.Equal(t, "mock-unionid", result.UnionID)
+	assert.Len(t, result.SessionID, sessionIDLength)
+	assert.NotContains(t, result.SessionID, "mock-session-key")
+
+	session, err := auth.GetSession(result.SessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, &Session{OpenID: "mock-openid", UnionID: "mock-unionid", SessionKey: "mock-session-key"}, session)
+
+	_, err = auth.GetSession("unknown-session-id")
+	assert.Equal(t, ErrSessionNotFound, err)
+}
+
+func TestLoginCode2SessionError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/sns/jscode2session").
+		Reply(200).JSON(map[string]interface{}{"errcode": 40029, "errmsg": "invalid code"})
+
+	_, err := newTestLoginAuth().Login(context2.Background(), "bad-code")
+	assert.Contains(t, err.Error(), "40029")
+}
+
+func TestGetSessionExpired(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/sns/jscode2session").
+		Reply(200).JSON(map[string]string{"openid": "mock-openid", "session_key": "mock-session-key"})
+
+	auth := newTestLoginAuth()
+	auth.SetSessionTTL(time.Second)
+	result, err := auth.Login(context2.Background(), "mock-code")
+	assert.Nil(t, err)
+	time.Sleep(1100 * time.Millisecond)
+	_, err = auth.GetSession(result.SessionID)
+	assert.Equal(t, ErrSessionNotFound, err)
+}
+
+// TestLoginRandomError 随机源不可用时登录失败，不保存登录态
+func TestLoginRandomError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/sns/jscode2session").
+		Reply(200).JSON(map[string]string{"openid": "mock-openid", "session_key": "mock-session-key"})
+	util.SetRandReader(iotest.ErrReader(errors.New("mock rand error")))
+	defer util.SetRandReader(nil)
+
+	result, err := newTestLoginAuth().Login(context2.Background(), "mock-code")
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "Login: generate session id error: mock rand error")
+}
@@ -2,6 +2,7 @@ package business
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -40,7 +41,7 @@ func (business *Business) GetPhoneNumberWithContext(ctx context.Context, in *Get
 	}
 
 	uri := fmt.Sprintf(getPhoneNumberURL, accessToken)
-	response, err := util.PostJSONContext(ctx, uri, in)
+	response, err := util.PostJSONContext(util.WithOperation(ctx, "business.business.GetPhoneNumber"), uri, in)
 	if err != nil {
 		return
 	}
@@ -53,3 +54,41 @@ func (business *Business) GetPhoneNumberWithContext(ctx context.Context, in *Get
 	err = util.DecodeWithError(response, &resp, "business.GetPhoneNumber")
 	return resp.PhoneInfo, err
 }
+
+// PhoneCodeErrorKind 手机号获取失败的错误分类
+type PhoneCodeErrorKind int
+
+const (
+	// PhoneCodeErrorUnknown 非微信返回的错误或未知错误码
+	PhoneCodeErrorUnknown PhoneCodeErrorKind = iota
+	// PhoneCodeErrorRetryable code 无效/过期或调用频繁，可引导用户重新点击获取手机号
+	PhoneCodeErrorRetryable
+	// PhoneCodeErrorConfig appid、access_token、权限等配置错误，用户重试无法解决
+	PhoneCodeErrorConfig
+)
+
+// phoneCodeErrorKinds 手机号获取接口错误码分类
+var phoneCodeErrorKinds = map[int64]PhoneCodeErrorKind{
+	-1:    PhoneCodeErrorRetryable, // 系统繁忙
+	40029: PhoneCodeErrorRetryable, // code 无效（已使用或已过期）
+	45011: PhoneCodeErrorRetryable, // API 调用太频繁
+	40001: PhoneCodeErrorConfig,    // access_token 无效
+	40013: PhoneCodeErrorConfig,    // appid 无效
+	40125: PhoneCodeErrorConfig,    // appsecret 无效
+	48001: PhoneCodeErrorConfig,    // 接口未授权
+	61024: PhoneCodeErrorConfig,    // 第三方平台未授权
+}
+
+// ClassifyPhoneCodeError 对 GetPhoneNumber 返回的错误进行分类
+func ClassifyPhoneCodeError(err error) PhoneCodeErrorKind {
+	var commonErr *util.CommonError
+	if !errors.As(err, &commonErr) {
+		return PhoneCodeErrorUnknown
+	}
+	return phoneCodeErrorKinds[commonErr.ErrCode.Int64()]
+}
+
+// IsRetryablePhoneCodeError 判断是否可以让用户重新点击获取手机号
+func IsRetryablePhoneCodeError(err error) bool {
+	return ClassifyPhoneCodeError(err) == PhoneCodeErrorRetryable
+}
@@ -0,0 +1,23 @@
+package business
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestClassifyPhoneCodeError(t *testing.T) {
+	retryable := util.DecodeWithError([]byte(`{"errcode":40029,"errmsg":"invalid code"}`), &struct{ util.CommonError }{}, "business.GetPhoneNumber")
+	assert.True(t, IsRetryablePhoneCodeError(retryable))
+	assert.Equal(t, PhoneCodeErrorRetryable, ClassifyPhoneCodeError(retryable))
+
+	config := util.DecodeWithError([]byte(`{"errcode":40013,"errmsg":"invalid appid"}`), &struct{ util.CommonError }{}, "business.GetPhoneNumber")
+	assert.False(t, IsRetryablePhoneCodeError(config))
+	assert.Equal(t, PhoneCodeErrorConfig, ClassifyPhoneCodeError(config))
+
+	assert.Equal(t, PhoneCodeErrorUnknown, ClassifyPhoneCodeError(errors.New("network error")))
+	assert.Equal(t, PhoneCodeErrorUnknown, ClassifyPhoneCodeError(util.NewCommonError("business.GetPhoneNumber", 99999, "unknown")))
+}
@@ -0,0 +1,82 @@
+package code
+
+import (
+	context2 "context"
+	"fmt"
+	"net/url"
+
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	// getQRCodeURL 获取体验版二维码
+	getQRCodeURL = "https://api.weixin.qq.com/wxa/get_qrcode?access_token=%s"
+	// getPageURL 获取已上传的代码的页面列表
+	getPageURL = "https://api.weixin.qq.com/wxa/get_page?access_token=%s"
+)
+
+// Code 小程序代码管理
+type Code struct {
+	*context.Context
+}
+
+// NewCode 实例化
+func NewCode(ctx *context.Context) *Code {
+	return &Code{ctx}
+}
+
+// GetQRCode 获取体验版二维码，返回图片二进制数据
+// path 为体验版二维码跳转的页面路径（可带参数），为空时跳转默认主页
+// see https://developers.weixin.qq.com/doc/oplatform/openApi/OpenApiDoc/miniprogram-management/code-management/getTrialQRCode.html
+func (code *Code) GetQRCode(path string) ([]byte, error) {
+	return code.GetQRCodeContext(context2.Background(), path)
+}
+
+// GetQRCodeContext 获取体验版二维码，返回图片二进制数据
+func (code *Code) GetQRCodeContext(ctx context2.Context, path string) ([]byte, error) {
+	accessToken, err := code.GetAccessTokenContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf(getQRCodeURL, accessToken)
+	if path != "" {
+		uri += "&path=" + url.QueryEscape(path)
+	}
+	response, contentType, err := util.HTTPGetBytesContext(util.WithOperation(ctx, "code.GetQRCode"), uri)
+	if err != nil {
+		return nil, err
+	}
+	// 未提交代码等情况下返回 json 格式的错误信息
+	if util.IsJSONResponse(contentType, response) {
+		if err = util.DecodeWithCommonError(response, "GetQRCode"); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("GetQRCode error : unexpected json response %s", response)
+	}
+	return response, nil
+}
+
+// GetPage 获取已上传的代码的页面列表，如 ["index", "page/list"]
+// see https://developers.weixin.qq.com/doc/oplatform/openApi/OpenApiDoc/miniprogram-management/code-management/getCodePage.html
+func (code *Code) GetPage() ([]string, error) {
+	return code.GetPageContext(context2.Background())
+}
+
+// GetPageContext 获取已上传的代码的页面列表
+func (code *Code) GetPageContext(ctx context2.Context) ([]string, error) {
+	accessToken, err := code.GetAccessTokenContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := util.HTTPGetContext(util.WithOperation(ctx, "code.GetPage"), fmt.Sprintf(getPageURL, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		util.CommonError
+		PageList []string `json:"page_list"`
+	}
+	err = util.DecodeWithError(response, &res, "GetPage")
+	return res.PageList, err
+}
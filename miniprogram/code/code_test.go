@@ -0,0 +1,62 @@
+package code
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (m mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestCode() *Code {
+	return NewCode(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestGetQRCode(t *testing.T) {
+	defer gock.Off()
+	png := []byte("\x89PNG\r\n\x1a\nmock")
+	gock.New("https://api.weixin.qq.com").Get("/wxa/get_qrcode").
+		MatchParam("path", "pages/index/index\\?a=1").
+		Reply(200).SetHeader("Content-Type", "image/png").BodyString(string(png))
+
+	data, err := newTestCode().GetQRCode("pages/index/index?a=1")
+	assert.Nil(t, err)
+	assert.Equal(t, png, data)
+}
+
+func TestGetQRCodeError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxa/get_qrcode").
+		Reply(200).JSON(map[string]interface{}{"errcode": 85004, "errmsg": "code not exist"})
+
+	data, err := newTestCode().GetQRCode("")
+	assert.Nil(t, data)
+	assert.EqualError(t, err, "GetQRCode Error , errcode=85004 , errmsg=code not exist")
+}
+
+func TestGetPage(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxa/get_page").
+		MatchParam("access_token", "mock-access-token").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok", "page_list": []string{"index", "page/list"}})
+
+	pages, err := newTestCode().GetPage()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"index", "page/list"}, pages)
+}
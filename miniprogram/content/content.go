@@ -1,6 +1,7 @@
 package content
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/miniprogram/context"
@@ -31,7 +32,7 @@ func (content *Content) CheckText(text string) error {
 	if err != nil {
 		return err
 	}
-	response, err := util.PostJSON(
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "content.ContentCheckText"),
 		fmt.Sprintf(checkTextURL, accessToken),
 		map[string]string{
 			"content": text,
@@ -1,6 +1,8 @@
 package context
 
 import (
+	"context"
+
 	"github.com/silenceper/wechat/v2/credential"
 	"github.com/silenceper/wechat/v2/miniprogram/config"
 )
@@ -10,3 +12,8 @@ type Context struct {
 	*config.Config
 	credential.AccessTokenContextHandle
 }
+
+// GetAccessTokenContext 获取 access_token，ctx 中通过 credential.WithAccessToken 指定了 access_token 时优先使用
+func (ctx *Context) GetAccessTokenContext(c context.Context) (string, error) {
+	return credential.GetAccessTokenContext(c, ctx.AccessTokenContextHandle)
+}
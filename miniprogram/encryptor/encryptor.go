@@ -28,12 +28,37 @@ var (
 	ErrAppIDNotMatch = errors.New("app id not match")
 	// ErrInvalidBlockSize block size不合法
 	ErrInvalidBlockSize = errors.New("invalid block size")
+	// ErrInvalidPadding 输入padding失败
+	ErrInvalidPadding = errors.New("invalid padding on input")
+	// ErrBase64Decode base64解码失败
+	ErrBase64Decode = errors.New("base64 decode error")
 	// ErrInvalidPKCS7Data PKCS7数据不合法
-	ErrInvalidPKCS7Data = errors.New("invalid PKCS7 data")
+	ErrInvalidPKCS7Data = &DecryptError{Kind: ErrInvalidBlockSize, Err: errors.New("invalid PKCS7 data")}
 	// ErrInvalidPKCS7Padding 输入padding失败
-	ErrInvalidPKCS7Padding = errors.New("invalid padding on input")
+	// Deprecated: 使用 ErrInvalidPadding
+	ErrInvalidPKCS7Padding = ErrInvalidPadding
 )
 
+// DecryptError 解密错误，可通过 errors.Is 判断错误类型（Kind），通过 errors.Unwrap 获取底层错误
+type DecryptError struct {
+	Kind error
+	Err  error
+}
+
+func (e *DecryptError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Kind, e.Err)
+}
+
+// Unwrap 返回底层错误
+func (e *DecryptError) Unwrap() error {
+	return e.Err
+}
+
+// Is 判断错误类型
+func (e *DecryptError) Is(target error) bool {
+	return e.Kind == target
+}
+
 // PlainData 用户信息/手机号信息
 type PlainData struct {
 	OpenID          string `json:"openId"`
@@ -67,11 +92,11 @@ func pkcs7Unpad(data []byte, blockSize int) ([]byte, error) {
 	c := data[len(data)-1]
 	n := int(c)
 	if n == 0 || n > len(data) {
-		return nil, ErrInvalidPKCS7Padding
+		return nil, ErrInvalidPadding
 	}
 	for i := 0; i < n; i++ {
 		if data[len(data)-n+i] != c {
-			return nil, ErrInvalidPKCS7Padding
+			return nil, ErrInvalidPadding
 		}
 	}
 	return data[:len(data)-n], nil
@@ -81,18 +106,21 @@ func pkcs7Unpad(data []byte, blockSize int) ([]byte, error) {
 func GetCipherText(sessionKey, encryptedData, iv string) ([]byte, error) {
 	aesKey, err := base64.StdEncoding.DecodeString(sessionKey)
 	if err != nil {
-		return nil, err
+		return nil, &DecryptError{Kind: ErrBase64Decode, Err: fmt.Errorf("session key: %w", err)}
 	}
 	cipherText, err := base64.StdEncoding.DecodeString(encryptedData)
 	if err != nil {
-		return nil, err
+		return nil, &DecryptError{Kind: ErrBase64Decode, Err: fmt.Errorf("encrypted data: %w", err)}
 	}
 	ivBytes, err := base64.StdEncoding.DecodeString(iv)
 	if err != nil {
-		return nil, err
+		return nil, &DecryptError{Kind: ErrBase64Decode, Err: fmt.Errorf("iv: %w", err)}
 	}
 	if len(ivBytes) != aes.BlockSize {
-		return nil, fmt.Errorf("bad iv length %d", len(ivBytes))
+		return nil, &DecryptError{Kind: ErrInvalidBlockSize, Err: fmt.Errorf("bad iv length %d", len(ivBytes))}
+	}
+	if len(cipherText) == 0 || len(cipherText)%aes.BlockSize != 0 {
+		return nil, &DecryptError{Kind: ErrInvalidBlockSize, Err: fmt.Errorf("bad encrypted data length %d", len(cipherText))}
 	}
 	block, err := aes.NewCipher(aesKey)
 	if err != nil {
@@ -1,10 +1,16 @@
 package encryptor
 
 import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
 	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
 )
 
 func TestGetCipherText_BadIV(t *testing.T) {
@@ -13,3 +19,69 @@ func TestGetCipherText_BadIV(t *testing.T) {
 	_, err := GetCipherText(keyData, badData, badData)
 	assert.Error(t, err)
 }
+
+// encrypt 使用 AES-CBC 加密并返回 base64 编码的密文，padding 由调用方处理
+func encrypt(t *testing.T, key, iv, plain []byte) string {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cipherText := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(cipherText, plain)
+	return base64.StdEncoding.EncodeToString(cipherText)
+}
+
+func pkcs7Pad(data []byte) []byte {
+	n := aes.BlockSize - len(data)%aes.BlockSize
+	return append(data, bytes.Repeat([]byte{byte(n)}, n)...)
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key := []byte("1234567890123456")
+	iv := []byte("abcdefghijklmnop")
+	sessionKey := base64.StdEncoding.EncodeToString(key)
+	ivStr := base64.StdEncoding.EncodeToString(iv)
+	encryptor := NewEncryptor(&context.Context{Config: &config.Config{AppID: "wx-appid"}})
+
+	tests := []struct {
+		name          string
+		encryptedData string
+		iv            string
+		expected      error
+	}{
+		{
+			name:          "base64 decode",
+			encryptedData: "not base64!",
+			iv:            ivStr,
+			expected:      ErrBase64Decode,
+		},
+		{
+			name:          "invalid block size",
+			encryptedData: base64.StdEncoding.EncodeToString([]byte("short")),
+			iv:            ivStr,
+			expected:      ErrInvalidBlockSize,
+		},
+		{
+			name:          "invalid padding",
+			encryptedData: encrypt(t, key, iv, bytes.Repeat([]byte{'a'}, aes.BlockSize)),
+			iv:            ivStr,
+			expected:      ErrInvalidPadding,
+		},
+		{
+			name:          "app id not match",
+			encryptedData: encrypt(t, key, iv, pkcs7Pad([]byte(`{"openId":"oUser","watermark":{"appid":"wx-other"}}`))),
+			iv:            ivStr,
+			expected:      ErrAppIDNotMatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := encryptor.Decrypt(sessionKey, tt.encryptedData, tt.iv)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	_, err := encryptor.Decrypt(sessionKey, "not base64!", ivStr)
+	var base64Err base64.CorruptInputError
+	assert.ErrorAs(t, err, &base64Err)
+}
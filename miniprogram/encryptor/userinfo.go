@@ -0,0 +1,52 @@
+package encryptor
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// Watermark 敏感数据水印，用于校验数据归属的小程序
+type Watermark struct {
+	Timestamp int64  `json:"timestamp"`
+	AppID     string `json:"appid"`
+}
+
+// UserInfo wx.getUserInfo 返回的加密用户信息
+type UserInfo struct {
+	OpenID    string    `json:"openId"`
+	UnionID   string    `json:"unionId"`
+	NickName  string    `json:"nickName"`
+	Gender    int       `json:"gender"` // 性别，0：未知 1：男性 2：女性
+	City      string    `json:"city"`
+	Province  string    `json:"province"`
+	Country   string    `json:"country"`
+	AvatarURL string    `json:"avatarUrl"`
+	Language  string    `json:"language"`
+	Watermark Watermark `json:"watermark"`
+}
+
+// DecryptUserInfo 解密 wx.getUserInfo 返回的 encryptedData，并校验水印中的 appid
+func (encryptor *Encryptor) DecryptUserInfo(sessionKey, encryptedData, iv string) (*UserInfo, error) {
+	cipherText, err := GetCipherText(sessionKey, encryptedData, iv)
+	if err != nil {
+		return nil, err
+	}
+	var userInfo UserInfo
+	if err = json.Unmarshal(cipherText, &userInfo); err != nil {
+		return nil, err
+	}
+	if userInfo.Watermark.AppID != encryptor.AppID {
+		return nil, ErrAppIDNotMatch
+	}
+	return &userInfo, nil
+}
+
+// VerifySignature 校验 wx.getUserInfo 返回的 rawData 未被篡改，即 signature == SHA1(rawData + sessionKey)
+func VerifySignature(rawData, sessionKey, signature string) bool {
+	sum := sha1.Sum([]byte(rawData + sessionKey))
+	expected := hex.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(signature))) == 1
+}
@@ -0,0 +1,54 @@
+package encryptor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+// 微信官方文档中解密示例的数据
+const (
+	demoAppID         = "wx4f4bc4dec97d474b"
+	demoSessionKey    = "tiihtNczf5v6AKRyjwEUhQ=="
+	demoIV            = "r7BXXKkLb8qrSNn05n0qiA=="
+	demoEncryptedData = "CiyLU1Aw2KjvrjMdj8YKliAjtP4gsMZMQmRzooG2xrDcvSnxIMXFufNstNGTyaGS9uT5geRa0W4oTOb1WT7fJlAC+oNPdbB+3hVbJSRgv+4lGOETKUQz6OYStslQ142dNCuabNPGBzlooOmB231qMM85d2/fV6ChevvXvQP8Hkue1poOFtnEtpyxVLW1zAo6/1Xx1COxFvrc2d7UL/lmHInNlxuacJXwu0fjpXfz/YqYzBIBzD6WUfTIF9GRHpOn/Hz7saL8xz+W//FRAUid1OksQaQx4CMs8LOddcQhULW4ucetDf96JcR3g0gfRK4PC7E/r7Z6xNrXd2UIeorGj5Ef7b1pJAYB6Y5anaHqZ9J6nKEBvB4DnNLIVWSgARns/8wR2SiRS7MNACwTyrGvt9ts8p12PKFdlqYTopNHR1Vf7XjfhQlVsAJdNiKdYmYVoKlaRv85IfVunYzO0IKXsyl7JCUjCpoG20f0a04COwfneQAGGwd5oa+T8yO5hzuyDb/XcxxmK01EpqOyuxINew=="
+)
+
+func TestDecryptUserInfo(t *testing.T) {
+	encryptor := NewEncryptor(&context.Context{Config: &config.Config{AppID: demoAppID}})
+	userInfo, err := encryptor.DecryptUserInfo(demoSessionKey, demoEncryptedData, demoIV)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, "oGZUI0egBJY1zhBYw2KhdUfwVJJE", userInfo.OpenID)
+	assert.Equal(t, "ocMvos6NjeKLIBqg5Mr9QjxrP1FA", userInfo.UnionID)
+	assert.Equal(t, "Band", userInfo.NickName)
+	assert.Equal(t, 1, userInfo.Gender)
+	assert.Equal(t, "Guangzhou", userInfo.City)
+	assert.Equal(t, "Guangdong", userInfo.Province)
+	assert.Equal(t, "CN", userInfo.Country)
+	assert.Equal(t, "zh_CN", userInfo.Language)
+	assert.Equal(t, "http://wx.qlogo.cn/mmopen/vi_32/aSKcBBPpibyKNicHNTMM0qJVh8Kjgiak2AHWr8MHM4WgMEm7GFhsf8OYrySdbvAMvTsw3mo8ibKicsnfN5pRjl1p8HQ/0", userInfo.AvatarURL)
+	assert.Equal(t, Watermark{Timestamp: 1477314187, AppID: demoAppID}, userInfo.Watermark)
+
+	other := NewEncryptor(&context.Context{Config: &config.Config{AppID: "wx-other"}})
+	_, err = other.DecryptUserInfo(demoSessionKey, demoEncryptedData, demoIV)
+	assert.ErrorIs(t, err, ErrAppIDNotMatch)
+}
+
+func TestVerifySignature(t *testing.T) {
+	rawData := `{"nickName":"Band","gender":1,"language":"zh_CN","city":"Guangzhou","province":"Guangdong","country":"CN"}`
+	signature := "e769b3d4ad3895a1d2edb40eaa1822250a2abfd7"
+
+	assert.True(t, VerifySignature(rawData, demoSessionKey, signature))
+	assert.True(t, VerifySignature(rawData, demoSessionKey, strings.ToUpper(signature)))
+
+	tampered := strings.Replace(rawData, "Band", "Bond", 1)
+	assert.False(t, VerifySignature(tampered, demoSessionKey, signature))
+	assert.False(t, VerifySignature(rawData, "other-session-key", signature))
+	assert.False(t, VerifySignature(rawData, demoSessionKey, ""))
+}
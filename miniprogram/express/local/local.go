@@ -0,0 +1,343 @@
+package local
+
+import (
+	context2 "context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	// addOrderURL 下配送单
+	addOrderURL = "https://api.weixin.qq.com/cgi-bin/express/local/business/order/add?access_token=%s"
+	// cancelOrderURL 取消配送单
+	cancelOrderURL = "https://api.weixin.qq.com/cgi-bin/express/local/business/order/cancel?access_token=%s"
+	// getOrderURL 拉取配送单信息
+	getOrderURL = "https://api.weixin.qq.com/cgi-bin/express/local/business/order/get?access_token=%s"
+	// abnormalConfirmURL 异常件退回商家商家确认收货
+	abnormalConfirmURL = "https://api.weixin.qq.com/cgi-bin/express/local/business/order/confirm_return?access_token=%s"
+	// getBindAccountURL 拉取已绑定账号
+	getBindAccountURL = "https://api.weixin.qq.com/cgi-bin/express/local/business/shop/get?access_token=%s"
+	// mockUpdateOrderURL 模拟配送公司更新配送单状态，仅用于测试账号
+	mockUpdateOrderURL = "https://api.weixin.qq.com/cgi-bin/express/local/business/test_update_order?access_token=%s"
+)
+
+// Local 小程序即时配送
+type Local struct {
+	*context.Context
+}
+
+// NewLocal 实例化
+func NewLocal(ctx *context.Context) *Local {
+	return &Local{ctx}
+}
+
+// DeliverySign 计算用于配送公司校验的签名，即 SHA1(shopid + shop_order_id + AppSecret)
+// appSecret 为在配送公司处申请的开发者 AppSecret
+func DeliverySign(shopID, shopOrderID, appSecret string) string {
+	sum := sha1.Sum([]byte(shopID + shopOrderID + appSecret))
+	return hex.EncodeToString(sum[:])
+}
+
+// OrderKey 配送单标识
+type OrderKey struct {
+	ShopID       string `json:"shopid"`        // 商家 id，由配送公司分配的 appkey
+	ShopOrderID  string `json:"shop_order_id"` // 唯一标识订单的 ID，由商户生成
+	ShopNo       string `json:"shop_no"`       // 商家门店编号，在配送公司登记，如果只有一个门店，可以不填
+	DeliverySign string `json:"delivery_sign"` // 用配送公司提供的 appSecret 加密的校验串，见 DeliverySign
+}
+
+// ResultError 配送公司返回的结果码
+type ResultError struct {
+	ResultCode int    `json:"resultcode"` // 配送公司返回的错误码
+	ResultMsg  string `json:"resultmsg"`  // 配送公司返回的错误信息
+}
+
+func (e ResultError) result() ResultError {
+	return e
+}
+
+// DeliveryError errcode 为 0 但配送公司返回非 0 的 resultcode 时返回的错误，可通过 errors.As 获取
+type DeliveryError struct {
+	ResultError
+	apiName string
+}
+
+// Error 实现 error 接口
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("%s Error , resultcode=%d , resultmsg=%s", e.apiName, e.ResultCode, e.ResultMsg)
+}
+
+// Address 发件人/收件人信息
+type Address struct {
+	Name           string  `json:"name"`                      // 姓名，最长不超过 256 个字符
+	City           string  `json:"city"`                      // 城市名称，如广州市
+	Address        string  `json:"address"`                   // 地址，如广东省广州市海珠区 xx 路 xx 号
+	AddressDetail  string  `json:"address_detail"`            // 地址详情，如 xx 小区 xx 栋 xx 号
+	Phone          string  `json:"phone"`                     // 电话/手机号，最长不超过 64 个字符
+	Lng            float64 `json:"lng"`                       // 经度（火星坐标或百度坐标，和 coordinate_type 字段配合使用）
+	Lat            float64 `json:"lat"`                       // 纬度
+	CoordinateType int     `json:"coordinate_type,omitempty"` // 坐标类型，0：火星坐标（高德，腾讯地图均采用火星坐标） 1：百度坐标
+}
+
+// Goods 货物
+type Goods struct {
+	GoodCount int     `json:"good_count"`          // 货物数量
+	GoodName  string  `json:"good_name"`           // 货品名称
+	GoodPrice float64 `json:"good_price"`          // 货品单价，单位为元
+	GoodUnit  string  `json:"good_unit,omitempty"` // 货品单位，最长不超过 20 个字符
+}
+
+// GoodsDetail 货物详情
+type GoodsDetail struct {
+	Goods []Goods `json:"goods"`
+}
+
+// Cargo 货物信息
+type Cargo struct {
+	GoodsValue        float64      `json:"goods_value"`                   // 货物价格，单位为元
+	GoodsHeight       float64      `json:"goods_height,omitempty"`        // 货物高度，单位为 cm
+	GoodsLength       float64      `json:"goods_length,omitempty"`        // 货物长度，单位为 cm
+	GoodsWidth        float64      `json:"goods_width,omitempty"`         // 货物宽度，单位为 cm
+	GoodsWeight       float64      `json:"goods_weight"`                  // 货物重量，单位为 kg
+	GoodsDetail       *GoodsDetail `json:"goods_detail,omitempty"`        // 货物详情
+	GoodsPickupInfo   string       `json:"goods_pickup_info,omitempty"`   // 货物取货信息，用于骑手到店取货
+	GoodsDeliveryInfo string       `json:"goods_delivery_info,omitempty"` // 货物交付信息，用于骑手送货
+	CargoFirstClass   string       `json:"cargo_first_class"`             // 品类一级类目
+	CargoSecondClass  string       `json:"cargo_second_class"`            // 品类二级类目
+}
+
+// OrderInfo 订单信息
+type OrderInfo struct {
+	DeliveryServiceCode  string  `json:"delivery_service_code,omitempty"`  // 配送服务代码，不同配送公司自定义
+	OrderType            int     `json:"order_type,omitempty"`             // 订单类型，0：即时单 1：预约单
+	ExpectedDeliveryTime int64   `json:"expected_delivery_time,omitempty"` // 期望派单时间，unix 时间戳
+	ExpectedFinishTime   int64   `json:"expected_finish_time,omitempty"`   // 期望送达时间，unix 时间戳
+	ExpectedPickTime     int64   `json:"expected_pick_time,omitempty"`     // 期望取件时间，unix 时间戳
+	PoiSeq               string  `json:"poi_seq,omitempty"`                // 门店订单流水号
+	Note                 string  `json:"note,omitempty"`                   // 备注，最长不超过 200 个字符
+	OrderTime            int64   `json:"order_time,omitempty"`             // 用户下单付款时间
+	IsInsured            int     `json:"is_insured,omitempty"`             // 是否保价，0：非保价 1：保价
+	DeclaredValue        float64 `json:"declared_value,omitempty"`         // 保价金额，单位为元
+	Tips                 float64 `json:"tips,omitempty"`                   // 小费，单位为元
+	IsDirectDelivery     int     `json:"is_direct_delivery,omitempty"`     // 是否选择直拿直送，0：不需要 1：需要
+	CashOnDelivery       int     `json:"cash_on_delivery,omitempty"`       // 骑手应付金额，单位为元
+	CashOnPickup         int     `json:"cash_on_pickup,omitempty"`         // 骑手应收金额，单位为元
+	RiderPickMethod      int     `json:"rider_pick_method,omitempty"`      // 物流流向，1：从门店取件送至用户 2：从用户取件送至门店
+	IsFinishCodeNeeded   int     `json:"is_finish_code_needed,omitempty"`  // 收货码，0：不需要 1：需要
+	IsPickupCodeNeeded   int     `json:"is_pickup_code_needed,omitempty"`  // 取货码，0：不需要 1：需要
+}
+
+// Shop 商品信息，会展示到物流通知消息中
+type Shop struct {
+	WxaPath    string `json:"wxa_path"`            // 商家小程序的路径，建议为订单页面
+	ImgURL     string `json:"img_url"`             // 商品缩略图 url
+	GoodsName  string `json:"goods_name"`          // 商品名称
+	GoodsCount int    `json:"goods_count"`         // 商品数量
+	WxaAppID   string `json:"wxa_appid,omitempty"` // 若结算方式为第三方平台代结算，则需填商家小程序 appid
+}
+
+// AddOrderRequest 下配送单请求
+type AddOrderRequest struct {
+	OrderKey
+	DeliveryID    string    `json:"delivery_id"`              // 配送公司 ID
+	OpenID        string    `json:"openid"`                   // 下单用户的 openid
+	DeliveryToken string    `json:"delivery_token,omitempty"` // 预下单接口返回的参数，配送公司可保证在一段时间内运费不变
+	SubBizID      string    `json:"sub_biz_id,omitempty"`     // 子商户 id，区分小程序内部多个子商户
+	Sender        Address   `json:"sender"`                   // 发件人信息，闪送、顺丰同城急送必须填写，美团配送、达达，若传了 shop_no 的值可不填该字段
+	Receiver      Address   `json:"receiver"`                 // 收件人信息
+	Cargo         Cargo     `json:"cargo"`                    // 货物信息
+	OrderInfo     OrderInfo `json:"order_info"`               // 订单信息
+	Shop          Shop      `json:"shop"`                     // 商品信息
+}
+
+// AddOrderResponse 下配送单返回
+type AddOrderResponse struct {
+	util.CommonError
+	ResultError
+	Fee              float64 `json:"fee"`               // 实际运费（单位：元），运费减去优惠券费用
+	DeliverFee       float64 `json:"deliverfee"`        // 运费（单位：元）
+	CouponFee        float64 `json:"couponfee"`         // 优惠券费用（单位：元）
+	Tips             float64 `json:"tips"`              // 小费（单位：元）
+	InsuranceFee     float64 `json:"insurancefee"`      // 保价费（单位：元）
+	Distance         float64 `json:"distance"`          // 配送距离（单位：米）
+	WaybillID        string  `json:"waybill_id"`        // 配送单号
+	OrderStatus      int     `json:"order_status"`      // 配送状态
+	FinishCode       int     `json:"finish_code"`       // 收货码
+	PickupCode       int     `json:"pickup_code"`       // 取货码
+	DispatchDuration int     `json:"dispatch_duration"` // 预计骑手接单时间，单位秒
+}
+
+// AddOrder 下配送单
+// see https://developers.weixin.qq.com/miniprogram/dev/OpenApiDoc/express/express-by-business/addLocalOrder.html
+func (local *Local) AddOrder(req *AddOrderRequest) (*AddOrderResponse, error) {
+	return local.AddOrderContext(context2.Background(), req)
+}
+
+// AddOrderContext 下配送单
+func (local *Local) AddOrderContext(ctx context2.Context, req *AddOrderRequest) (*AddOrderResponse, error) {
+	res := &AddOrderResponse{}
+	if err := local.post(ctx, addOrderURL, req, res, "AddLocalOrder"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelOrderRequest 取消配送单请求
+type CancelOrderRequest struct {
+	OrderKey
+	DeliveryID     string `json:"delivery_id"`             // 快递公司 ID
+	WaybillID      string `json:"waybill_id,omitempty"`    // 配送单 id
+	CancelReasonID int    `json:"cancel_reason_id"`        // 取消原因 Id，1：暂时不需要邮寄 2：价格不合适 3：订单信息有误，重新下单 4：骑手取货不及时 5：骑手配送不及时 6：其他原因
+	CancelReason   string `json:"cancel_reason,omitempty"` // 取消原因，cancel_reason_id 为 6 时需填写
+}
+
+// CancelOrderResponse 取消配送单返回
+type CancelOrderResponse struct {
+	util.CommonError
+	ResultError
+	DeductFee float64 `json:"deduct_fee"` // 预计扣除的违约金（单位：元），精确到分
+	Desc      string  `json:"desc"`       // 说明
+}
+
+// CancelOrder 取消配送单
+func (local *Local) CancelOrder(req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return local.CancelOrderContext(context2.Background(), req)
+}
+
+// CancelOrderContext 取消配送单
+func (local *Local) CancelOrderContext(ctx context2.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	res := &CancelOrderResponse{}
+	if err := local.post(ctx, cancelOrderURL, req, res, "CancelLocalOrder"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetOrderResponse 拉取配送单信息返回
+type GetOrderResponse struct {
+	util.CommonError
+	ResultError
+	OrderStatus int     `json:"order_status"` // 配送状态
+	WaybillID   string  `json:"waybill_id"`   // 配送单号
+	RiderName   string  `json:"rider_name"`   // 骑手姓名
+	RiderPhone  string  `json:"rider_phone"`  // 骑手电话
+	RiderLng    float64 `json:"rider_lng"`    // 骑手位置经度，配送中时返回
+	RiderLat    float64 `json:"rider_lat"`    // 骑手位置纬度，配送中时返回
+	ReachTime   int64   `json:"reach_time"`   // 预计还剩多久送达时间，单位秒，配送中时返回
+}
+
+// GetOrder 拉取配送单信息
+func (local *Local) GetOrder(key *OrderKey) (*GetOrderResponse, error) {
+	return local.GetOrderContext(context2.Background(), key)
+}
+
+// GetOrderContext 拉取配送单信息
+func (local *Local) GetOrderContext(ctx context2.Context, key *OrderKey) (*GetOrderResponse, error) {
+	res := &GetOrderResponse{}
+	if err := local.post(ctx, getOrderURL, key, res, "GetLocalOrder"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AbnormalConfirmRequest 异常件退回商家商家确认收货请求
+type AbnormalConfirmRequest struct {
+	OrderKey
+	WaybillID string `json:"waybill_id"`       // 配送单 id
+	Remark    string `json:"remark,omitempty"` // 备注
+}
+
+// ResultResponse 仅包含结果码的返回
+type ResultResponse struct {
+	util.CommonError
+	ResultError
+}
+
+// AbnormalConfirm 异常件退回商家商家确认收货
+func (local *Local) AbnormalConfirm(req *AbnormalConfirmRequest) (*ResultResponse, error) {
+	return local.AbnormalConfirmContext(context2.Background(), req)
+}
+
+// AbnormalConfirmContext 异常件退回商家商家确认收货
+func (local *Local) AbnormalConfirmContext(ctx context2.Context, req *AbnormalConfirmRequest) (*ResultResponse, error) {
+	res := &ResultResponse{}
+	if err := local.post(ctx, abnormalConfirmURL, req, res, "AbnormalConfirm"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BindAccount 已绑定的配送公司账号
+type BindAccount struct {
+	DeliveryID  string `json:"delivery_id"`  // 配送公司 ID
+	ShopID      string `json:"shopid"`       // 商家 id
+	AuditResult int    `json:"audit_result"` // 审核状态
+}
+
+// GetBindAccountResponse 拉取已绑定账号返回
+type GetBindAccountResponse struct {
+	util.CommonError
+	ShopList []BindAccount `json:"shop_list"`
+}
+
+// GetBindAccount 拉取已绑定账号
+func (local *Local) GetBindAccount() ([]BindAccount, error) {
+	return local.GetBindAccountContext(context2.Background())
+}
+
+// GetBindAccountContext 拉取已绑定账号
+func (local *Local) GetBindAccountContext(ctx context2.Context) ([]BindAccount, error) {
+	res := &GetBindAccountResponse{}
+	if err := local.post(ctx, getBindAccountURL, struct{}{}, res, "GetBindAccount"); err != nil {
+		return nil, err
+	}
+	return res.ShopList, nil
+}
+
+// MockUpdateOrderRequest 模拟配送公司更新配送单状态请求
+type MockUpdateOrderRequest struct {
+	ShopID      string `json:"shopid"`               // 商家 id，必须是 test_shop_id
+	ShopOrderID string `json:"shop_order_id"`        // 唯一标识订单的 ID，由商户生成
+	ActionTime  int64  `json:"action_time"`          // 状态变更时间点，Unix 秒级时间戳
+	OrderStatus int    `json:"order_status"`         // 配送状态
+	ActionMsg   string `json:"action_msg,omitempty"` // 附加信息
+}
+
+// MockUpdateOrder 模拟配送公司更新配送单状态，仅用于测试账号联调
+func (local *Local) MockUpdateOrder(req *MockUpdateOrderRequest) (*ResultResponse, error) {
+	return local.MockUpdateOrderContext(context2.Background(), req)
+}
+
+// MockUpdateOrderContext 模拟配送公司更新配送单状态
+func (local *Local) MockUpdateOrderContext(ctx context2.Context, req *MockUpdateOrderRequest) (*ResultResponse, error) {
+	res := &ResultResponse{}
+	if err := local.post(ctx, mockUpdateOrderURL, req, res, "MockUpdateLocalOrder"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// post 发送请求并解析返回，配送公司返回非 0 的 resultcode 时返回 *DeliveryError
+func (local *Local) post(ctx context2.Context, urlFormat string, req, res interface{}, apiName string) error {
+	accessToken, err := local.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	var response []byte
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "local."+apiName), fmt.Sprintf(urlFormat, accessToken), req); err != nil {
+		return err
+	}
+	if err = util.DecodeWithError(response, res, apiName); err != nil {
+		return err
+	}
+	if r, ok := res.(interface{ result() ResultError }); ok {
+		if result := r.result(); result.ResultCode != 0 {
+			return &DeliveryError{ResultError: result, apiName: apiName}
+		}
+	}
+	return nil
+}
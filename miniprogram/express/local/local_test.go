@@ -0,0 +1,116 @@
+package local
+
+import (
+	context2 "context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestLocal() *Local {
+	return NewLocal(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestDeliverySign(t *testing.T) {
+	// sha1("test_shop_id" + "order-1" + "test_app_secrect")
+	assert.Equal(t, "47cbc3215cd6ebfe27273c21be1a233c4ff01eb1", DeliverySign("test_shop_id", "order-1", "test_app_secrect"))
+}
+
+func TestAddOrder(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/express/local/business/order/add").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"shopid":"test_shop_id","shop_order_id":"order-1".*"receiver":\{"name":"张三","city":"广州市"`).
+		Reply(200).JSON(map[string]interface{}{
+		"errcode":      0,
+		"resultcode":   0,
+		"resultmsg":    "ok",
+		"fee":          11,
+		"deliverfee":   11,
+		"distance":     1008,
+		"waybill_id":   "123456789",
+		"order_status": 101,
+		"finish_code":  1024,
+	})
+
+	res, err := newTestLocal().AddOrder(&AddOrderRequest{
+		OrderKey: OrderKey{
+			ShopID:       "test_shop_id",
+			ShopOrderID:  "order-1",
+			DeliverySign: DeliverySign("test_shop_id", "order-1", "test_app_secrect"),
+		},
+		DeliveryID: "TEST",
+		OpenID:     "mock-openid",
+		Sender:     Address{Name: "商家", City: "广州市", Address: "海珠区", Phone: "020-12345678", Lng: 113.3, Lat: 23.1},
+		Receiver:   Address{Name: "张三", City: "广州市", Address: "天河区", Phone: "13800000000", Lng: 113.4, Lat: 23.2},
+		Cargo: Cargo{
+			GoodsValue:       20,
+			GoodsWeight:      1,
+			GoodsDetail:      &GoodsDetail{Goods: []Goods{{GoodCount: 1, GoodName: "奶茶", GoodPrice: 20}}},
+			CargoFirstClass:  "美食宵夜",
+			CargoSecondClass: "零食小吃",
+		},
+		OrderInfo: OrderInfo{IsFinishCodeNeeded: 1},
+		Shop:      Shop{WxaPath: "/pages/order/index", GoodsName: "奶茶", GoodsCount: 1},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "123456789", res.WaybillID)
+	assert.Equal(t, 101, res.OrderStatus)
+	assert.Equal(t, float64(1008), res.Distance)
+	assert.Equal(t, 1024, res.FinishCode)
+	assert.True(t, gock.IsDone())
+}
+
+func TestAddOrderError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/express/local/business/order/add").
+		Reply(200).JSON(map[string]interface{}{"errcode": 934016, "errmsg": "shop order id exist"})
+
+	res, err := newTestLocal().AddOrder(&AddOrderRequest{})
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}
+
+func TestAddOrderResultCode(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/express/local/business/order/add").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "resultcode": 1001, "resultmsg": "配送范围超出"})
+
+	res, err := newTestLocal().AddOrder(&AddOrderRequest{})
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "AddLocalOrder Error , resultcode=1001 , resultmsg=配送范围超出")
+	var deliveryErr *DeliveryError
+	if assert.True(t, errors.As(err, &deliveryErr)) {
+		assert.Equal(t, 1001, deliveryErr.ResultCode)
+	}
+}
+
+func TestGetBindAccountWithoutResultCode(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/express/local/business/shop/get").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "shop_list": []map[string]interface{}{
+		{"delivery_id": "SFTC", "shopid": "test_shop_id", "audit_result": 0},
+	}})
+
+	accounts, err := newTestLocal().GetBindAccount()
+	assert.Nil(t, err)
+	assert.Equal(t, []BindAccount{{DeliveryID: "SFTC", ShopID: "test_shop_id"}}, accounts)
+}
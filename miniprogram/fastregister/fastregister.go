@@ -0,0 +1,176 @@
+// Package fastregister 第三方平台快速注册企业小程序
+package fastregister
+
+import (
+	context2 "context"
+	"errors"
+	"fmt"
+
+	openContext "github.com/silenceper/wechat/v2/openplatform/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	fastRegisterURL = "https://api.weixin.qq.com/cgi-bin/component/fastregisterweapp?action=%s&component_access_token=%s"
+)
+
+// CodeType 企业代码类型
+type CodeType string
+
+const (
+	// CodeTypeCreditCode 统一社会信用代码（18 位）
+	CodeTypeCreditCode CodeType = "1"
+	// CodeTypeOrganizationCode 组织机构代码（9 位 xxxxxxxx-x）
+	CodeTypeOrganizationCode CodeType = "2"
+	// CodeTypeLicenseNumber 营业执照注册号（15 位）
+	CodeTypeLicenseNumber CodeType = "3"
+)
+
+// 查询创建任务状态时通过 errcode 返回的任务状态
+const (
+	// errCodeTaskNotFound 任务不存在
+	errCodeTaskNotFound = 89250
+	// errCodeLegalPersonChecking 法人&企业信息一致性校验中
+	errCodeLegalPersonChecking = 89251
+	// errCodeLegalPersonMismatch 法人&企业信息一致性校验未通过
+	errCodeLegalPersonMismatch = 89252
+	// errCodeTaskInProgress 该主体已有任务执行中，距上次任务 24h 后再试
+	errCodeTaskInProgress = 89249
+)
+
+// RegisterStatus 创建任务状态
+type RegisterStatus string
+
+const (
+	// RegisterStatusConfirmed 任务已提交且法人已确认，审核结果通过 notify_third_fasteregister 事件推送
+	RegisterStatusConfirmed RegisterStatus = "confirmed"
+	// RegisterStatusChecking 法人&企业信息一致性校验中
+	RegisterStatusChecking RegisterStatus = "checking"
+	// RegisterStatusInProgress 该主体已有任务执行中
+	RegisterStatusInProgress RegisterStatus = "in_progress"
+	// RegisterStatusMismatch 法人&企业信息一致性校验未通过
+	RegisterStatusMismatch RegisterStatus = "mismatch"
+	// RegisterStatusNotFound 任务不存在
+	RegisterStatusNotFound RegisterStatus = "not_found"
+)
+
+// FastRegister 快速注册企业小程序
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/Register_Mini_Programs/Fast_Registration_Interface_document.html
+type FastRegister struct {
+	*openContext.Context
+}
+
+// NewFastRegister 实例化
+func NewFastRegister(ctx *openContext.Context) *FastRegister {
+	return &FastRegister{ctx}
+}
+
+// RegisterInfo 企业及法人信息
+type RegisterInfo struct {
+	Name               string   `json:"name"`                      // 企业名
+	Code               string   `json:"code"`                      // 企业代码
+	CodeType           CodeType `json:"code_type"`                 // 企业代码类型
+	LegalPersonaWechat string   `json:"legal_persona_wechat"`      // 法人微信号
+	LegalPersonaName   string   `json:"legal_persona_name"`        // 法人姓名（绑定银行卡）
+	ComponentPhone     string   `json:"component_phone,omitempty"` // 第三方联系电话
+}
+
+// SearchResult 查询创建任务状态的结果
+type SearchResult struct {
+	util.CommonError
+
+	Status RegisterStatus `json:"-"` // 由 errcode 得到的任务状态
+}
+
+// NotifyResult notify_third_fasteregister 事件推送的注册结果
+type NotifyResult struct {
+	AppID    string       `json:"appid"`     // 创建的小程序 appid
+	Status   int          `json:"status"`    // 0 为创建成功，其他为错误码
+	AuthCode string       `json:"auth_code"` // 第三方授权码
+	Msg      string       `json:"msg"`       // 错误信息
+	Info     RegisterInfo `json:"info"`      // 注册时提交的企业及法人信息
+}
+
+// Register 提交快速创建小程序任务，提交后法人会收到微信通知，确认后进入审核
+func (register *FastRegister) Register(name, code, codeType, legalPersonaWechat, legalPersonaName, componentPhone string) error {
+	return register.RegisterContext(context2.Background(), name, code, codeType, legalPersonaWechat, legalPersonaName, componentPhone)
+}
+
+// RegisterContext 提交快速创建小程序任务
+func (register *FastRegister) RegisterContext(ctx context2.Context, name, code, codeType, legalPersonaWechat, legalPersonaName, componentPhone string) error {
+	info := &RegisterInfo{
+		Name:               name,
+		Code:               code,
+		CodeType:           CodeType(codeType),
+		LegalPersonaWechat: legalPersonaWechat,
+		LegalPersonaName:   legalPersonaName,
+		ComponentPhone:     componentPhone,
+	}
+	if err := info.validate(); err != nil {
+		return err
+	}
+	response, err := register.post(ctx, "create", info)
+	if err != nil {
+		return err
+	}
+	return util.DecodeWithCommonError(response, "FastRegisterWeapp")
+}
+
+// SearchStatus 查询创建任务状态，任务状态通过 Status 返回，其他错误码返回 error
+func (register *FastRegister) SearchStatus(name, legalPersonaWechat, legalPersonaName string) (*SearchResult, error) {
+	return register.SearchStatusContext(context2.Background(), name, legalPersonaWechat, legalPersonaName)
+}
+
+// SearchStatusContext 查询创建任务状态
+func (register *FastRegister) SearchStatusContext(ctx context2.Context, name, legalPersonaWechat, legalPersonaName string) (*SearchResult, error) {
+	if name == "" || legalPersonaWechat == "" || legalPersonaName == "" {
+		return nil, errors.New("name, legal_persona_wechat and legal_persona_name are required")
+	}
+	response, err := register.post(ctx, "search", map[string]string{
+		"name":                 name,
+		"legal_persona_wechat": legalPersonaWechat,
+		"legal_persona_name":   legalPersonaName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &SearchResult{}
+	if err = util.DecodeWithError(response, result, "FastRegisterWeappSearch"); err == nil {
+		result.Status = RegisterStatusConfirmed
+		return result, nil
+	}
+	switch result.ErrCode {
+	case errCodeLegalPersonChecking:
+		result.Status = RegisterStatusChecking
+	case errCodeTaskInProgress:
+		result.Status = RegisterStatusInProgress
+	case errCodeLegalPersonMismatch:
+		result.Status = RegisterStatusMismatch
+	case errCodeTaskNotFound:
+		result.Status = RegisterStatusNotFound
+	default:
+		return nil, err
+	}
+	return result, nil
+}
+
+func (register *FastRegister) post(ctx context2.Context, action string, req interface{}) ([]byte, error) {
+	componentAccessToken, err := register.GetComponentAccessTokenContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return util.PostJSONContext(util.WithOperation(ctx, "fastregister."+action),
+		fmt.Sprintf(fastRegisterURL, action, componentAccessToken), req)
+}
+
+func (info *RegisterInfo) validate() error {
+	if info.Name == "" || info.Code == "" || info.LegalPersonaWechat == "" || info.LegalPersonaName == "" {
+		return errors.New("name, code, legal_persona_wechat and legal_persona_name are required")
+	}
+	switch info.CodeType {
+	case CodeTypeCreditCode, CodeTypeOrganizationCode, CodeTypeLicenseNumber:
+		return nil
+	default:
+		return fmt.Errorf("invalid code_type %q, must be 1, 2 or 3", info.CodeType)
+	}
+}
@@ -0,0 +1,79 @@
+package fastregister
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/openplatform/config"
+	openContext "github.com/silenceper/wechat/v2/openplatform/context"
+)
+
+func newTestFastRegister(t *testing.T) *FastRegister {
+	memCache := cache.NewMemory()
+	if err := memCache.Set("component_access_token_mock-component-appid", "mock-component-token", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	return NewFastRegister(&openContext.Context{Config: &config.Config{AppID: "mock-component-appid", Cache: memCache}})
+}
+
+func TestRegister(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/component/fastregisterweapp").
+		MatchParam("action", "create").
+		MatchParam("component_access_token", "mock-component-token").
+		BodyString(`"name":"mock company","code":"91440300MA5EXAMPLE","code_type":"1","legal_persona_wechat":"legal_wechat"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	err := newTestFastRegister(t).Register("mock company", "91440300MA5EXAMPLE", "1", "legal_wechat", "张三", "13800000000")
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+
+	// 参数校验失败时不发起请求
+	err = newTestFastRegister(t).Register("mock company", "91440300MA5EXAMPLE", "4", "legal_wechat", "张三", "")
+	assert.EqualError(t, err, `invalid code_type "4", must be 1, 2 or 3`)
+}
+
+func TestSearchStatus(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/component/fastregisterweapp").
+		MatchParam("action", "search").
+		BodyString(`"legal_persona_name":"张三"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/component/fastregisterweapp").
+		MatchParam("action", "search").
+		Reply(200).JSON(map[string]interface{}{"errcode": 89251, "errmsg": "legal person checking"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/component/fastregisterweapp").
+		MatchParam("action", "search").
+		Reply(200).JSON(map[string]interface{}{"errcode": 86004, "errmsg": "invalid wechat"})
+
+	register := newTestFastRegister(t)
+	result, err := register.SearchStatus("mock company", "legal_wechat", "张三")
+	if assert.Nil(t, err) {
+		assert.Equal(t, RegisterStatusConfirmed, result.Status)
+	}
+	result, err = register.SearchStatus("mock company", "legal_wechat", "张三")
+	if assert.Nil(t, err) {
+		assert.Equal(t, RegisterStatusChecking, result.Status)
+		assert.Equal(t, "legal person checking", result.ErrMsg)
+	}
+	_, err = register.SearchStatus("mock company", "legal_wechat", "张三")
+	assert.EqualError(t, err, "FastRegisterWeappSearch Error , errcode=86004 , errmsg=invalid wechat")
+	assert.True(t, gock.IsDone())
+}
+
+func TestNotifyResult(t *testing.T) {
+	var result NotifyResult
+	err := json.Unmarshal([]byte(`{"appid":"wx1234567890","status":0,"auth_code":"mock-auth-code","msg":"OK",`+
+		`"info":{"name":"mock company","code":"91440300MA5EXAMPLE","code_type":"1","legal_persona_wechat":"legal_wechat",`+
+		`"legal_persona_name":"张三","component_phone":"13800000000"}}`), &result)
+	assert.Nil(t, err)
+	assert.Equal(t, "wx1234567890", result.AppID)
+	assert.Equal(t, "mock-auth-code", result.AuthCode)
+	assert.Equal(t, CodeTypeCreditCode, result.Info.CodeType)
+	assert.Equal(t, "张三", result.Info.LegalPersonaName)
+}
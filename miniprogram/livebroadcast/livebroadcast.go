@@ -0,0 +1,162 @@
+package livebroadcast
+
+import (
+	context2 "context"
+	"fmt"
+	"net/url"
+
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	// addAssistantURL 添加管理直播间小助手
+	addAssistantURL = "https://api.weixin.qq.com/wxaapi/broadcast/room/addassistant?access_token=%s"
+	// removeAssistantURL 删除管理直播间小助手
+	removeAssistantURL = "https://api.weixin.qq.com/wxaapi/broadcast/room/removeassistant?access_token=%s"
+	// getAssistantListURL 查询管理直播间小助手
+	getAssistantListURL = "https://api.weixin.qq.com/wxaapi/broadcast/room/getassistantlist?access_token=%s&roomId=%d"
+	// getSharedCodeURL 获取直播间分享二维码
+	getSharedCodeURL = "https://api.weixin.qq.com/wxaapi/broadcast/room/getsharedcode?access_token=%s&roomId=%d"
+	// updateCommentURL 开启/关闭直播间全局禁言
+	updateCommentURL = "https://api.weixin.qq.com/wxaapi/broadcast/room/updatecomment?access_token=%s"
+)
+
+// LiveBroadcast 小程序直播
+type LiveBroadcast struct {
+	*context.Context
+}
+
+// NewLiveBroadcast 实例化
+func NewLiveBroadcast(ctx *context.Context) *LiveBroadcast {
+	return &LiveBroadcast{ctx}
+}
+
+// Assistant 直播间小助手
+type Assistant struct {
+	Username string `json:"username"` // 小助手微信号
+	Nickname string `json:"nickname"` // 小助手昵称
+}
+
+// AddAssistant 添加管理直播间小助手
+// see https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api-assistant.html
+func (live *LiveBroadcast) AddAssistant(roomID int64, users []Assistant) error {
+	return live.AddAssistantContext(context2.Background(), roomID, users)
+}
+
+// AddAssistantContext 添加管理直播间小助手
+func (live *LiveBroadcast) AddAssistantContext(ctx context2.Context, roomID int64, users []Assistant) error {
+	req := map[string]interface{}{
+		"roomId": roomID,
+		"users":  users,
+	}
+	return live.postWithCommonError(ctx, addAssistantURL, req, "AddAssistant")
+}
+
+// RemoveAssistant 删除管理直播间小助手
+func (live *LiveBroadcast) RemoveAssistant(roomID int64, username string) error {
+	return live.RemoveAssistantContext(context2.Background(), roomID, username)
+}
+
+// RemoveAssistantContext 删除管理直播间小助手
+func (live *LiveBroadcast) RemoveAssistantContext(ctx context2.Context, roomID int64, username string) error {
+	req := map[string]interface{}{
+		"roomId":   roomID,
+		"username": username,
+	}
+	return live.postWithCommonError(ctx, removeAssistantURL, req, "RemoveAssistant")
+}
+
+// AssistantInfo 直播间小助手信息
+type AssistantInfo struct {
+	Timestamp int64  `json:"timestamp"` // 修改时间
+	Headimg   string `json:"headimg"`   // 头像
+	Nickname  string `json:"nickname"`  // 昵称
+	Alias     string `json:"alias"`     // 微信号
+	OpenID    string `json:"openid"`    // openid
+}
+
+// GetAssistantListResponse 查询管理直播间小助手返回
+type GetAssistantListResponse struct {
+	util.CommonError
+	List     []AssistantInfo `json:"list"`
+	Count    int             `json:"count"`    // 小助手个数
+	MaxCount int             `json:"maxCount"` // 小助手最大个数
+}
+
+// GetAssistantList 查询管理直播间小助手
+func (live *LiveBroadcast) GetAssistantList(roomID int64) (res GetAssistantListResponse, err error) {
+	return live.GetAssistantListContext(context2.Background(), roomID)
+}
+
+// GetAssistantListContext 查询管理直播间小助手
+func (live *LiveBroadcast) GetAssistantListContext(ctx context2.Context, roomID int64) (res GetAssistantListResponse, err error) {
+	var accessToken string
+	if accessToken, err = live.GetAccessTokenContext(ctx); err != nil {
+		return
+	}
+	var response []byte
+	if response, err = util.HTTPGetContext(util.WithOperation(ctx, "livebroadcast.GetAssistantList"), fmt.Sprintf(getAssistantListURL, accessToken, roomID)); err != nil {
+		return
+	}
+	err = util.DecodeWithError(response, &res, "GetAssistantList")
+	return
+}
+
+// GetSharedCodeResponse 获取直播间分享二维码返回
+type GetSharedCodeResponse struct {
+	util.CommonError
+	CdnURL    string `json:"cdnUrl"`    // 分享二维码
+	PagePath  string `json:"pagePath"`  // 分享路径
+	PosterURL string `json:"posterUrl"` // 分享海报
+}
+
+// GetSharedCode 获取直播间分享二维码，params 为自定义参数，可为空
+func (live *LiveBroadcast) GetSharedCode(roomID int64, params string) (res GetSharedCodeResponse, err error) {
+	return live.GetSharedCodeContext(context2.Background(), roomID, params)
+}
+
+// GetSharedCodeContext 获取直播间分享二维码
+func (live *LiveBroadcast) GetSharedCodeContext(ctx context2.Context, roomID int64, params string) (res GetSharedCodeResponse, err error) {
+	var accessToken string
+	if accessToken, err = live.GetAccessTokenContext(ctx); err != nil {
+		return
+	}
+	uri := fmt.Sprintf(getSharedCodeURL, accessToken, roomID)
+	if params != "" {
+		uri += "&params=" + url.QueryEscape(params)
+	}
+	var response []byte
+	if response, err = util.HTTPGetContext(util.WithOperation(ctx, "livebroadcast.GetSharedCode"), uri); err != nil {
+		return
+	}
+	err = util.DecodeWithError(response, &res, "GetSharedCode")
+	return
+}
+
+// UpdateComment 开启/关闭直播间全局禁言，banComment 1：禁言，0：取消禁言
+func (live *LiveBroadcast) UpdateComment(roomID int64, banComment int) error {
+	return live.UpdateCommentContext(context2.Background(), roomID, banComment)
+}
+
+// UpdateCommentContext 开启/关闭直播间全局禁言
+func (live *LiveBroadcast) UpdateCommentContext(ctx context2.Context, roomID int64, banComment int) error {
+	req := map[string]interface{}{
+		"roomId":     roomID,
+		"banComment": banComment,
+	}
+	return live.postWithCommonError(ctx, updateCommentURL, req, "UpdateComment")
+}
+
+// postWithCommonError 发送 POST 请求并解析通用错误
+func (live *LiveBroadcast) postWithCommonError(ctx context2.Context, urlFormat string, req interface{}, apiName string) error {
+	accessToken, err := live.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	var response []byte
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "livebroadcast."+apiName), fmt.Sprintf(urlFormat, accessToken), req); err != nil {
+		return err
+	}
+	return util.DecodeWithCommonError(response, apiName)
+}
@@ -0,0 +1,77 @@
+package livebroadcast
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestLiveBroadcast() *LiveBroadcast {
+	return NewLiveBroadcast(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestAssistant(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxaapi/broadcast/room/addassistant").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`{"roomId":1001,"users":\[{"username":"assistant_1","nickname":"小助手"}\]}`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+	gock.New("https://api.weixin.qq.com").Get("/wxaapi/broadcast/room/getassistantlist").
+		MatchParam("roomId", "1001").
+		Reply(200).JSON(map[string]interface{}{
+		"errcode":  0,
+		"list":     []map[string]interface{}{{"nickname": "小助手", "alias": "assistant_1", "openid": "mock-openid"}},
+		"count":    1,
+		"maxCount": 5,
+	})
+	gock.New("https://api.weixin.qq.com").Post("/wxaapi/broadcast/room/removeassistant").
+		BodyString(`{"roomId":1001,"username":"assistant_1"}`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 300036, "errmsg": "assistant not exist"})
+
+	live := newTestLiveBroadcast()
+	assert.Nil(t, live.AddAssistant(1001, []Assistant{{Username: "assistant_1", Nickname: "小助手"}}))
+
+	list, err := live.GetAssistantList(1001)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, list.Count)
+	assert.Equal(t, 5, list.MaxCount)
+	assert.Equal(t, "assistant_1", list.List[0].Alias)
+
+	err = live.RemoveAssistant(1001, "assistant_1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "300036")
+	assert.True(t, gock.IsDone())
+}
+
+func TestUpdateComment(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxaapi/broadcast/room/updatecomment").
+		BodyString(`{"banComment":1,"roomId":1001}`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+	gock.New("https://api.weixin.qq.com").Post("/wxaapi/broadcast/room/updatecomment").
+		BodyString(`{"banComment":0,"roomId":1001}`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+
+	live := newTestLiveBroadcast()
+	assert.Nil(t, live.UpdateComment(1001, 1))
+	assert.Nil(t, live.UpdateCommentContext(context2.Background(), 1001, 0))
+	assert.True(t, gock.IsDone())
+}
@@ -1,6 +1,7 @@
 package message
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/miniprogram/context"
@@ -115,7 +116,7 @@ func (manager *Manager) Send(msg *CustomerMessage) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerSendMessage, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "message.SendCustomerMessage"), uri, msg)
 	if err != nil {
 		return err
 	}
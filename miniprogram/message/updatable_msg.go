@@ -1,6 +1,7 @@
 package message
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/miniprogram/context"
@@ -46,7 +47,7 @@ func (updatableMessage *UpdatableMessage) CreateActivityID() (res CreateActivity
 	}
 
 	uri := fmt.Sprintf(createActivityURL, accessToken)
-	response, err := util.HTTPGet(uri)
+	response, err := util.HTTPGetContext(util.WithOperation(context2.Background(), "message.CreateActivityID"), uri)
 	if err != nil {
 		return
 	}
@@ -68,7 +69,7 @@ func (updatableMessage *UpdatableMessage) SetUpdatableMsg(activityID string, tar
 		TemplateInfo: template,
 	}
 
-	response, err := util.PostJSON(uri, data)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "message.SendUpdatableMsg"), uri, data)
 	if err != nil {
 		return
 	}
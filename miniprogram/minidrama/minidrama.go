@@ -87,7 +87,7 @@ func (s *MiniDrama) PullUpload(ctx context.Context, in *PullUploadRequest) (out
 		return
 	}
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.PullUpload"), address, in); err != nil {
 		return
 	}
 
@@ -104,7 +104,7 @@ func (s *MiniDrama) GetTask(ctx context.Context, in *GetTaskRequest) (out GetTas
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.GetTask"), address, in); err != nil {
 		return
 	}
 
@@ -121,7 +121,7 @@ func (s *MiniDrama) ApplyUpload(ctx context.Context, in *ApplyUploadRequest) (ou
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.ApplyUpload"), address, in); err != nil {
 		return
 	}
 
@@ -177,7 +177,7 @@ func (s *MiniDrama) CommitUpload(ctx context.Context, in *CommitUploadRequest) (
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.CommitUpload"), address, in); err != nil {
 		return
 	}
 
@@ -194,7 +194,7 @@ func (s *MiniDrama) ListMedia(ctx context.Context, in *ListMediaRequest) (out Li
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.ListMedia"), address, in); err != nil {
 		return
 	}
 
@@ -211,7 +211,7 @@ func (s *MiniDrama) GetMedia(ctx context.Context, in *GetMediaRequest) (out GetM
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.GetMedia"), address, in); err != nil {
 		return
 	}
 
@@ -228,7 +228,7 @@ func (s *MiniDrama) GetMediaLink(ctx context.Context, in *GetMediaLinkRequest) (
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.GetMediaLink"), address, in); err != nil {
 		return
 	}
 
@@ -245,7 +245,7 @@ func (s *MiniDrama) DeleteMedia(ctx context.Context, in *DeleteMediaRequest) (ou
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.DeleteMedia"), address, in); err != nil {
 		return
 	}
 
@@ -262,7 +262,7 @@ func (s *MiniDrama) AuditDrama(ctx context.Context, in *AuditDramaRequest) (out
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.AuditDrama"), address, in); err != nil {
 		return
 	}
 
@@ -279,7 +279,7 @@ func (s *MiniDrama) ListDramas(ctx context.Context, in *ListDramasRequest) (out
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.ListDramas"), address, in); err != nil {
 		return
 	}
 
@@ -296,7 +296,7 @@ func (s *MiniDrama) GetDrama(ctx context.Context, in *GetDramaRequest) (out GetD
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.GetDrama"), address, in); err != nil {
 		return
 	}
 	// 使用通用方法返回错误
@@ -312,7 +312,7 @@ func (s *MiniDrama) GetCdnUsageData(ctx context.Context, in *GetCdnUsageDataRequ
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.GetCdnUsageData"), address, in); err != nil {
 		return
 	}
 	// 使用通用方法返回错误
@@ -328,7 +328,7 @@ func (s *MiniDrama) GetCdnLogs(ctx context.Context, in *GetCdnLogsRequest) (out
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "minidrama.GetCdnLogs"), address, in); err != nil {
 		return
 	}
 	// 使用通用方法返回错误
@@ -337,8 +337,8 @@ func (s *MiniDrama) GetCdnLogs(ctx context.Context, in *GetCdnLogsRequest) (out
 }
 
 // requestAddress 请求地址
-func (s *MiniDrama) requestAddress(_ context.Context, url string) (string, error) {
-	accessToken, err := s.ctx.GetAccessToken()
+func (s *MiniDrama) requestAddress(ctx context.Context, url string) (string, error) {
+	accessToken, err := s.ctx.GetAccessTokenContext(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -6,10 +6,13 @@ import (
 	"github.com/silenceper/wechat/v2/miniprogram/analysis"
 	"github.com/silenceper/wechat/v2/miniprogram/auth"
 	"github.com/silenceper/wechat/v2/miniprogram/business"
+	"github.com/silenceper/wechat/v2/miniprogram/code"
 	"github.com/silenceper/wechat/v2/miniprogram/config"
 	"github.com/silenceper/wechat/v2/miniprogram/content"
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/miniprogram/encryptor"
+	"github.com/silenceper/wechat/v2/miniprogram/express/local"
+	"github.com/silenceper/wechat/v2/miniprogram/livebroadcast"
 	"github.com/silenceper/wechat/v2/miniprogram/message"
 	"github.com/silenceper/wechat/v2/miniprogram/minidrama"
 	"github.com/silenceper/wechat/v2/miniprogram/order"
@@ -18,9 +21,11 @@ import (
 	"github.com/silenceper/wechat/v2/miniprogram/redpacketcover"
 	"github.com/silenceper/wechat/v2/miniprogram/riskcontrol"
 	"github.com/silenceper/wechat/v2/miniprogram/security"
+	"github.com/silenceper/wechat/v2/miniprogram/shop/category"
 	"github.com/silenceper/wechat/v2/miniprogram/shortlink"
 	"github.com/silenceper/wechat/v2/miniprogram/subscribe"
 	"github.com/silenceper/wechat/v2/miniprogram/tcb"
+	"github.com/silenceper/wechat/v2/miniprogram/tester"
 	"github.com/silenceper/wechat/v2/miniprogram/urllink"
 	"github.com/silenceper/wechat/v2/miniprogram/urlscheme"
 	"github.com/silenceper/wechat/v2/miniprogram/virtualpayment"
@@ -36,10 +41,24 @@ type MiniProgram struct {
 func NewMiniProgram(cfg *config.Config) *MiniProgram {
 	var defaultAkHandle credential.AccessTokenContextHandle
 	const cacheKeyPrefix = credential.CacheKeyMiniProgramPrefix
+	secretProvider := credential.StaticSecret(cfg.AppSecret)
+	if cfg.SecretProvider != nil {
+		secretProvider = cfg.SecretProvider
+	}
 	if cfg.UseStableAK {
-		defaultAkHandle = credential.NewStableAccessToken(cfg.AppID, cfg.AppSecret, cacheKeyPrefix, cfg.Cache)
+		defaultAkHandle = credential.NewStableAccessTokenWithSecretProvider(cfg.AppID, secretProvider, cacheKeyPrefix, cfg.GetTokenCache())
 	} else {
-		defaultAkHandle = credential.NewDefaultAccessToken(cfg.AppID, cfg.AppSecret, cacheKeyPrefix, cfg.Cache)
+		defaultAkHandle = credential.NewDefaultAccessTokenWithSecretProvider(cfg.AppID, secretProvider, cacheKeyPrefix, cfg.GetTokenCache())
+	}
+	if locker, ok := defaultAkHandle.(credential.TokenLocker); ok && cfg.TokenLock != nil {
+		locker.SetLock(cfg.TokenLock)
+	}
+	if observer, ok := defaultAkHandle.(credential.TokenRefreshObserver); ok && cfg.OnTokenRefresh != nil {
+		hook := credential.TokenRefreshHook(cfg.OnTokenRefresh)
+		if !cfg.RevealRefreshedToken {
+			hook = credential.RedactRefreshHook(hook)
+		}
+		observer.SetRefreshHook(hook)
 	}
 	ctx := &context.Context{
 		Config:                   cfg,
@@ -85,6 +104,11 @@ func (miniProgram *MiniProgram) GetBusiness() *business.Business {
 	return business.NewBusiness(miniProgram.ctx)
 }
 
+// GetCode 小程序代码管理
+func (miniProgram *MiniProgram) GetCode() *code.Code {
+	return code.NewCode(miniProgram.ctx)
+}
+
 // GetPrivacy 小程序隐私协议相关 API
 func (miniProgram *MiniProgram) GetPrivacy() *privacy.Privacy {
 	return privacy.NewPrivacy(miniProgram.ctx)
@@ -135,6 +159,16 @@ func (miniProgram *MiniProgram) GetSecurity() *security.Security {
 	return security.NewSecurity(miniProgram.ctx)
 }
 
+// GetShopCategory 自定义版交易组件类目
+func (miniProgram *MiniProgram) GetShopCategory() *category.Category {
+	return category.NewCategory(miniProgram.ctx)
+}
+
+// GetLiveBroadcast 小程序直播接口
+func (miniProgram *MiniProgram) GetLiveBroadcast() *livebroadcast.LiveBroadcast {
+	return livebroadcast.NewLiveBroadcast(miniProgram.ctx)
+}
+
 // GetShortLink 小程序短链接口
 func (miniProgram *MiniProgram) GetShortLink() *shortlink.ShortLink {
 	return shortlink.NewShortLink(miniProgram.ctx)
@@ -179,3 +213,13 @@ func (miniProgram *MiniProgram) GetRedPacketCover() *redpacketcover.RedPacketCov
 func (miniProgram *MiniProgram) GetUpdatableMessage() *message.UpdatableMessage {
 	return message.NewUpdatableMessage(miniProgram.ctx)
 }
+
+// GetExpressLocal 小程序即时配送
+func (miniProgram *MiniProgram) GetExpressLocal() *local.Local {
+	return local.NewLocal(miniProgram.ctx)
+}
+
+// GetTester 小程序体验者管理
+func (miniProgram *MiniProgram) GetTester() *tester.Tester {
+	return tester.NewTester(miniProgram.ctx)
+}
@@ -1,6 +1,7 @@
 package order
 
 import (
+	context2 "context"
 	"fmt"
 	"time"
 
@@ -41,7 +42,7 @@ func (shipping *Shipping) UploadShippingInfo(in *UploadShippingInfoRequest) (err
 	}
 
 	uri := fmt.Sprintf(uploadShippingInfoURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "order.UploadShippingInfo"), uri, in)
 	if err != nil {
 		return
 	}
@@ -58,7 +59,7 @@ func (shipping *Shipping) GetShippingOrder(in *GetShippingOrderRequest) (res Shi
 	}
 
 	uri := fmt.Sprintf(getShippingOrderURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "order.GetShippingOrder"), uri, in)
 	if err != nil {
 		return
 	}
@@ -75,7 +76,7 @@ func (shipping *Shipping) GetShippingOrderList(in *GetShippingOrderListRequest)
 	}
 
 	uri := fmt.Sprintf(getShippingOrderListURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "order.GetShippingOrderList"), uri, in)
 	if err != nil {
 		return
 	}
@@ -92,7 +93,7 @@ func (shipping *Shipping) NotifyConfirmReceive(in *NotifyConfirmReceiveRequest)
 	}
 
 	uri := fmt.Sprintf(notifyConfirmReceiveURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "order.NotifyConfirmReceive"), uri, in)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package privacy
 
 import (
+	context2 "context"
 	"errors"
 	"fmt"
 
@@ -95,7 +96,7 @@ func (s *Privacy) GetPrivacySetting(privacyVer int) (GetPrivacySettingResponse,
 		return GetPrivacySettingResponse{}, err
 	}
 
-	response, err := util.PostJSON(fmt.Sprintf("%s?access_token=%s", getPrivacySettingURL, accessToken), map[string]int{
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "privacy.getprivacysetting"), fmt.Sprintf("%s?access_token=%s", getPrivacySettingURL, accessToken), map[string]int{
 		"privacy_ver": privacyVer,
 	})
 	if err != nil {
@@ -117,7 +118,7 @@ func (s *Privacy) SetPrivacySetting(privacyVer int, ownerSetting OwnerSetting, s
 		return err
 	}
 
-	response, err := util.PostJSON(fmt.Sprintf("%s?access_token=%s", setPrivacySettingURL, accessToken), SetPrivacySettingRequest{
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "privacy.setprivacysetting"), fmt.Sprintf("%s?access_token=%s", setPrivacySettingURL, accessToken), SetPrivacySettingRequest{
 		PrivacyVer:   privacyVer,
 		OwnerSetting: ownerSetting,
 		SettingList:  settingList,
@@ -143,7 +144,7 @@ func (s *Privacy) UploadPrivacyExtFile(fileData []byte) (UploadPrivacyExtFileRes
 		return UploadPrivacyExtFileResponse{}, err
 	}
 
-	response, err := util.PostJSON(fmt.Sprintf("%s?access_token=%s", uploadPrivacyExtFileURL, accessToken), map[string][]byte{
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "privacy.setprivacysetting"), fmt.Sprintf("%s?access_token=%s", uploadPrivacyExtFileURL, accessToken), map[string][]byte{
 		"file": fileData,
 	})
 	if err != nil {
@@ -0,0 +1,46 @@
+package qrcode
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	// errCodeInvalidPage page 不存在或小程序未发布
+	errCodeInvalidPage = 41030
+	// errCodeNoCategory 小程序未设置类目
+	errCodeNoCategory = 85078
+	// errCodeNotReleased 小程序没有线上版本
+	errCodeNotReleased = 85079
+)
+
+var (
+	// ErrPageNotReleased 页面不存在或小程序尚未发布，需要先发布小程序或设置 CheckPath 为 false
+	ErrPageNotReleased = errors.New("page is not released, release the mini program first")
+	// ErrNoCategory 小程序尚未设置服务类目，需要先在小程序管理后台设置类目
+	ErrNoCategory = errors.New("mini program has no category, set a category first")
+)
+
+// qrCodeErrors 生成小程序码错误码对应的错误
+var qrCodeErrors = map[int64]error{
+	errCodeInvalidPage: ErrPageNotReleased,
+	errCodeNotReleased: ErrPageNotReleased,
+	errCodeNoCategory:  ErrNoCategory,
+}
+
+// classifyError 将特定错误码转换为对应的错误，可通过 errors.Is 判断，同时仍可通过 errors.As 取得原始的 *util.CommonError
+func classifyError(err error) error {
+	var commonErr *util.CommonError
+	if !errors.As(err, &commonErr) {
+		return err
+	}
+	if typedErr, ok := qrCodeErrors[commonErr.ErrCode.Int64()]; ok {
+		return &util.MappedError{
+			Err:   fmt.Errorf("%w (errcode=%d, errmsg=%s)", typedErr, commonErr.ErrCode.Int64(), commonErr.ErrMsg),
+			Cause: commonErr,
+		}
+	}
+	return err
+}
@@ -0,0 +1,40 @@
+package qrcode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestGetWXACodeTypedErrors(t *testing.T) {
+	defer gock.Off()
+	cases := []struct {
+		errCode  int
+		expected error
+	}{
+		{41030, ErrPageNotReleased},
+		{85079, ErrPageNotReleased},
+		{85078, ErrNoCategory},
+		{45009, nil},
+	}
+	for _, c := range cases {
+		gock.New("https://api.weixin.qq.com").Post("/wxa/getwxacode").
+			Reply(200).JSON(map[string]interface{}{"errcode": c.errCode, "errmsg": "mock error"})
+
+		_, err := newTestQRCode().GetWXACode(QRCoder{Path: "pages/index/index"})
+		var commonErr *util.CommonError
+		if assert.True(t, errors.As(err, &commonErr), "errcode %d", c.errCode) {
+			assert.Equal(t, int64(c.errCode), commonErr.ErrCode.Int64())
+		}
+		if c.expected != nil {
+			assert.True(t, errors.Is(err, c.expected), "errcode %d", c.errCode)
+		} else {
+			assert.False(t, errors.Is(err, ErrPageNotReleased) || errors.Is(err, ErrNoCategory))
+		}
+	}
+	assert.True(t, gock.IsDone())
+}
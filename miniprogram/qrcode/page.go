@@ -0,0 +1,101 @@
+package qrcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownPage page 或 path 不在小程序的页面列表中
+var ErrUnknownPage = errors.New("unknown page")
+
+// PageValidator 根据小程序的页面列表在请求前校验 page 及 path，避免页面路径拼写错误
+type PageValidator struct {
+	pages map[string]struct{}
+	list  []string
+}
+
+// NewPageValidator 根据页面列表实例化，页面列表可通过 code.GetPage 获取或自行提供（如 app.json 中的 pages）
+func NewPageValidator(pages []string) *PageValidator {
+	v := &PageValidator{pages: make(map[string]struct{}, len(pages))}
+	for _, page := range pages {
+		page = trimPage(page)
+		if _, ok := v.pages[page]; ok || page == "" {
+			continue
+		}
+		v.pages[page] = struct{}{}
+		v.list = append(v.list, page)
+	}
+	return v
+}
+
+// Validate 校验页面路径，忽略开头的 / 及 query，为空时跳转主页不做校验
+// 页面不存在时返回 ErrUnknownPage，有相近的页面时在错误信息中给出建议
+func (v *PageValidator) Validate(page string) error {
+	page = trimPage(page)
+	if page == "" {
+		return nil
+	}
+	if _, ok := v.pages[page]; ok {
+		return nil
+	}
+	if suggestion := v.Suggest(page); suggestion != "" {
+		return fmt.Errorf("%w: %q, did you mean %q?", ErrUnknownPage, page, suggestion)
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownPage, page)
+}
+
+// Suggest 返回与 page 最相近的页面，编辑距离超过 max(2, 页面长度/3) 时返回空字符串
+func (v *PageValidator) Suggest(page string) string {
+	page = trimPage(page)
+	limit := len(page) / 3
+	if limit < 2 {
+		limit = 2
+	}
+	var suggestion string
+	for _, candidate := range v.list {
+		if d := editDistance(page, candidate); d <= limit {
+			suggestion, limit = candidate, d-1
+		}
+	}
+	return suggestion
+}
+
+// trimPage 去掉开头的 / 及 query
+func trimPage(page string) string {
+	if i := strings.IndexByte(page, '?'); i >= 0 {
+		page = page[:i]
+	}
+	return strings.TrimPrefix(page, "/")
+}
+
+// editDistance 计算两个字符串的编辑距离
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
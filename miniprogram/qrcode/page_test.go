@@ -0,0 +1,45 @@
+package qrcode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPageValidator(t *testing.T) {
+	v := NewPageValidator([]string{"pages/index/index", "/pages/order/detail", "pages/order/list"})
+
+	// 已存在的页面，忽略开头的 / 及 query
+	assert.NoError(t, v.Validate("pages/index/index"))
+	assert.NoError(t, v.Validate("/pages/order/detail?id=1"))
+	assert.NoError(t, v.Validate(""))
+
+	// 拼写相近时给出建议
+	err := v.Validate("pages/ordr/detail")
+	assert.True(t, errors.Is(err, ErrUnknownPage))
+	assert.EqualError(t, err, `unknown page: "pages/ordr/detail", did you mean "pages/order/detail"?`)
+	assert.Equal(t, "pages/order/list", v.Suggest("pages/order/lists"))
+
+	// 没有相近的页面
+	err = v.Validate("pages/user/profile")
+	assert.EqualError(t, err, `unknown page: "pages/user/profile"`)
+	assert.Equal(t, "", v.Suggest("pages/user/profile"))
+}
+
+func TestGetWXACodePageValidator(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/getwxacode").
+		Reply(200).SetHeader("Content-Type", "image/jpeg").BodyString("mock-image")
+
+	qrCode := newTestQRCode().SetPageValidator(NewPageValidator([]string{"pages/index/index"}))
+	_, err := qrCode.GetWXACode(QRCoder{Path: "pages/indx/index?from=poster"})
+	assert.True(t, errors.Is(err, ErrUnknownPage))
+	assert.EqualError(t, err, `GetWXACode error : unknown page: "pages/indx/index", did you mean "pages/index/index"?`)
+
+	response, err := qrCode.GetWXACode(QRCoder{Path: "pages/index/index?from=poster"})
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-image", string(response))
+	assert.True(t, gock.IsDone())
+}
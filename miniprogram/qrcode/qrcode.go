@@ -3,10 +3,13 @@ package qrcode
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/silenceper/wechat/v2/internal/wxapath"
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/util"
+	"github.com/silenceper/wechat/v2/util/image"
 )
 
 const (
@@ -18,6 +21,7 @@ const (
 // QRCode struct
 type QRCode struct {
 	*context.Context
+	pageValidator *PageValidator
 }
 
 // NewQRCode 实例
@@ -27,13 +31,39 @@ func NewQRCode(context *context.Context) *QRCode {
 	return qrCode
 }
 
-// Color QRCode color
+// Color QRCode color，各分量为十进制表示的 0-255
+// 发送时按照微信文档以字符串编码，如 {"r":"0","g":"0","b":"0"}；解析时兼容字符串及数字两种形式
 type Color struct {
 	R string `json:"r"`
 	G string `json:"g"`
 	B string `json:"b"`
 }
 
+// NewColor 使用 rgb 分量创建颜色
+func NewColor(r, g, b uint8) *Color {
+	return &Color{
+		R: strconv.Itoa(int(r)),
+		G: strconv.Itoa(int(g)),
+		B: strconv.Itoa(int(b)),
+	}
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler，兼容 {"r":"0"} 及 {"r":0} 两种形式
+func (c *Color) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		R util.FlexInt64 `json:"r"`
+		G util.FlexInt64 `json:"g"`
+		B util.FlexInt64 `json:"b"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	c.R = strconv.FormatInt(raw.R.Int64(), 10)
+	c.G = strconv.FormatInt(raw.G.Int64(), 10)
+	c.B = strconv.FormatInt(raw.B.Int64(), 10)
+	return nil
+}
+
 // QRCoder 小程序码参数
 type QRCoder struct {
 	// page 必须是已经发布的小程序存在的页面,根路径前不要填加 /,不能携带参数（参数请放在scene字段里），如果不填写这个字段，默认跳主页面
@@ -58,6 +88,38 @@ type QRCoder struct {
 	ShowSplashAd bool `json:"show_splash_ad,omitempty"`
 }
 
+// SetPageValidator 设置页面校验，设置后请求前校验 page 及 path 是否在小程序的页面列表中
+func (qrCode *QRCode) SetPageValidator(validator *PageValidator) *QRCode {
+	qrCode.pageValidator = validator
+	return qrCode
+}
+
+// validatePage 使用 PageValidator 校验 page 及 path，未设置时不校验
+func (qrCode *QRCode) validatePage(apiName string, coderParams QRCoder) error {
+	if qrCode.pageValidator == nil {
+		return nil
+	}
+	for _, page := range []string{coderParams.Page, coderParams.Path} {
+		if err := qrCode.pageValidator.Validate(page); err != nil {
+			return fmt.Errorf("%s error : %w", apiName, err)
+		}
+	}
+	return nil
+}
+
+// validate 校验 page 及 env_version，path 对于小游戏可以只传入 query 部分，不做校验
+func (coderParams QRCoder) validate(apiName string) error {
+	if coderParams.Page != "" {
+		if err := wxapath.ValidatePage(coderParams.Page); err != nil {
+			return fmt.Errorf("%s error : %w", apiName, err)
+		}
+	}
+	if err := wxapath.ValidateEnvVersion(coderParams.EnvVersion); err != nil {
+		return fmt.Errorf("%s error : %w", apiName, err)
+	}
+	return nil
+}
+
 // fetchCode 请求并返回二维码二进制数据
 func (qrCode *QRCode) fetchCode(urlStr string, body interface{}) (response []byte, err error) {
 	var accessToken string
@@ -74,16 +136,20 @@ func (qrCode *QRCode) fetchCode(urlStr string, body interface{}) (response []byt
 	}
 	if strings.HasPrefix(contentType, "application/json") {
 		// 返回错误信息
-		var result util.CommonError
-		err = json.Unmarshal(response, &result)
-		if err == nil && result.ErrCode != 0 {
-			err = fmt.Errorf("fetchCode error : errcode=%v , errmsg=%v", result.ErrCode, result.ErrMsg)
-			return nil, err
+		if err = util.DecodeWithCommonError(response, "fetchCode"); err != nil {
+			return nil, classifyError(err)
 		}
 	}
-	if contentType == "image/jpeg" {
+	switch contentType {
+	case "image/jpeg":
 		// 返回文件
 		return response, nil
+	case "image/png":
+		// is_hyaline 为 true 时返回透明底色的 png，原样返回不做重新编码
+		if !image.IsPNG(response) {
+			return nil, fmt.Errorf("fetchCode error : invalid png response")
+		}
+		return response, nil
 	}
 	err = fmt.Errorf("fetchCode error : unknown response content type - %v", contentType)
 	return nil, err
@@ -92,17 +158,42 @@ func (qrCode *QRCode) fetchCode(urlStr string, body interface{}) (response []byt
 // CreateWXAQRCode 获取小程序二维码，适用于需要的码数量较少的业务场景
 // 文档地址： https://developers.weixin.qq.com/miniprogram/dev/api/createWXAQRCode.html
 func (qrCode *QRCode) CreateWXAQRCode(coderParams QRCoder) (response []byte, err error) {
+	if err = coderParams.validate("CreateWXAQRCode"); err != nil {
+		return
+	}
+	if err = qrCode.validatePage("CreateWXAQRCode", coderParams); err != nil {
+		return
+	}
 	return qrCode.fetchCode(createWXAQRCodeURL, coderParams)
 }
 
 // GetWXACode 获取小程序码，适用于需要的码数量较少的业务场景
 // 文档地址： https://developers.weixin.qq.com/miniprogram/dev/api/getWXACode.html
 func (qrCode *QRCode) GetWXACode(coderParams QRCoder) (response []byte, err error) {
+	if err = coderParams.validate("GetWXACode"); err != nil {
+		return
+	}
+	if err = qrCode.validatePage("GetWXACode", coderParams); err != nil {
+		return
+	}
 	return qrCode.fetchCode(getWXACodeURL, coderParams)
 }
 
 // GetWXACodeUnlimit 获取小程序码，适用于需要的码数量极多的业务场景
 // 文档地址： https://developers.weixin.qq.com/miniprogram/dev/api/getWXACodeUnlimit.html
+// IsHyaline 为 true 时返回透明底色的 png 图片，可通过 image.IsTransparentPNG 判断
 func (qrCode *QRCode) GetWXACodeUnlimit(coderParams QRCoder) (response []byte, err error) {
-	return qrCode.fetchCode(getWXACodeUnlimitURL, coderParams)
+	if err = coderParams.validate("GetWXACodeUnlimit"); err != nil {
+		return
+	}
+	if err = qrCode.validatePage("GetWXACodeUnlimit", coderParams); err != nil {
+		return
+	}
+	if response, err = qrCode.fetchCode(getWXACodeUnlimitURL, coderParams); err != nil {
+		return
+	}
+	if coderParams.IsHyaline && !image.IsPNG(response) {
+		return nil, fmt.Errorf("GetWXACodeUnlimit error : is_hyaline requires png response")
+	}
+	return
 }
@@ -0,0 +1,77 @@
+package qrcode
+
+import (
+	"bytes"
+	context2 "context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	imageutil "github.com/silenceper/wechat/v2/util/image"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestQRCode() *QRCode {
+	return NewQRCode(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestGetWXACodeUnlimitHyaline(t *testing.T) {
+	defer gock.Off()
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.NRGBA{A: 255})
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, img))
+
+	gock.New("https://api.weixin.qq.com").Post("/wxa/getwxacodeunlimit").
+		BodyString(`"is_hyaline":true`).
+		Reply(200).SetHeader("Content-Type", "image/png").Body(bytes.NewReader(buf.Bytes()))
+
+	data, err := newTestQRCode().GetWXACodeUnlimit(QRCoder{Scene: "a=1", IsHyaline: true})
+	assert.Nil(t, err)
+	// 原样返回，不重新编码
+	assert.Equal(t, buf.Bytes(), data)
+	assert.True(t, imageutil.IsTransparentPNG(data))
+}
+
+func TestGetWXACodeUnlimitInvalidPNG(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/getwxacodeunlimit").
+		Reply(200).SetHeader("Content-Type", "image/png").BodyString(`{"errcode":40001}`)
+
+	_, err := newTestQRCode().GetWXACodeUnlimit(QRCoder{Scene: "a=1", IsHyaline: true})
+	assert.NotNil(t, err)
+}
+
+func TestColorJSON(t *testing.T) {
+	data, err := json.Marshal(NewColor(0, 128, 255))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"r":"0","g":"128","b":"255"}`, string(data))
+
+	for _, raw := range []string{`{"r":"0","g":"128","b":"255"}`, `{"r":0,"g":128,"b":255}`} {
+		var c Color
+		assert.Nil(t, json.Unmarshal([]byte(raw), &c), raw)
+		assert.Equal(t, NewColor(0, 128, 255), &c, raw)
+	}
+
+	var c Color
+	assert.NotNil(t, json.Unmarshal([]byte(`{"r":"red"}`), &c))
+}
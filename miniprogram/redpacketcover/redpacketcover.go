@@ -1,6 +1,7 @@
 package redpacketcover
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/miniprogram/context"
@@ -48,7 +49,7 @@ func (cover *RedPacketCover) GetRedPacketCoverURL(coderParams GetRedPacketCoverR
 	}
 
 	uri := fmt.Sprintf(getRedPacketCoverURL, accessToken)
-	response, err := util.PostJSON(uri, coderParams)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "redpacketcover.GetRedPacketCoverURL"), uri, coderParams)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package riskcontrol
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/miniprogram/context"
@@ -49,7 +50,7 @@ func (riskControl *RiskControl) GetUserRiskRank(in *UserRiskRankRequest) (res Us
 	}
 
 	uri := fmt.Sprintf(getUserRiskRankURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "riskcontrol.GetUserRiskRank"), uri, in)
 	if err != nil {
 		return
 	}
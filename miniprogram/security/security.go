@@ -1,7 +1,10 @@
 package security
 
 import (
+	context2 "context"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 
 	"github.com/silenceper/wechat/v2/miniprogram/context"
@@ -40,7 +43,7 @@ func (security *Security) MediaCheckAsyncV1(in *MediaCheckAsyncV1Request) (trace
 	}
 
 	uri := fmt.Sprintf(mediaCheckAsyncURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "security.MediaCheckAsyncV1"), uri, in)
 	if err != nil {
 		return
 	}
@@ -54,16 +57,47 @@ func (security *Security) MediaCheckAsyncV1(in *MediaCheckAsyncV1Request) (trace
 	return res.TraceID, err
 }
 
+// MediaType 异步校验的多媒体类型
+type MediaType uint8
+
+const (
+	// MediaTypeAudio 音频
+	MediaTypeAudio MediaType = 1
+	// MediaTypeImage 图片
+	MediaTypeImage MediaType = 2
+)
+
+// Validate 校验多媒体类型是否合法
+func (t MediaType) Validate() error {
+	switch t {
+	case MediaTypeAudio, MediaTypeImage:
+		return nil
+	default:
+		return fmt.Errorf("invalid media_type %d, must be one of 1(audio), 2(image)", t)
+	}
+}
+
 // MediaCheckAsyncRequest 图片/音频异步校验请求参数
 type MediaCheckAsyncRequest struct {
-	MediaURL  string `json:"media_url"`  // 要检测的图片或音频的url，支持图片格式包括jpg, jepg, png, bmp, gif（取首帧），支持的音频格式包括mp3, aac, ac3, wma, flac, vorbis, opus, wav
-	MediaType uint8  `json:"media_type"` // 1:音频;2:图片
-	OpenID    string `json:"openid"`     // 用户的openid（用户需在近两小时访问过小程序）
-	Scene     uint8  `json:"scene"`      // 场景枚举值（1 资料；2 评论；3 论坛；4 社交日志）
+	MediaURL  string    `json:"media_url"`  // 要检测的图片或音频的url，支持图片格式包括jpg, jepg, png, bmp, gif（取首帧），支持的音频格式包括mp3, aac, ac3, wma, flac, vorbis, opus, wav
+	MediaType MediaType `json:"media_type"` // 1:音频;2:图片
+	OpenID    string    `json:"openid"`     // 用户的openid（用户需在近两小时访问过小程序）
+	Scene     Scene     `json:"scene"`      // 场景枚举值（1 资料；2 评论；3 论坛；4 社交日志）
 }
 
 // MediaCheckAsync 异步校验图片/音频是否含有违法违规内容
+// 检测结果通过 wxa_media_check 事件推送，可使用 message 包中的 MediaCheckAsyncData 解析，并通过 trace_id 与本次请求关联
 func (security *Security) MediaCheckAsync(in *MediaCheckAsyncRequest) (traceID string, err error) {
+	if in.MediaURL == "" {
+		return "", errors.New("media_url is required")
+	}
+	if err = in.MediaType.Validate(); err != nil {
+		return
+	}
+	if err = in.Scene.Validate(); err != nil {
+		return
+	}
+
 	accessToken, err := security.GetAccessToken()
 	if err != nil {
 		return
@@ -77,7 +111,7 @@ func (security *Security) MediaCheckAsync(in *MediaCheckAsyncRequest) (traceID s
 	req.Version = 2
 
 	uri := fmt.Sprintf(mediaCheckAsyncURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "security.MediaCheckAsync"), uri, req)
 	if err != nil {
 		return
 	}
@@ -111,6 +145,37 @@ func (security *Security) ImageCheckV1(filename string) (err error) {
 	return util.DecodeWithCommonError(response, "ImageCheckV1")
 }
 
+// riskyContentErrCode 内容含有违法违规内容
+const riskyContentErrCode = 87014
+
+// ImgSecCheck 校验一张图片是否含有违法违规内容（同步），图片内容从 r 中读取，适用于头像等需要即时结果的场景
+// 图片不含违法违规内容时 pass 为 true，含有违法违规内容（errcode=87014）时 pass 为 false 且 err 为 nil
+// https://developers.weixin.qq.com/miniprogram/dev/framework/security.imgSecCheck.html
+// Deprecated
+// 在2021年9月1日停止更新。建议使用 MediaCheckAsync
+func (security *Security) ImgSecCheck(filename string, r io.Reader) (pass bool, err error) {
+	accessToken, err := security.GetAccessToken()
+	if err != nil {
+		return
+	}
+
+	uri := fmt.Sprintf(imageCheckURL, accessToken)
+	response, err := util.PostFileFromReader("media", filename, filename, uri, r)
+	if err != nil {
+		return
+	}
+
+	err = util.DecodeWithCommonError(response, "ImgSecCheck")
+	if err == nil {
+		return true, nil
+	}
+	var commonErr *util.CommonError
+	if errors.As(err, &commonErr) && commonErr.ErrCode == riskyContentErrCode {
+		return false, nil
+	}
+	return false, err
+}
+
 // CheckSuggest 检查建议
 type CheckSuggest string
 
@@ -123,18 +188,42 @@ const (
 	CheckSuggestReview CheckSuggest = "review"
 )
 
+// Scene 内容安全检测场景
+type Scene uint8
+
+const (
+	// SceneProfile 资料
+	SceneProfile Scene = iota + 1
+	// SceneComment 评论
+	SceneComment
+	// SceneForum 论坛
+	SceneForum
+	// SceneSocialLog 社交日志
+	SceneSocialLog
+)
+
+// Validate 校验场景值是否为 1 资料、2 评论、3 论坛、4 社交日志 之一
+func (s Scene) Validate() error {
+	switch s {
+	case SceneProfile, SceneComment, SceneForum, SceneSocialLog:
+		return nil
+	default:
+		return fmt.Errorf("invalid scene %d, must be one of 1(profile), 2(comment), 3(forum), 4(social log)", s)
+	}
+}
+
 // MsgScene 文本场景
-type MsgScene uint8
+type MsgScene = Scene
 
 const (
 	// MsgSceneMaterial 资料文件检查场景
-	MsgSceneMaterial MsgScene = iota + 1
+	MsgSceneMaterial = SceneProfile
 	// MsgSceneComment 评论
-	MsgSceneComment
+	MsgSceneComment = SceneComment
 	// MsgSceneForum 论坛
-	MsgSceneForum
+	MsgSceneForum = SceneForum
 	// MsgSceneSocialLog 社交日志
-	MsgSceneSocialLog
+	MsgSceneSocialLog = SceneSocialLog
 )
 
 // CheckLabel 检查命中标签
@@ -169,12 +258,12 @@ func (cl CheckLabel) String() string {
 
 // MsgCheckRequest 文本检查请求
 type MsgCheckRequest struct {
-	OpenID    string   `json:"openid"`    // 用户的openid（用户需在近两小时访问过小程序）
-	Scene     MsgScene `json:"scene"`     // 场景枚举值（1 资料；2 评论；3 论坛；4 社交日志）
-	Content   string   `json:"content"`   // 需检测的文本内容，文本字数的上限为 2500 字，需使用 UTF-8 编码
-	Nickname  string   `json:"nickname"`  // （非必填）用户昵称，需使用UTF-8编码
-	Title     string   `json:"title"`     // （非必填）文本标题，需使用UTF-8编码
-	Signature string   `json:"signature"` // （非必填）个性签名，该参数仅在资料类场景有效(scene=1)，需使用UTF-8编码
+	OpenID    string `json:"openid"`    // 用户的openid（用户需在近两小时访问过小程序）
+	Scene     Scene  `json:"scene"`     // 场景枚举值（1 资料；2 评论；3 论坛；4 社交日志）
+	Content   string `json:"content"`   // 需检测的文本内容，文本字数的上限为 2500 字，需使用 UTF-8 编码
+	Nickname  string `json:"nickname"`  // （非必填）用户昵称，需使用UTF-8编码
+	Title     string `json:"title"`     // （非必填）文本标题，需使用UTF-8编码
+	Signature string `json:"signature"` // （非必填）个性签名，该参数仅在资料类场景有效(scene=1)，需使用UTF-8编码
 }
 
 // MsgCheckResponse 文本检查响应
@@ -210,7 +299,7 @@ func (security *Security) MsgCheckV1(content string) (res MsgCheckResponse, err
 	req.Content = content
 
 	uri := fmt.Sprintf(msgCheckURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "security.security.MsgCheckV1"), uri, req)
 	if err != nil {
 		return
 	}
@@ -222,6 +311,10 @@ func (security *Security) MsgCheckV1(content string) (res MsgCheckResponse, err
 
 // MsgCheck 检查一段文本是否含有违法违规内容
 func (security *Security) MsgCheck(in *MsgCheckRequest) (res MsgCheckResponse, err error) {
+	if err = in.Scene.Validate(); err != nil {
+		return
+	}
+
 	accessToken, err := security.GetAccessToken()
 	if err != nil {
 		return
@@ -235,7 +328,7 @@ func (security *Security) MsgCheck(in *MsgCheckRequest) (res MsgCheckResponse, e
 	req.Version = 2
 
 	uri := fmt.Sprintf(msgCheckURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "security.security.MsgCheck"), uri, req)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,124 @@
+package security
+
+import (
+	context2 "context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (m mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestSecurity() *Security {
+	return NewSecurity(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestSceneValidate(t *testing.T) {
+	for _, scene := range []Scene{SceneProfile, SceneComment, SceneForum, SceneSocialLog} {
+		assert.NoError(t, scene.Validate(), "scene %d", scene)
+	}
+	assert.Error(t, Scene(0).Validate())
+	assert.Error(t, Scene(5).Validate())
+}
+
+func TestMsgCheck(t *testing.T) {
+	defer gock.Off()
+	for _, scene := range []Scene{SceneProfile, SceneComment, SceneForum, SceneSocialLog} {
+		gock.New("https://api.weixin.qq.com").Post("/wxa/msg_sec_check").
+			BodyString(`"scene":` + strconv.Itoa(int(scene)) + `.*"version":2`).
+			Reply(200).JSON(map[string]interface{}{
+			"errcode":  0,
+			"trace_id": "mock-trace-id",
+			"result":   map[string]interface{}{"suggest": "pass", "label": 100},
+		})
+
+		res, err := newTestSecurity().MsgCheck(&MsgCheckRequest{OpenID: "mock-openid", Scene: scene, Content: "hello"})
+		assert.NoError(t, err)
+		assert.Equal(t, CheckSuggestPass, res.Result.Suggest)
+	}
+	assert.True(t, gock.IsDone())
+
+	_, err := newTestSecurity().MsgCheck(&MsgCheckRequest{OpenID: "mock-openid", Scene: 5, Content: "hello"})
+	assert.EqualError(t, err, "invalid scene 5, must be one of 1(profile), 2(comment), 3(forum), 4(social log)")
+}
+
+func TestMediaCheckAsync(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/media_check_async").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"media_url":"https://example.com/a.png","media_type":2,"openid":"mock-openid","scene":2,"version":2`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok", "trace_id": "mock-trace-id"})
+
+	traceID, err := newTestSecurity().MediaCheckAsync(&MediaCheckAsyncRequest{
+		MediaURL:  "https://example.com/a.png",
+		MediaType: MediaTypeImage,
+		OpenID:    "mock-openid",
+		Scene:     SceneComment,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-trace-id", traceID)
+	assert.True(t, gock.IsDone())
+}
+
+func TestMediaCheckAsyncInvalidMediaType(t *testing.T) {
+	_, err := newTestSecurity().MediaCheckAsync(&MediaCheckAsyncRequest{MediaURL: "https://example.com/a.png", MediaType: 3, Scene: SceneComment})
+	assert.EqualError(t, err, "invalid media_type 3, must be one of 1(audio), 2(image)")
+
+	_, err = newTestSecurity().MediaCheckAsync(&MediaCheckAsyncRequest{MediaType: MediaTypeImage, Scene: SceneComment})
+	assert.Error(t, err)
+}
+
+func TestMediaCheckAsyncInvalidScene(t *testing.T) {
+	_, err := newTestSecurity().MediaCheckAsync(&MediaCheckAsyncRequest{MediaURL: "https://example.com/a.png", MediaType: 2})
+	assert.Error(t, err)
+}
+
+func TestImgSecCheck(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/img_sec_check").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`name="media"; filename="avatar.jpg"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	pass, err := newTestSecurity().ImgSecCheck("avatar.jpg", strings.NewReader("mock-image"))
+	assert.NoError(t, err)
+	assert.True(t, pass)
+	assert.True(t, gock.IsDone())
+}
+
+func TestImgSecCheckRisky(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/img_sec_check").
+		Reply(200).JSON(map[string]interface{}{"errcode": 87014, "errmsg": "risky content"})
+
+	pass, err := newTestSecurity().ImgSecCheck("avatar.jpg", strings.NewReader("mock-image"))
+	assert.NoError(t, err)
+	assert.False(t, pass)
+}
+
+func TestImgSecCheckError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/img_sec_check").
+		Reply(200).JSON(map[string]interface{}{"errcode": 40001, "errmsg": "invalid credential"})
+
+	pass, err := newTestSecurity().ImgSecCheck("avatar.jpg", strings.NewReader("mock-image"))
+	assert.EqualError(t, err, "ImgSecCheck Error , errcode=40001 , errmsg=invalid credential")
+	assert.False(t, pass)
+}
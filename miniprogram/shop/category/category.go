@@ -0,0 +1,101 @@
+package category
+
+import (
+	context2 "context"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	// getAllCategoryURL 获取商品类目（含资质要求）
+	getAllCategoryURL = "https://api.weixin.qq.com/shop/cat/get?access_token=%s"
+	// getCategoryURL 获取指定父类目下的子类目
+	getCategoryURL = "https://api.weixin.qq.com/product/category/get?access_token=%s"
+)
+
+// Category 自定义版交易组件类目
+type Category struct {
+	*context.Context
+}
+
+// NewCategory 实例化
+func NewCategory(ctx *context.Context) *Category {
+	return &Category{ctx}
+}
+
+// ThirdCategory 三级类目及其资质要求
+type ThirdCategory struct {
+	ThirdCatID               int    `json:"third_cat_id"`               // 三级类目 ID
+	ThirdCatName             string `json:"third_cat_name"`             // 三级类目名称
+	Qualification            string `json:"qualification"`              // 类目资质
+	QualificationType        int    `json:"qualification_type"`         // 类目资质类型，0：不需要，1：必填，2：选填
+	ProductQualification     string `json:"product_qualification"`      // 商品资质
+	ProductQualificationType int    `json:"product_qualification_type"` // 商品资质类型，0：不需要，1：必填，2：选填
+	SecondCatID              int    `json:"second_cat_id"`              // 二级类目 ID
+	SecondCatName            string `json:"second_cat_name"`            // 二级类目名称
+	FirstCatID               int    `json:"first_cat_id"`               // 一级类目 ID
+	FirstCatName             string `json:"first_cat_name"`             // 一级类目名称
+}
+
+// GetResponse 获取商品类目返回
+type GetResponse struct {
+	util.CommonError
+	ThirdCatList []ThirdCategory `json:"third_cat_list"`
+}
+
+// Get 获取商品类目，返回所有三级类目及其所属的一、二级类目和资质要求
+// see https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/business-capabilities/ministore/minishopopencomponent2/API/cat/get_children_cateogry.html
+func (category *Category) Get() (res GetResponse, err error) {
+	return category.GetContext(context2.Background())
+}
+
+// GetContext 获取商品类目
+func (category *Category) GetContext(ctx context2.Context) (res GetResponse, err error) {
+	var accessToken string
+	if accessToken, err = category.GetAccessTokenContext(ctx); err != nil {
+		return
+	}
+	var response []byte
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "category.GetCategory"), fmt.Sprintf(getAllCategoryURL, accessToken), map[string]interface{}{}); err != nil {
+		return
+	}
+	err = util.DecodeWithError(response, &res, "GetCategory")
+	return
+}
+
+// Item 类目
+type Item struct {
+	CatID  int    `json:"cat_id"`   // 类目 ID
+	FCatID int    `json:"f_cat_id"` // 父类目 ID
+	Name   string `json:"name"`     // 类目名称
+}
+
+// GetChildrenResponse 获取子类目返回
+type GetChildrenResponse struct {
+	util.CommonError
+	CatList []Item `json:"cat_list"`
+}
+
+// GetChildren 获取指定父类目下的子类目，fCatID 为 0 时返回一级类目
+func (category *Category) GetChildren(fCatID int) (res GetChildrenResponse, err error) {
+	return category.GetChildrenContext(context2.Background(), fCatID)
+}
+
+// GetChildrenContext 获取指定父类目下的子类目
+func (category *Category) GetChildrenContext(ctx context2.Context, fCatID int) (res GetChildrenResponse, err error) {
+	var accessToken string
+	if accessToken, err = category.GetAccessTokenContext(ctx); err != nil {
+		return
+	}
+	req := map[string]int{
+		"f_cat_id": fCatID,
+	}
+	var response []byte
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "category.GetChildrenCategory"), fmt.Sprintf(getCategoryURL, accessToken), req); err != nil {
+		return
+	}
+	err = util.DecodeWithError(response, &res, "GetChildrenCategory")
+	return
+}
@@ -0,0 +1,112 @@
+package category
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (m mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestCategory() *Category {
+	return NewCategory(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestGet(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/shop/cat/get").
+		MatchParam("access_token", "mock-access-token").
+		Reply(200).BodyString(`{"errcode":0,"errmsg":"ok","third_cat_list":[
+			{"third_cat_id":6493,"third_cat_name":"爬行垫/毯","qualification":"","qualification_type":0,
+			 "product_qualification":"《国家强制性产品认证证书》（CCC 安全认证证书）","product_qualification_type":1,
+			 "second_cat_id":6489,"second_cat_name":"爬行用品","first_cat_id":6472,"first_cat_name":"玩具乐器"},
+			{"third_cat_id":6494,"third_cat_name":"爬行学步带","qualification":"","qualification_type":0,
+			 "product_qualification":"","product_qualification_type":0,
+			 "second_cat_id":6489,"second_cat_name":"爬行用品","first_cat_id":6472,"first_cat_name":"玩具乐器"}]}`)
+
+	res, err := newTestCategory().Get()
+	assert.Nil(t, err)
+	if assert.Len(t, res.ThirdCatList, 2) {
+		first := res.ThirdCatList[0]
+		assert.Equal(t, 6493, first.ThirdCatID)
+		assert.Equal(t, "爬行垫/毯", first.ThirdCatName)
+		assert.Equal(t, 1, first.ProductQualificationType)
+		assert.Equal(t, "《国家强制性产品认证证书》（CCC 安全认证证书）", first.ProductQualification)
+		// 同一子树下的三级类目属于相同的一、二级类目
+		for _, item := range res.ThirdCatList {
+			assert.Equal(t, 6489, item.SecondCatID)
+			assert.Equal(t, "爬行用品", item.SecondCatName)
+			assert.Equal(t, 6472, item.FirstCatID)
+			assert.Equal(t, "玩具乐器", item.FirstCatName)
+		}
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetChildren(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/product/category/get").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"f_cat_id":0`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "cat_list": []map[string]interface{}{
+		{"cat_id": 6472, "f_cat_id": 0, "name": "玩具乐器"},
+		{"cat_id": 6153, "f_cat_id": 0, "name": "食品饮料"},
+	}})
+	gock.New("https://api.weixin.qq.com").Post("/product/category/get").
+		BodyString(`"f_cat_id":6472`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "cat_list": []map[string]interface{}{
+		{"cat_id": 6489, "f_cat_id": 6472, "name": "爬行用品"},
+	}})
+	gock.New("https://api.weixin.qq.com").Post("/product/category/get").
+		BodyString(`"f_cat_id":6489`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "cat_list": []map[string]interface{}{
+		{"cat_id": 6493, "f_cat_id": 6489, "name": "爬行垫/毯"},
+		{"cat_id": 6494, "f_cat_id": 6489, "name": "爬行学步带"},
+	}})
+
+	// 从一级类目逐层获取子树
+	category := newTestCategory()
+	var path []string
+	var leaves []Item
+	fCatID := 0
+	for level := 0; level < 3; level++ {
+		res, err := category.GetChildrenContext(context2.Background(), fCatID)
+		if !assert.Nil(t, err) || !assert.NotEmpty(t, res.CatList) {
+			return
+		}
+		for _, item := range res.CatList {
+			assert.Equal(t, fCatID, item.FCatID)
+		}
+		path = append(path, res.CatList[0].Name)
+		fCatID = res.CatList[0].CatID
+		leaves = res.CatList
+	}
+	assert.Equal(t, []string{"玩具乐器", "爬行用品", "爬行垫/毯"}, path)
+	assert.Equal(t, []Item{{CatID: 6493, FCatID: 6489, Name: "爬行垫/毯"}, {CatID: 6494, FCatID: 6489, Name: "爬行学步带"}}, leaves)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetChildrenError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/product/category/get").
+		Reply(200).JSON(map[string]interface{}{"errcode": 9401020, "errmsg": "invalid parameter"})
+
+	_, err := newTestCategory().GetChildren(-1)
+	assert.EqualError(t, err, "GetChildrenCategory Error , errcode=9401020 , errmsg=invalid parameter")
+}
@@ -1,8 +1,10 @@
 package shortlink
 
 import (
+	context2 "context"
 	"fmt"
 
+	"github.com/silenceper/wechat/v2/internal/wxapath"
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/util"
 )
@@ -45,6 +47,9 @@ type resShortLinker struct {
 
 // Generate 生成 shortLink
 func (shortLink *ShortLink) generate(shortLinkParams ShortLinker) (string, error) {
+	if err := wxapath.ValidatePageWithQuery(shortLinkParams.PageURL); err != nil {
+		return "", fmt.Errorf("GenerateShortLink: %w", err)
+	}
 	var accessToken string
 	accessToken, err := shortLink.GetAccessToken()
 	if err != nil {
@@ -52,7 +57,7 @@ func (shortLink *ShortLink) generate(shortLinkParams ShortLinker) (string, error
 	}
 
 	urlStr := fmt.Sprintf(generateShortLinkURL, accessToken)
-	response, err := util.PostJSON(urlStr, shortLinkParams)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "shortlink.GenerateShortLink"), urlStr, shortLinkParams)
 	if err != nil {
 		return "", err
 	}
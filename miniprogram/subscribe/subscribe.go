@@ -1,9 +1,11 @@
 package subscribe
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/silenceper/wechat/v2/internal/openapi"
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/util"
 )
@@ -53,7 +55,7 @@ type Message struct {
 // DataItem 模版内某个 .DATA 的值
 type DataItem struct {
 	Value interface{} `json:"value"`
-	Color string      `json:"color"`
+	Color string      `json:"color,omitempty"`
 }
 
 // TemplateItem template item
@@ -79,14 +81,28 @@ type resTemplateSend struct {
 }
 
 // Send 发送订阅消息
+// 接口调用超过限制时按照配置的 QuotaExceededPolicy 进行处理
 func (s *Subscribe) Send(msg *Message) (err error) {
+	return s.SendContext(context2.Background(), msg)
+}
+
+// SendContext 发送订阅消息
+// 接口调用超过限制时按照配置的 QuotaExceededPolicy 进行处理，QuotaExceededBlock 策略下等待期间响应 ctx 取消
+func (s *Subscribe) SendContext(ctx context2.Context, msg *Message) (err error) {
+	return util.DoWithQuotaPolicy(ctx, s.QuotaExceededPolicy, openapi.NewOpenAPI(s.Context).ClearQuota, func() error {
+		return s.send(ctx, msg)
+	})
+}
+
+// send 发送订阅消息
+func (s *Subscribe) send(ctx context2.Context, msg *Message) (err error) {
 	var accessToken string
-	accessToken, err = s.GetAccessToken()
+	accessToken, err = s.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeSendURL, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := util.PostJSONContext(util.WithOperation(ctx, "subscribe.Send"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -101,7 +117,7 @@ func (s *Subscribe) SendGetMsgID(msg *Message) (msgID int64, err error) {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeSendURL, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "subscribe.SendGetMsgID"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -128,7 +144,7 @@ func (s *Subscribe) ListTemplates() (*TemplateList, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", getTemplateURL, accessToken)
-	response, err := util.HTTPGet(uri)
+	response, err := util.HTTPGetContext(util.WithOperation(context2.Background(), "subscribe.ListTemplates"), uri)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +186,7 @@ func (s *Subscribe) UniformSend(msg *UniformMessage) (err error) {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uniformMessageSend, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "subscribe.UniformSend"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -197,7 +213,7 @@ func (s *Subscribe) Add(ShortID string, kidList []int, sceneDesc string) (templa
 	}{TemplateIDShort: ShortID, SceneDesc: sceneDesc, KidList: kidList}
 	uri := fmt.Sprintf("%s?access_token=%s", addTemplateURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "subscribe.AddSubscribe"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -218,7 +234,7 @@ func (s *Subscribe) Delete(templateID string) (err error) {
 	}{TemplateID: templateID}
 	uri := fmt.Sprintf("%s?access_token=%s", delTemplateURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "subscribe.DeleteSubscribe"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,72 @@
+package subscribe
+
+import (
+	context2 "context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (m mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestSubscribe() *Subscribe {
+	return NewSubscribe(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestDataItemColor(t *testing.T) {
+	data, err := json.Marshal(map[string]*DataItem{
+		"thing1": {Value: "订单已发货", Color: "#173177"},
+		"time2":  {Value: "2024-01-01 10:00"},
+	})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"thing1":{"value":"订单已发货","color":"#173177"},"time2":{"value":"2024-01-01 10:00"}}`, string(data))
+	assert.NotContains(t, string(data), `"color":""`)
+}
+
+func TestSendOmitsEmptyColor(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/subscribe/send").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"data":\{"thing1":\{"value":"订单已发货"\}\}`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	err := newTestSubscribe().Send(&Message{
+		ToUser:     "mock-openid",
+		TemplateID: "mock-template",
+		Data:       map[string]*DataItem{"thing1": {Value: "订单已发货"}},
+	})
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+// TestSendContextQuotaBlockCanceled QuotaExceededBlock 策略下等待期间响应 ctx 取消
+func TestSendContextQuotaBlockCanceled(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/subscribe/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": 45009, "errmsg": "reach max api daily quota limit"})
+
+	s := newTestSubscribe()
+	s.QuotaExceededPolicy = util.QuotaExceededBlock
+	ctx, cancel := context2.WithTimeout(context2.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := s.SendContext(ctx, &Message{ToUser: "mock-openid", TemplateID: "mock-template"})
+	assert.Equal(t, context2.DeadlineExceeded, err)
+}
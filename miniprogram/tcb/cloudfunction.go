@@ -1,6 +1,7 @@
 package tcb
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -26,7 +27,7 @@ func (tcb *Tcb) InvokeCloudFunction(env, name, args string) (*InvokeCloudFunctio
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s&env=%s&name=%s", invokeCloudFunctionURL, accessToken, env, name)
-	response, err := util.HTTPPost(uri, args)
+	response, err := util.HTTPPostContext(util.WithOperation(context.Background(), "tcb.InvokeCloudFunction"), uri, []byte(args), nil)
 	if err != nil {
 		return nil, err
 	}
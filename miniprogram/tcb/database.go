@@ -1,6 +1,7 @@
 package tcb
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -200,7 +201,7 @@ func (tcb *Tcb) DatabaseMigrateImport(req *DatabaseMigrateImportReq) (*DatabaseM
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseMigrateImportURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseMigrateImport"), uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +219,7 @@ func (tcb *Tcb) DatabaseMigrateExport(req *DatabaseMigrateExportReq) (*DatabaseM
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseMigrateExportURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseMigrateExport"), uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +237,7 @@ func (tcb *Tcb) DatabaseMigrateQueryInfo(env string, jobID int64) (*DatabaseMigr
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseMigrateQueryInfoURL, accessToken)
-	response, err := util.PostJSON(uri, map[string]interface{}{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseMigrateQueryInfo"), uri, map[string]interface{}{
 		"env":    env,
 		"job_id": jobID,
 	})
@@ -256,7 +257,7 @@ func (tcb *Tcb) UpdateIndex(req *UpdateIndexReq) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", updateIndexURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.UpdateIndex"), uri, req)
 	if err != nil {
 		return err
 	}
@@ -272,7 +273,7 @@ func (tcb *Tcb) DatabaseCollectionAdd(env, collectionName string) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCollectionAddURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseCollectionReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseCollectionAdd"), uri, &DatabaseCollectionReq{
 		Env:            env,
 		CollectionName: collectionName,
 	})
@@ -291,7 +292,7 @@ func (tcb *Tcb) DatabaseCollectionDelete(env, collectionName string) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCollectionDeleteURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseCollectionReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseCollectionDelete"), uri, &DatabaseCollectionReq{
 		Env:            env,
 		CollectionName: collectionName,
 	})
@@ -310,7 +311,7 @@ func (tcb *Tcb) DatabaseCollectionGet(env string, limit, offset int64) (*Databas
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCollectionGetURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseCollectionGetReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseCollectionGet"), uri, &DatabaseCollectionGetReq{
 		Env:    env,
 		Limit:  limit,
 		Offset: offset,
@@ -332,7 +333,7 @@ func (tcb *Tcb) DatabaseAdd(env, query string) (*DatabaseAddRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseAddURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseAdd"), uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -353,7 +354,7 @@ func (tcb *Tcb) DatabaseDelete(env, query string) (*DatabaseDeleteRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseDeleteURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseDelete"), uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -374,7 +375,7 @@ func (tcb *Tcb) DatabaseUpdate(env, query string) (*DatabaseUpdateRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseUpdateURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseUpdate"), uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -395,7 +396,7 @@ func (tcb *Tcb) DatabaseQuery(env, query string) (*DatabaseQueryRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseQueryURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseQuery"), uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -416,7 +417,7 @@ func (tcb *Tcb) DatabaseCount(env, query string) (*DatabaseCountRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCountURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.DatabaseCount"), uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
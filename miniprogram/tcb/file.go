@@ -1,6 +1,7 @@
 package tcb
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -83,7 +84,7 @@ func (tcb *Tcb) UploadFile(env, path string) (*UploadFileRes, error) {
 		Env:  env,
 		Path: path,
 	}
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.UploadFile"), uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +106,7 @@ func (tcb *Tcb) BatchDownloadFile(env string, fileList []*DownloadFile) (*BatchD
 		Env:      env,
 		FileList: fileList,
 	}
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.BatchDownloadFile"), uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +128,7 @@ func (tcb *Tcb) BatchDeleteFile(env string, fileIDList []string) (*BatchDeleteFi
 		Env:        env,
 		FileIDList: fileIDList,
 	}
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "tcb.BatchDeleteFile"), uri, req)
 	if err != nil {
 		return nil, err
 	}
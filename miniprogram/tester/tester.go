@@ -0,0 +1,107 @@
+package tester
+
+import (
+	context2 "context"
+	"errors"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	// bindTesterURL 绑定体验者
+	bindTesterURL = "https://api.weixin.qq.com/wxa/bind_tester?access_token=%s"
+	// unbindTesterURL 解除绑定体验者
+	unbindTesterURL = "https://api.weixin.qq.com/wxa/unbind_tester?access_token=%s"
+	// memberAuthURL 获取体验者列表
+	memberAuthURL = "https://api.weixin.qq.com/wxa/memberauth?access_token=%s"
+)
+
+// Tester 小程序体验者管理
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/Mini_Program_AdminManagement/Admin.html
+type Tester struct {
+	*context.Context
+}
+
+// NewTester 实例化
+func NewTester(ctx *context.Context) *Tester {
+	return &Tester{ctx}
+}
+
+// Member 体验者
+type Member struct {
+	UserStr string `json:"userstr"` // 人员对应的唯一字符串
+}
+
+// Bind 绑定微信用户为体验者，返回人员对应的唯一字符串
+func (tester *Tester) Bind(wechatID string) (userStr string, err error) {
+	return tester.BindContext(context2.Background(), wechatID)
+}
+
+// BindContext 绑定微信用户为体验者，返回人员对应的唯一字符串
+func (tester *Tester) BindContext(ctx context2.Context, wechatID string) (userStr string, err error) {
+	if wechatID == "" {
+		return "", errors.New("wechatid is empty")
+	}
+	var res struct {
+		util.CommonError
+		UserStr string `json:"userstr"`
+	}
+	err = tester.post(ctx, bindTesterURL, map[string]string{"wechatid": wechatID}, &res, "tester.Bind")
+	return res.UserStr, err
+}
+
+// Unbind 解除绑定体验者
+func (tester *Tester) Unbind(wechatID string) error {
+	return tester.UnbindContext(context2.Background(), wechatID)
+}
+
+// UnbindContext 解除绑定体验者
+func (tester *Tester) UnbindContext(ctx context2.Context, wechatID string) error {
+	if wechatID == "" {
+		return errors.New("wechatid is empty")
+	}
+	return tester.post(ctx, unbindTesterURL, map[string]string{"wechatid": wechatID}, nil, "tester.Unbind")
+}
+
+// UnbindByUserStr 通过 GetList 返回的人员唯一字符串解除绑定体验者
+func (tester *Tester) UnbindByUserStr(ctx context2.Context, userStr string) error {
+	if userStr == "" {
+		return errors.New("userstr is empty")
+	}
+	return tester.post(ctx, unbindTesterURL, map[string]string{"userstr": userStr}, nil, "tester.Unbind")
+}
+
+// GetList 获取体验者列表
+func (tester *Tester) GetList() ([]Member, error) {
+	return tester.GetListContext(context2.Background())
+}
+
+// GetListContext 获取体验者列表
+func (tester *Tester) GetListContext(ctx context2.Context) ([]Member, error) {
+	var res struct {
+		util.CommonError
+		Members []Member `json:"members"`
+	}
+	if err := tester.post(ctx, memberAuthURL, map[string]string{"action": "get_experiencer"}, &res, "tester.GetList"); err != nil {
+		return nil, err
+	}
+	return res.Members, nil
+}
+
+// post 发送请求并解析结果，res 为 nil 时只校验错误码，apiName 同时作为请求的操作名
+func (tester *Tester) post(ctx context2.Context, urlFormat string, req, res interface{}, apiName string) error {
+	accessToken, err := tester.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	var response []byte
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "tester."+apiName), fmt.Sprintf(urlFormat, accessToken), req); err != nil {
+		return err
+	}
+	if res == nil {
+		return util.DecodeWithCommonError(response, apiName)
+	}
+	return util.DecodeWithError(response, res, apiName)
+}
@@ -0,0 +1,80 @@
+package tester
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (m mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestTester() *Tester {
+	return NewTester(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestBind(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/bind_tester").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"wechatid":"mock-wechatid"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok", "userstr": "mock-userstr"})
+	gock.New("https://api.weixin.qq.com").Post("/wxa/bind_tester").
+		BodyString(`"wechatid":"bound-wechatid"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 85004, "errmsg": "already bound"})
+
+	userStr, err := newTestTester().Bind("mock-wechatid")
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-userstr", userStr)
+
+	_, err = newTestTester().Bind("bound-wechatid")
+	assert.Error(t, err)
+
+	_, err = newTestTester().Bind("")
+	assert.Error(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+func TestUnbind(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/unbind_tester").
+		BodyString(`"wechatid":"mock-wechatid"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+	gock.New("https://api.weixin.qq.com").Post("/wxa/unbind_tester").
+		BodyString(`"userstr":"mock-userstr"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	assert.NoError(t, newTestTester().Unbind("mock-wechatid"))
+	assert.NoError(t, newTestTester().UnbindByUserStr(context2.Background(), "mock-userstr"))
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetList(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/memberauth").
+		BodyString(`"action":"get_experiencer"`).
+		Reply(200).JSON(map[string]interface{}{
+		"errcode": 0,
+		"errmsg":  "ok",
+		"members": []map[string]string{{"userstr": "userstr-1"}, {"userstr": "userstr-2"}},
+	})
+
+	members, err := newTestTester().GetListContext(context2.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []Member{{UserStr: "userstr-1"}, {UserStr: "userstr-2"}}, members)
+}
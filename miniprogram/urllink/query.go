@@ -1,6 +1,7 @@
 package urllink
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -20,19 +21,13 @@ type ULQueryResult struct {
 	util.CommonError
 
 	URLLinkInfo struct {
-		Appid      string `json:"appid"`
-		Path       string `json:"path"`
-		Query      string `json:"query"`
-		CreateTime int64  `json:"create_time"`
-		ExpireTime int64  `json:"expire_time"`
-		EnvVersion string `json:"env_version"`
-		CloudBase  struct {
-			Env           string `json:"env"`
-			Domain        string `json:"domain"`
-			Path          string `json:"path"`
-			Query         string `json:"query"`
-			ResourceAppid string `json:"resource_appid"`
-		} `json:"cloud_base"`
+		Appid      string    `json:"appid"`
+		Path       string    `json:"path"`
+		Query      string    `json:"query"`
+		CreateTime int64     `json:"create_time"`
+		ExpireTime int64     `json:"expire_time"`
+		EnvVersion string    `json:"env_version"`
+		CloudBase  CloudBase `json:"cloud_base"`
 	} `json:"url_link_info"`
 	VisitOpenid string    `json:"visit_openid"`
 	QuotaInfo   QuotaInfo `json:"quota_info"`
@@ -59,7 +54,7 @@ func (u *URLLink) QueryWithType(req *ULQueryRequest) (*ULQueryResult, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(queryURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "urllink.URLLink.Query"), fmt.Sprintf(queryURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ULQueryResult{}
@@ -1,8 +1,11 @@
 package urllink
 
 import (
+	context2 "context"
+	"errors"
 	"fmt"
 
+	"github.com/silenceper/wechat/v2/internal/wxapath"
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/util"
 )
@@ -33,7 +36,7 @@ const (
 // ULParams 请求参数
 // https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/url-link/urllink.generate.html#请求参数
 type ULParams struct {
-	Path  string `json:"path"`
+	Path  string `json:"path,omitempty"`
 	Query string `json:"query"`
 	// envVersion 要打开的小程序版本。正式版为 "release"，体验版为 "trial"，开发版为 "develop"
 	EnvVersion     string      `json:"env_version,omitempty"`
@@ -41,6 +44,42 @@ type ULParams struct {
 	ExpireType     TExpireType `json:"expire_type"`
 	ExpireTime     int64       `json:"expire_time"`
 	ExpireInterval int         `json:"expire_interval"`
+	// CloudBase 云开发静态网站自定义 H5 配置参数，与 Path 互斥
+	CloudBase *CloudBase `json:"cloud_base,omitempty"`
+}
+
+// CloudBase 云开发静态网站自定义 H5 配置参数
+type CloudBase struct {
+	Env           string `json:"env"`                      // 云开发环境
+	Domain        string `json:"domain,omitempty"`         // 静态网站自定义域名，不填则使用默认域名
+	Path          string `json:"path,omitempty"`           // 云开发静态网站 H5 页面路径，不可携带 query
+	Query         string `json:"query,omitempty"`          // 云开发静态网站 H5 页面 query 参数
+	ResourceAppid string `json:"resource_appid,omitempty"` // 第三方批量代云开发时必填，表示创建该 env 的 appid
+}
+
+// validate 校验请求参数
+func (params *ULParams) validate() error {
+	if params.Path != "" {
+		if err := wxapath.ValidatePage(params.Path); err != nil {
+			return fmt.Errorf("URLLink.Generate: %w", err)
+		}
+	}
+	if err := wxapath.ValidateQuery(params.Query); err != nil {
+		return fmt.Errorf("URLLink.Generate: %w", err)
+	}
+	if err := wxapath.ValidateEnvVersion(params.EnvVersion); err != nil {
+		return fmt.Errorf("URLLink.Generate: %w", err)
+	}
+	if params.CloudBase == nil {
+		return nil
+	}
+	if params.Path != "" {
+		return errors.New("URLLink.Generate: path and cloud_base are mutually exclusive")
+	}
+	if params.CloudBase.Env == "" {
+		return errors.New("URLLink.Generate: cloud_base.env is required")
+	}
+	return nil
 }
 
 // ULResult 返回的结果
@@ -53,13 +92,16 @@ type ULResult struct {
 
 // Generate 生成url link
 func (u *URLLink) Generate(params *ULParams) (string, error) {
+	if err := params.validate(); err != nil {
+		return "", err
+	}
 	accessToken, err := u.GetAccessToken()
 	if err != nil {
 		return "", err
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", generateURL, accessToken)
-	response, err := util.PostJSON(uri, params)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "urllink.URLLink.Generate"), uri, params)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,80 @@
+package urllink
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func (mockAccessTokenHandle) GetAccessTokenContext(_ context2.Context) (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestURLLink() *URLLink {
+	return NewURLLink(&context.Context{
+		Config:                   &config.Config{AppID: "mock-appid"},
+		AccessTokenContextHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestGenerateCloudBase(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/generate_urllink").
+		BodyString(`"cloud_base":{"env":"mock-env","domain":"mock.tcloudbaseapp.com","path":"/jump-wxa.html","query":"sign=mock"}`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "url_link": "https://wxaurl.cn/mock"})
+
+	link, err := newTestURLLink().Generate(&ULParams{
+		CloudBase: &CloudBase{
+			Env:    "mock-env",
+			Domain: "mock.tcloudbaseapp.com",
+			Path:   "/jump-wxa.html",
+			Query:  "sign=mock",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://wxaurl.cn/mock", link)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGenerateCloudBaseInvalid(t *testing.T) {
+	_, err := newTestURLLink().Generate(&ULParams{Path: "pages/index/index", CloudBase: &CloudBase{Env: "mock-env"}})
+	assert.EqualError(t, err, "URLLink.Generate: path and cloud_base are mutually exclusive")
+
+	_, err = newTestURLLink().Generate(&ULParams{CloudBase: &CloudBase{}})
+	assert.EqualError(t, err, "URLLink.Generate: cloud_base.env is required")
+}
+
+func TestGenerateInvalidPath(t *testing.T) {
+	_, err := newTestURLLink().Generate(&ULParams{Path: "pages/index/index?id=1"})
+	assert.EqualError(t, err, `URLLink.Generate: invalid page "pages/index/index?id=1": unexpected character '?'`)
+
+	_, err = newTestURLLink().Generate(&ULParams{Path: "pages/index/index", Query: "?id=1"})
+	assert.EqualError(t, err, `URLLink.Generate: invalid query "?id=1": should not start with ?`)
+
+	_, err = newTestURLLink().Generate(&ULParams{Path: "pages/index/index", EnvVersion: "beta"})
+	assert.EqualError(t, err, `URLLink.Generate: invalid env_version "beta", must be one of release, trial, develop`)
+}
+
+// TestGenerateQueryPunctuation query 中可以使用文档允许的 ; 及 # 等特殊字符
+func TestGenerateQueryPunctuation(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/wxa/generate_urllink").
+		BodyString(`"query":"a=1;b=2#top"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "url_link": "https://wxaurl.cn/mock"})
+
+	link, err := newTestURLLink().Generate(&ULParams{Path: "pages/index/index", Query: "a=1;b=2#top"})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://wxaurl.cn/mock", link)
+	assert.True(t, gock.IsDone())
+}
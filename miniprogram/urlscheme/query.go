@@ -1,6 +1,7 @@
 package urlscheme
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -71,7 +72,7 @@ func (u *URLScheme) QuerySchemeWithRes(req QueryScheme) (*ResQueryScheme, error)
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(querySchemeURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "urlscheme.QueryScheme"), fmt.Sprintf(querySchemeURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ResQueryScheme{}
@@ -1,8 +1,10 @@
 package urlscheme
 
 import (
+	context2 "context"
 	"fmt"
 
+	"github.com/silenceper/wechat/v2/internal/wxapath"
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/util"
 )
@@ -64,6 +66,26 @@ type USParams struct {
 	Sn             string      `json:"sn,omitempty"`
 }
 
+// validate 校验跳转的目标小程序信息
+func (params *USParams) validate(apiName string) error {
+	jumpWxa := params.JumpWxa
+	if jumpWxa == nil {
+		return nil
+	}
+	if jumpWxa.Path != "" {
+		if err := wxapath.ValidatePage(jumpWxa.Path); err != nil {
+			return fmt.Errorf("%s: %w", apiName, err)
+		}
+	}
+	if err := wxapath.ValidateQuery(jumpWxa.Query); err != nil {
+		return fmt.Errorf("%s: %w", apiName, err)
+	}
+	if err := wxapath.ValidateEnvVersion(string(jumpWxa.EnvVersion)); err != nil {
+		return fmt.Errorf("%s: %w", apiName, err)
+	}
+	return nil
+}
+
 // USResult 返回的结果
 // https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/url-scheme/urlscheme.generate.html#返回值
 type USResult struct {
@@ -74,13 +96,16 @@ type USResult struct {
 
 // Generate 生成url link
 func (u *URLScheme) Generate(params *USParams) (string, error) {
+	if err := params.validate("URLScheme.Generate"); err != nil {
+		return "", err
+	}
 	accessToken, err := u.GetAccessToken()
 	if err != nil {
 		return "", err
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", generateURL, accessToken)
-	response, err := util.PostJSON(uri, params)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "urlscheme.URLScheme.Generate"), uri, params)
 	if err != nil {
 		return "", err
 	}
@@ -96,11 +121,14 @@ func (u *URLScheme) GenerateNFC(params *USParams) (string, error) {
 		accessToken string
 		err         error
 	)
+	if err = params.validate("URLScheme.GenerateNFC"); err != nil {
+		return "", err
+	}
 	if accessToken, err = u.GetAccessToken(); err != nil {
 		return "", err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(generateNFCURL, accessToken), params); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "urlscheme.URLScheme.GenerateNFC"), fmt.Sprintf(generateNFCURL, accessToken), params); err != nil {
 		return "", err
 	}
 	result := &USResult{}
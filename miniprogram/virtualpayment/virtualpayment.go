@@ -50,12 +50,12 @@ func (s *VirtualPayment) QueryUserBalance(ctx context.Context, in *QueryUserBala
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.QueryUserBalance"), address, in); err != nil {
 		return
 	}
 
@@ -78,12 +78,12 @@ func (s *VirtualPayment) CurrencyPay(ctx context.Context, in *CurrencyPayRequest
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.CurrencyPay"), address, in); err != nil {
 		return
 	}
 
@@ -107,11 +107,11 @@ func (s *VirtualPayment) QueryOrder(ctx context.Context, in *QueryOrderRequest)
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.QueryOrder"), address, in); err != nil {
 		return
 	}
 
@@ -134,12 +134,12 @@ func (s *VirtualPayment) CancelCurrencyPay(ctx context.Context, in *CancelCurren
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.CancelCurrencyPay"), address, in); err != nil {
 		return
 	}
 
@@ -164,12 +164,12 @@ func (s *VirtualPayment) NotifyProvideGoods(ctx context.Context, in *NotifyProvi
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.NotifyProvideGoods"), address, in); err != nil {
 		return
 	}
 
@@ -193,12 +193,12 @@ func (s *VirtualPayment) PresentCurrency(ctx context.Context, in *PresentCurrenc
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.PresentCurrency"), address, in); err != nil {
 		return
 	}
 
@@ -222,12 +222,12 @@ func (s *VirtualPayment) DownloadBill(ctx context.Context, in *DownloadBillReque
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.DownloadBill"), address, in); err != nil {
 		return
 	}
 
@@ -251,12 +251,12 @@ func (s *VirtualPayment) RefundOrder(ctx context.Context, in *RefundOrderRequest
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.RefundOrder"), address, in); err != nil {
 		return
 	}
 
@@ -280,12 +280,12 @@ func (s *VirtualPayment) CreateWithdrawOrder(ctx context.Context, in *CreateWith
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.CreateWithdrawOrder"), address, in); err != nil {
 		return
 	}
 
@@ -309,12 +309,12 @@ func (s *VirtualPayment) QueryWithdrawOrder(ctx context.Context, in *QueryWithdr
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.QueryWithdrawOrder"), address, in); err != nil {
 		return
 	}
 
@@ -338,12 +338,12 @@ func (s *VirtualPayment) StartUploadGoods(ctx context.Context, in *StartUploadGo
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.StartUploadGoods"), address, in); err != nil {
 		return
 	}
 
@@ -367,12 +367,12 @@ func (s *VirtualPayment) QueryUploadGoods(ctx context.Context, in *QueryUploadGo
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.QueryUploadGoods"), address, in); err != nil {
 		return
 	}
 
@@ -396,12 +396,12 @@ func (s *VirtualPayment) StartPublishGoods(ctx context.Context, in *StartPublish
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.StartPublishGoods"), address, in); err != nil {
 		return
 	}
 
@@ -425,12 +425,12 @@ func (s *VirtualPayment) QueryPublishGoods(ctx context.Context, in *QueryPublish
 		}
 		address string
 	)
-	if address, err = s.requestAddress(params); err != nil {
+	if address, err = s.requestAddress(ctx, params); err != nil {
 		return
 	}
 
 	var response []byte
-	if response, err = util.PostJSONContext(ctx, address, in); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "virtualpayment.QueryPublishGoods"), address, in); err != nil {
 		return
 	}
 
@@ -474,7 +474,7 @@ func (s *VirtualPayment) PaySignature(url, data string) (paySign, signature stri
 }
 
 // requestURL .组合 URL
-func (s *VirtualPayment) requestAddress(params URLParams) (url string, err error) {
+func (s *VirtualPayment) requestAddress(ctx context.Context, params URLParams) (url string, err error) {
 	switch params.Path {
 	case queryUserBalance:
 	case currencyPay:
@@ -501,7 +501,7 @@ func (s *VirtualPayment) requestAddress(params URLParams) (url string, err error
 		return
 	}
 
-	if params.AccessToken, err = s.ctx.GetAccessToken(); err != nil {
+	if params.AccessToken, err = s.ctx.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 
@@ -1,6 +1,7 @@
 package basic
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -42,7 +43,7 @@ func (basic *Basic) GetCallbackIP() ([]string, error) {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", getCallbackIPURL, ak)
-	data, err := util.HTTPGet(url)
+	data, err := util.HTTPGetContext(util.WithOperation(context2.Background(), "basic.GetCallbackIP"), url)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +59,7 @@ func (basic *Basic) GetAPIDomainIP() ([]string, error) {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", getAPIDomainIPURL, ak)
-	data, err := util.HTTPGet(url)
+	data, err := util.HTTPGetContext(util.WithOperation(context2.Background(), "basic.GetAPIDomainIP"), url)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +75,7 @@ func (basic *Basic) ClearQuota() error {
 		return err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", clearQuotaURL, ak)
-	data, err := util.PostJSON(url, map[string]string{
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "basic.ClearQuota"), url, map[string]string{
 		"appid": basic.AppID,
 	})
 	if err != nil {
@@ -1,8 +1,11 @@
 package basic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
 	"time"
 
@@ -50,7 +53,7 @@ func (basic *Basic) GetQRTicket(tq *Request) (t *Ticket, err error) {
 	}
 
 	uri := fmt.Sprintf(qrCreateURL, accessToken)
-	response, err := util.PostJSON(uri, tq)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "basic.GetQRTicket"), uri, tq)
 	if err != nil {
 		err = fmt.Errorf("get qr ticket failed, %s", err)
 		return
@@ -70,9 +73,21 @@ func (basic *Basic) GetQRTicket(tq *Request) (t *Ticket, err error) {
 	return
 }
 
-// ShowQRCode 通过ticket换取二维码
+// ErrEmptyTicket 二维码 ticket 为空
+var ErrEmptyTicket = errors.New("qrcode ticket is empty")
+
+// ShowQRCode 通过ticket换取二维码，ticket 为空时返回空字符串
 func ShowQRCode(tk *Ticket) string {
-	return fmt.Sprintf(getQRImgURL, tk.Ticket)
+	qrURL, _ := ShowQRCodeURL(tk)
+	return qrURL
+}
+
+// ShowQRCodeURL 通过ticket换取二维码，ticket 会进行 URL 编码
+func ShowQRCodeURL(tk *Ticket) (string, error) {
+	if tk == nil || tk.Ticket == "" {
+		return "", ErrEmptyTicket
+	}
+	return fmt.Sprintf(getQRImgURL, url.QueryEscape(tk.Ticket)), nil
 }
 
 // NewTmpQrRequest 新建临时二维码请求实例
@@ -0,0 +1,24 @@
+package basic
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShowQRCode(t *testing.T) {
+	ticket := "gQH47joAAAAAAAAAASxodHRwOi8vd2VpeGluLnFxLmNvbS9xL2taZ2Z3TVRtNzJXV1Brb3ZhYmJJAAIEZ23sUwMEmm3sUw+/=&#"
+	qrURL, err := ShowQRCodeURL(&Ticket{Ticket: ticket})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://mp.weixin.qq.com/cgi-bin/showqrcode?ticket="+url.QueryEscape(ticket), qrURL)
+	assert.Equal(t, qrURL, ShowQRCode(&Ticket{Ticket: ticket}))
+
+	parsed, err := url.Parse(qrURL)
+	assert.Nil(t, err)
+	assert.Equal(t, ticket, parsed.Query().Get("ticket"))
+
+	_, err = ShowQRCodeURL(&Ticket{})
+	assert.Equal(t, ErrEmptyTicket, err)
+	assert.Equal(t, "", ShowQRCode(nil))
+}
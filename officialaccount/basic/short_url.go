@@ -1,6 +1,7 @@
 package basic
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -40,7 +41,7 @@ func (basic *Basic) Long2ShortURL(longURL string) (shortURL string, err error) {
 		return
 	}
 	uri = fmt.Sprintf(long2shortURL, ac)
-	responseBytes, err = util.PostJSON(uri, req)
+	responseBytes, err = util.PostJSONContext(util.WithOperation(context.Background(), "basic.Long2ShortURL"), uri, req)
 	if err != nil {
 		return
 	}
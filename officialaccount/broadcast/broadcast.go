@@ -1,6 +1,8 @@
 package broadcast
 
 import (
+	context2 "context"
+	"errors"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -55,8 +57,8 @@ type User struct {
 // Result 群发返回结果
 type Result struct {
 	util.CommonError
-	MsgID     int64  `json:"msg_id"`
-	MsgDataID int64  `json:"msg_data_id"`
+	MsgID     int64  `json:"msg_id"`      // 消息发送任务的ID
+	MsgDataID int64  `json:"msg_data_id"` // 消息的数据ID，仅群发图文消息时返回，可用于获取图文分析数据
 	MsgStatus string `json:"msg_status"`
 }
 
@@ -117,12 +119,12 @@ func (broadcast *Broadcast) SendText(user *User, content string) (*Result, error
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.SendText"), url, req)
 	if err != nil {
 		return nil, err
 	}
 	res := &Result{}
-	err = util.DecodeWithError(data, res, "SendText")
+	err = broadcast.previewError(util.DecodeWithError(data, res, "SendText"))
 	return res, err
 }
 
@@ -144,12 +146,12 @@ func (broadcast *Broadcast) SendNews(user *User, mediaID string, ignoreReprint b
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.SendNews"), url, req)
 	if err != nil {
 		return nil, err
 	}
 	res := &Result{}
-	err = util.DecodeWithError(data, res, "SendNews")
+	err = broadcast.previewError(util.DecodeWithError(data, res, "SendNews"))
 	return res, err
 }
 
@@ -168,12 +170,12 @@ func (broadcast *Broadcast) SendVoice(user *User, mediaID string) (*Result, erro
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.SendVoice"), url, req)
 	if err != nil {
 		return nil, err
 	}
 	res := &Result{}
-	err = util.DecodeWithError(data, res, "SendVoice")
+	err = broadcast.previewError(util.DecodeWithError(data, res, "SendVoice"))
 	return res, err
 }
 
@@ -196,12 +198,12 @@ func (broadcast *Broadcast) SendImage(user *User, images *Image) (*Result, error
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.SendImage"), url, req)
 	if err != nil {
 		return nil, err
 	}
 	res := &Result{}
-	err = util.DecodeWithError(data, res, "SendImage")
+	err = broadcast.previewError(util.DecodeWithError(data, res, "SendImage"))
 	return res, err
 }
 
@@ -222,12 +224,12 @@ func (broadcast *Broadcast) SendVideo(user *User, mediaID string, title, descrip
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.SendVideo"), url, req)
 	if err != nil {
 		return nil, err
 	}
 	res := &Result{}
-	err = util.DecodeWithError(data, res, "SendVideo")
+	err = broadcast.previewError(util.DecodeWithError(data, res, "SendVideo"))
 	return res, err
 }
 
@@ -246,18 +248,27 @@ func (broadcast *Broadcast) SendWxCard(user *User, cardID string) (*Result, erro
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.SendWxCard"), url, req)
 	if err != nil {
 		return nil, err
 	}
 	res := &Result{}
-	err = util.DecodeWithError(data, res, "SendWxCard")
+	err = broadcast.previewError(util.DecodeWithError(data, res, "SendWxCard"))
 	return res, err
 }
 
-// Delete 删除群发消息
+// Delete 删除群发消息，从粉丝的图文消息中删除已群发的文章
+// articleIDx 为要删除的文章在图文消息中的位置，第一篇编号为 1，为 0 时删除全部文章
 func (broadcast *Broadcast) Delete(msgID int64, articleIDx int64) error {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.DeleteContext(context2.Background(), msgID, articleIDx)
+}
+
+// DeleteContext 删除群发消息
+func (broadcast *Broadcast) DeleteContext(ctx context2.Context, msgID int64, articleIDx int64) error {
+	if msgID == 0 {
+		return errors.New("msg_id is required")
+	}
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -266,7 +277,7 @@ func (broadcast *Broadcast) Delete(msgID int64, articleIDx int64) error {
 		"article_idx": articleIDx,
 	}
 	url := fmt.Sprintf("%s?access_token=%s", deleteSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(ctx, "broadcast.Delete"), url, req)
 	if err != nil {
 		return err
 	}
@@ -289,7 +300,7 @@ func (broadcast *Broadcast) GetMassStatus(msgID string) (*Result, error) {
 		"msg_id": msgID,
 	}
 	url := fmt.Sprintf("%s?access_token=%s", massStatusSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.GetMassStatus"), url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -306,7 +317,7 @@ func (broadcast *Broadcast) GetSpeed() (*SpeedResult, error) {
 	}
 	req := map[string]interface{}{}
 	url := fmt.Sprintf("%s?access_token=%s", getSpeedSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.GetSpeed"), url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -325,7 +336,7 @@ func (broadcast *Broadcast) SetSpeed(speed int) (*SpeedResult, error) {
 		"speed": speed,
 	}
 	url := fmt.Sprintf("%s?access_token=%s", setSpeedSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "broadcast.SetSpeed"), url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,99 @@
+package broadcast
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func TestSendNews(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/mass/sendall").
+		BodyString(`"msgtype":"mpnews"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "send job submission success", "msg_id": 34182, "msg_data_id": 206227730})
+
+	broadcast := NewBroadcast(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	res, err := broadcast.SendNews(nil, "mock-media-id", true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(34182), res.MsgID)
+	assert.Equal(t, int64(206227730), res.MsgDataID)
+	assert.True(t, gock.IsDone())
+}
+
+func TestDelete(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/mass/delete").
+		BodyString(`"article_idx":2,"msg_id":34182`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	broadcast := NewBroadcast(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	assert.Nil(t, broadcast.Delete(34182, 2))
+	assert.True(t, gock.IsDone())
+
+	assert.NotNil(t, broadcast.Delete(0, 0))
+}
+
+func TestPreview(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/mass/preview").
+		BodyString(`"touser":"mock-openid"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "preview success", "msg_id": 34182})
+
+	broadcast := NewBroadcast(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	res, err := broadcast.Preview().SendText(&User{OpenID: []string{"mock-openid"}}, "hello")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(34182), res.MsgID)
+	assert.True(t, gock.IsDone())
+}
+
+func TestPreviewNotFollower(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/mass/preview").
+		Reply(200).JSON(map[string]interface{}{"errcode": 43004, "errmsg": "require subscribe"})
+
+	broadcast := NewBroadcast(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	_, err := broadcast.Preview().SendText(&User{OpenID: []string{"mock-openid"}}, "hello")
+	assert.True(t, errors.Is(err, ErrPreviewNotFollower))
+	var commonErr *util.CommonError
+	if assert.True(t, errors.As(err, &commonErr)) {
+		assert.Equal(t, int64(43004), commonErr.ErrCode.Int64())
+	}
+}
+
+func TestSendNotFollowerWithoutPreview(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/mass/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": 43004, "errmsg": "require subscribe"})
+
+	broadcast := NewBroadcast(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	_, err := broadcast.SendText(&User{OpenID: []string{"mock-openid"}}, "hello")
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, ErrPreviewNotFollower))
+}
@@ -0,0 +1,43 @@
+package broadcast
+
+import (
+	"errors"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	// errCodeInvalidOpenID 不合法的 openid
+	errCodeInvalidOpenID = 40003
+	// errCodeRequireSubscribe 需要接收者关注
+	errCodeRequireSubscribe = 43004
+)
+
+var (
+	// ErrPreviewNotFollower 预览对象未关注公众号
+	ErrPreviewNotFollower = errors.New("preview target has not followed the official account")
+	// ErrPreviewInvalidOpenID 预览对象的 openid 不合法，如不属于该公众号
+	ErrPreviewInvalidOpenID = errors.New("preview target openid is invalid")
+)
+
+// previewErrors 预览接口错误码对应的错误
+var previewErrors = map[int64]error{
+	errCodeRequireSubscribe: ErrPreviewNotFollower,
+	errCodeInvalidOpenID:    ErrPreviewInvalidOpenID,
+}
+
+// previewError 预览时将特定错误码转换为对应的错误，可通过 errors.Is 判断，
+// 同时仍可通过 errors.As 取得原始的 *util.CommonError
+func (broadcast *Broadcast) previewError(err error) error {
+	if err == nil || !broadcast.preview {
+		return err
+	}
+	var commonErr *util.CommonError
+	if !errors.As(err, &commonErr) {
+		return err
+	}
+	if previewErr, ok := previewErrors[commonErr.ErrCode.Int64()]; ok {
+		return &util.MappedError{Err: previewErr, Cause: commonErr}
+	}
+	return err
+}
@@ -0,0 +1,209 @@
+package comment
+
+import (
+	context2 "context"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	openURL        = "https://api.weixin.qq.com/cgi-bin/comment/open"         // 打开已群发文章评论
+	closeURL       = "https://api.weixin.qq.com/cgi-bin/comment/close"        // 关闭已群发文章评论
+	listURL        = "https://api.weixin.qq.com/cgi-bin/comment/list"         // 查看指定文章的评论数据
+	markElectURL   = "https://api.weixin.qq.com/cgi-bin/comment/markelect"    // 将评论标记精选
+	unmarkElectURL = "https://api.weixin.qq.com/cgi-bin/comment/unmarkelect"  // 将评论取消精选
+	deleteURL      = "https://api.weixin.qq.com/cgi-bin/comment/delete"       // 删除评论
+	replyAddURL    = "https://api.weixin.qq.com/cgi-bin/comment/reply/add"    // 回复评论
+	replyDeleteURL = "https://api.weixin.qq.com/cgi-bin/comment/reply/delete" // 删除回复
+)
+
+// Type 评论类型
+type Type int
+
+const (
+	// TypeAll 0:普通评论和精选评论
+	TypeAll Type = iota
+	// TypeNormal 1:普通评论
+	TypeNormal
+	// TypeElected 2:精选评论
+	TypeElected
+)
+
+// Comment 图文消息留言管理
+type Comment struct {
+	*context.Context
+}
+
+// NewComment init
+func NewComment(ctx *context.Context) *Comment {
+	return &Comment{
+		Context: ctx,
+	}
+}
+
+// reqArticle 指定群发图文的请求参数
+type reqArticle struct {
+	MsgDataID int64 `json:"msg_data_id"`     // 群发返回的 msg_data_id
+	Index     int   `json:"index,omitempty"` // 多图文时，用来指定第几篇图文，从 0 开始，不带默认操作该 msg_data_id 的第一篇图文
+}
+
+// reqComment 指定评论的请求参数
+type reqComment struct {
+	reqArticle
+	UserCommentID int64 `json:"user_comment_id"` // 用户评论 id
+}
+
+// Open 打开已群发文章评论
+func (comment *Comment) Open(msgDataID int64, index int) error {
+	return comment.OpenContext(context2.Background(), msgDataID, index)
+}
+
+// OpenContext 打开已群发文章评论
+func (comment *Comment) OpenContext(ctx context2.Context, msgDataID int64, index int) error {
+	return comment.post(ctx, openURL, reqArticle{MsgDataID: msgDataID, Index: index}, "OpenComment")
+}
+
+// Close 关闭已群发文章评论
+func (comment *Comment) Close(msgDataID int64, index int) error {
+	return comment.CloseContext(context2.Background(), msgDataID, index)
+}
+
+// CloseContext 关闭已群发文章评论
+func (comment *Comment) CloseContext(ctx context2.Context, msgDataID int64, index int) error {
+	return comment.post(ctx, closeURL, reqArticle{MsgDataID: msgDataID, Index: index}, "CloseComment")
+}
+
+// ListRequest 查看评论数据请求参数
+type ListRequest struct {
+	MsgDataID int64 `json:"msg_data_id"`     // 群发返回的 msg_data_id
+	Index     int   `json:"index,omitempty"` // 多图文时，用来指定第几篇图文，从 0 开始
+	Begin     int   `json:"begin"`           // 起始位置
+	Count     int   `json:"count"`           // 获取数目（>=50 会被拒绝）
+	Type      Type  `json:"type"`            // 0:普通评论&精选评论 1:普通评论 2:精选评论
+}
+
+// Reply 作者回复
+type Reply struct {
+	Content    string `json:"content"`     // 作者回复内容
+	CreateTime int64  `json:"create_time"` // 作者回复时间
+}
+
+// Item 评论
+type Item struct {
+	UserCommentID int64  `json:"user_comment_id"` // 用户评论 id
+	OpenID        string `json:"openid"`          // 用户 openid
+	CreateTime    int64  `json:"create_time"`     // 评论时间
+	Content       string `json:"content"`         // 评论内容
+	CommentType   int    `json:"comment_type"`    // 是否精选评论，0 为即非精选，1 为 true，即精选
+	Reply         Reply  `json:"reply"`           // 作者回复
+}
+
+// ListResponse 查看评论数据响应
+type ListResponse struct {
+	util.CommonError
+	Total    int    `json:"total"`   // 总数，非 comment 的size
+	Comments []Item `json:"comment"` // 评论列表
+}
+
+// List 查看指定文章的评论数据
+func (comment *Comment) List(req *ListRequest) (*ListResponse, error) {
+	return comment.ListContext(context2.Background(), req)
+}
+
+// ListContext 查看指定文章的评论数据
+func (comment *Comment) ListContext(ctx context2.Context, req *ListRequest) (*ListResponse, error) {
+	accessToken, err := comment.GetAccessTokenContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("%s?access_token=%s", listURL, accessToken)
+	response, err := util.PostJSONContext(util.WithOperation(ctx, "comment.ListComment"), uri, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &ListResponse{}
+	if err = util.DecodeWithError(response, res, "ListComment"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarkElect 将评论标记精选
+func (comment *Comment) MarkElect(msgDataID int64, index int, userCommentID int64) error {
+	return comment.MarkElectContext(context2.Background(), msgDataID, index, userCommentID)
+}
+
+// MarkElectContext 将评论标记精选
+func (comment *Comment) MarkElectContext(ctx context2.Context, msgDataID int64, index int, userCommentID int64) error {
+	return comment.post(ctx, markElectURL, newReqComment(msgDataID, index, userCommentID), "MarkElectComment")
+}
+
+// UnmarkElect 将评论取消精选
+func (comment *Comment) UnmarkElect(msgDataID int64, index int, userCommentID int64) error {
+	return comment.UnmarkElectContext(context2.Background(), msgDataID, index, userCommentID)
+}
+
+// UnmarkElectContext 将评论取消精选
+func (comment *Comment) UnmarkElectContext(ctx context2.Context, msgDataID int64, index int, userCommentID int64) error {
+	return comment.post(ctx, unmarkElectURL, newReqComment(msgDataID, index, userCommentID), "UnmarkElectComment")
+}
+
+// Delete 删除评论
+func (comment *Comment) Delete(msgDataID int64, index int, userCommentID int64) error {
+	return comment.DeleteContext(context2.Background(), msgDataID, index, userCommentID)
+}
+
+// DeleteContext 删除评论
+func (comment *Comment) DeleteContext(ctx context2.Context, msgDataID int64, index int, userCommentID int64) error {
+	return comment.post(ctx, deleteURL, newReqComment(msgDataID, index, userCommentID), "DeleteComment")
+}
+
+// ReplyAdd 回复评论
+func (comment *Comment) ReplyAdd(msgDataID int64, index int, userCommentID int64, content string) error {
+	return comment.ReplyAddContext(context2.Background(), msgDataID, index, userCommentID, content)
+}
+
+// ReplyAddContext 回复评论
+func (comment *Comment) ReplyAddContext(ctx context2.Context, msgDataID int64, index int, userCommentID int64, content string) error {
+	req := struct {
+		reqComment
+		Content string `json:"content"`
+	}{
+		reqComment: newReqComment(msgDataID, index, userCommentID),
+		Content:    content,
+	}
+	return comment.post(ctx, replyAddURL, req, "AddCommentReply")
+}
+
+// ReplyDelete 删除回复
+func (comment *Comment) ReplyDelete(msgDataID int64, index int, userCommentID int64) error {
+	return comment.ReplyDeleteContext(context2.Background(), msgDataID, index, userCommentID)
+}
+
+// ReplyDeleteContext 删除回复
+func (comment *Comment) ReplyDeleteContext(ctx context2.Context, msgDataID int64, index int, userCommentID int64) error {
+	return comment.post(ctx, replyDeleteURL, newReqComment(msgDataID, index, userCommentID), "DeleteCommentReply")
+}
+
+func newReqComment(msgDataID int64, index int, userCommentID int64) reqComment {
+	return reqComment{
+		reqArticle:    reqArticle{MsgDataID: msgDataID, Index: index},
+		UserCommentID: userCommentID,
+	}
+}
+
+// post 发送请求并解析通用错误
+func (comment *Comment) post(ctx context2.Context, url string, req interface{}, apiName string) error {
+	accessToken, err := comment.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	uri := fmt.Sprintf("%s?access_token=%s", url, accessToken)
+	response, err := util.PostJSONContext(util.WithOperation(ctx, "comment."+apiName), uri, req)
+	if err != nil {
+		return err
+	}
+	return util.DecodeWithCommonError(response, apiName)
+}
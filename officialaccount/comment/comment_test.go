@@ -0,0 +1,75 @@
+package comment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestComment() *Comment {
+	return NewComment(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestList(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/comment/list").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"msg_data_id":2247483650,"index":1,"begin":0,"count":20,"type":2`).
+		Reply(200).BodyString(`{
+			"errcode": 0,
+			"errmsg": "ok",
+			"total": 1,
+			"comment": [{
+				"user_comment_id": 1,
+				"openid": "mock-openid",
+				"create_time": 1650000000,
+				"content": "mock-content",
+				"comment_type": 1,
+				"reply": {"content": "mock-reply", "create_time": 1650000100}
+			}]
+		}`)
+
+	res, err := newTestComment().List(&ListRequest{
+		MsgDataID: 2247483650,
+		Index:     1,
+		Count:     20,
+		Type:      TypeElected,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, res.Total)
+	if assert.Len(t, res.Comments, 1) {
+		assert.Equal(t, int64(1), res.Comments[0].UserCommentID)
+		assert.Equal(t, "mock-openid", res.Comments[0].OpenID)
+		assert.Equal(t, "mock-reply", res.Comments[0].Reply.Content)
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestReplyAdd(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/comment/reply/add").
+		BodyString(`"msg_data_id":2247483650,"user_comment_id":1,"content":"mock-reply"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	err := newTestComment().ReplyAdd(2247483650, 0, 1, "mock-reply")
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/comment/reply/add").
+		Reply(200).JSON(map[string]interface{}{"errcode": 88010, "errmsg": "reply already exists"})
+	err = newTestComment().ReplyAdd(2247483650, 0, 1, "mock-reply")
+	assert.NotNil(t, err)
+}
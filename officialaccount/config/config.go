@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // Config .config for 微信公众号
@@ -12,4 +13,27 @@ type Config struct {
 	EncodingAESKey string `json:"encoding_aes_key"` // EncodingAESKey
 	Cache          cache.Cache
 	UseStableAK    bool // use the stable access_token
+	// TokenLock 分布式锁，多实例共享缓存时用于互斥刷新 access_token，为空时仅进程内互斥
+	TokenLock cache.Lock `json:"-"`
+	// TokenCache 存储 access_token、jsapi_ticket 等凭证的缓存，为空时使用 Cache
+	// 可将凭证放在多实例共享的缓存中，而其他数据使用本地缓存
+	TokenCache cache.Cache `json:"-"`
+	// SecretProvider 动态获取 appSecret，设置后刷新 access_token 时优先使用，用于平滑轮换 appSecret
+	SecretProvider func() string `json:"-"`
+	// OnTokenRefresh 每次从微信服务器刷新 access_token 后的回调，可用于监控异常的刷新频率，err 不为空时表示刷新失败
+	// 默认回调中的 token 已脱敏，见 RevealRefreshedToken
+	OnTokenRefresh func(appID string, token string, expiresIn int64, err error) `json:"-"`
+	// RevealRefreshedToken 为 true 时 OnTokenRefresh 回调中传入完整的 token
+	RevealRefreshedToken bool `json:"-"`
+	// QuotaExceededPolicy 接口调用超过限制（45009）时的处理策略，默认直接返回错误
+	// 目前仅作用于模板消息 Send/SendContext/SendReliable 及 user.ListAllUserOpenIDsWithOptions，其他接口始终直接返回错误
+	QuotaExceededPolicy util.QuotaExceededPolicy `json:"-"`
+}
+
+// GetTokenCache 返回存储凭证使用的缓存，未设置 TokenCache 时返回 Cache
+func (cfg *Config) GetTokenCache() cache.Cache {
+	if cfg.TokenCache != nil {
+		return cfg.TokenCache
+	}
+	return cfg.Cache
 }
@@ -1,6 +1,7 @@
 package customerservice
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -66,7 +67,7 @@ func (csm *Manager) List() (customerServiceList []*KeFuInfo, err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerServiceListURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "customerservice.ListCustomerService"), uri)
 	if err != nil {
 		return
 	}
@@ -97,7 +98,7 @@ func (csm *Manager) OnlineList() (customerServiceOnlineList []*KeFuOnlineInfo, e
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerServiceOnlineListURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "customerservice.ListOnlineCustomerService"), uri)
 	if err != nil {
 		return
 	}
@@ -125,7 +126,7 @@ func (csm *Manager) Add(kfAccount, nickName string) (err error) {
 		NickName:  nickName,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "customerservice.AddCustomerService"), uri, data)
 	if err != nil {
 		return
 	}
@@ -149,7 +150,7 @@ func (csm *Manager) Update(kfAccount, nickName string) (err error) {
 		NickName:  nickName,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "customerservice.UpdateCustomerService"), uri, data)
 	if err != nil {
 		return
 	}
@@ -171,7 +172,7 @@ func (csm *Manager) Delete(kfAccount string) (err error) {
 		KfAccount: kfAccount,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "customerservice.DeleteCustomerService"), uri, data)
 	if err != nil {
 		return
 	}
@@ -195,7 +196,7 @@ func (csm *Manager) InviteBind(kfAccount, inviteWX string) (err error) {
 		InviteWX:  inviteWX,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "customerservice.InviteBindCustomerService"), uri, data)
 	if err != nil {
 		return
 	}
@@ -236,7 +237,7 @@ func (csm *Manager) SendTypingStatus(openid string, cmd TypingStatus) (err error
 		Command: string(cmd),
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "customerservice.SendTypingStatus"), uri, data)
 	if err != nil {
 		return
 	}
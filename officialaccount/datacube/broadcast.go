@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -146,12 +147,12 @@ func (cube *DataCube) GetArticleSummary(s string, e string) (resArticleSummary R
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getArticleSummary, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 1)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetArticleSummary"), uri, req)
 	if err != nil {
 		return
 	}
@@ -168,12 +169,12 @@ func (cube *DataCube) GetArticleTotal(s string, e string) (resArticleTotal ResAr
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getArticleTotal, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 1)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetArticleTotal"), uri, req)
 	if err != nil {
 		return
 	}
@@ -190,12 +191,12 @@ func (cube *DataCube) GetUserRead(s string, e string) (resUserRead ResUserRead,
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUserRead, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 3)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUserRead"), uri, req)
 	if err != nil {
 		return
 	}
@@ -212,12 +213,12 @@ func (cube *DataCube) GetUserReadHour(s string, e string) (resUserReadHour ResUs
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUserReadHour, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 1)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUserReadHour"), uri, req)
 	if err != nil {
 		return
 	}
@@ -234,12 +235,12 @@ func (cube *DataCube) GetUserShare(s string, e string) (resUserShare ResUserShar
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUserShare, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 7)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUserShare"), uri, req)
 	if err != nil {
 		return
 	}
@@ -256,12 +257,12 @@ func (cube *DataCube) GetUserShareHour(s string, e string) (resUserShareHour Res
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUserShareHour, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 1)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUserShareHour"), uri, req)
 	if err != nil {
 		return
 	}
@@ -2,6 +2,7 @@ package datacube
 
 import (
 	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/util"
 )
 
 type reqDate struct {
@@ -9,6 +10,20 @@ type reqDate struct {
 	EndDate   string `json:"end_date"`
 }
 
+// newReqDate 校验起止日期，接口限制的最大时间跨度为 maxDays 天
+// 日期支持 YYYY-MM-DD 及 YYYYMMDD 格式，统一转换为接口要求的 YYYY-MM-DD
+func newReqDate(s, e string, maxDays int) (*reqDate, error) {
+	dateRange, err := util.ParseDateRange(s, e)
+	if err != nil {
+		return nil, err
+	}
+	if err = dateRange.Validate(maxDays); err != nil {
+		return nil, err
+	}
+	begin, end := dateRange.FormatLayout(util.DashDateLayout)
+	return &reqDate{BeginDate: begin, EndDate: end}, nil
+}
+
 // DataCube 数据统计
 type DataCube struct {
 	*context.Context
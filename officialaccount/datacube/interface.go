@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -46,12 +47,12 @@ func (cube *DataCube) GetInterfaceSummary(s string, e string) (resInterfaceSumma
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getInterfaceSummary, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 30)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetInterfaceSummary"), uri, req)
 	if err != nil {
 		return
 	}
@@ -68,12 +69,12 @@ func (cube *DataCube) GetInterfaceSummaryHour(s string, e string) (resInterfaceS
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getInterfaceSummaryHour, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 1)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetInterfaceSummaryHour"), uri, req)
 	if err != nil {
 		return
 	}
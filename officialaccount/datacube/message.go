@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -106,12 +107,12 @@ func (cube *DataCube) GetUpstreamMsg(s string, e string) (resUpstreamMsg ResUpst
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUpstreamMsg, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 7)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUpstreamMsg"), uri, req)
 	if err != nil {
 		return
 	}
@@ -128,12 +129,12 @@ func (cube *DataCube) GetUpstreamMsgHour(s string, e string) (resUpstreamMsgHour
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUpstreamMsgHour, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 1)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUpstreamMsgHour"), uri, req)
 	if err != nil {
 		return
 	}
@@ -150,12 +151,12 @@ func (cube *DataCube) GetUpstreamMsgWeek(s string, e string) (resUpstreamMsgWeek
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUpstreamMsgWeek, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 30)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUpstreamMsgWeek"), uri, req)
 	if err != nil {
 		return
 	}
@@ -172,12 +173,12 @@ func (cube *DataCube) GetUpstreamMsgMonth(s string, e string) (resUpstreamMsgMon
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUpstreamMsgMonth, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 30)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUpstreamMsgMonth"), uri, req)
 	if err != nil {
 		return
 	}
@@ -194,12 +195,12 @@ func (cube *DataCube) GetUpstreamMsgDist(s string, e string) (resUpstreamMsgDist
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUpstreamMsgDist, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 15)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUpstreamMsgDist"), uri, req)
 	if err != nil {
 		return
 	}
@@ -216,12 +217,12 @@ func (cube *DataCube) GetUpstreamMsgDistWeek(s string, e string) (resUpstreamMsg
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUpstreamMsgDistWeek, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 30)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUpstreamMsgDistWeek"), uri, req)
 	if err != nil {
 		return
 	}
@@ -238,12 +239,12 @@ func (cube *DataCube) GetUpstreamMsgDistMonth(s string, e string) (resUpstreamMs
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUpstreamMsgDistMonth, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 30)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "datacube.GetUpstreamMsgDistMonth"), uri, req)
 	if err != nil {
 		return
 	}
@@ -1,7 +1,10 @@
 package datacube
 
 import (
+	context2 "context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/silenceper/wechat/v2/util"
 )
@@ -9,6 +12,9 @@ import (
 const (
 	getUserSummary    = "https://api.weixin.qq.com/datacube/getusersummary"
 	getUserAccumulate = "https://api.weixin.qq.com/datacube/getusercumulate"
+
+	// userSummaryMaxDays 获取用户增减数据的最大时间跨度
+	userSummaryMaxDays = 7
 )
 
 // ResUserSummary 获取用户增减数据响应
@@ -33,20 +39,25 @@ type ResUserAccumulate struct {
 	} `json:"list"`
 }
 
-// GetUserSummary 获取用户增减数据
+// GetUserSummary 获取用户增减数据，最大时间跨度为 7 天
 func (cube *DataCube) GetUserSummary(s string, e string) (resUserSummary ResUserSummary, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUserSummaryContext(context2.Background(), s, e)
+}
+
+// GetUserSummaryContext 获取用户增减数据，最大时间跨度为 7 天
+func (cube *DataCube) GetUserSummaryContext(ctx context2.Context, s string, e string) (resUserSummary ResUserSummary, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUserSummary, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, userSummaryMaxDays)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(ctx, "datacube.GetUserSummary"), uri, req)
 	if err != nil {
 		return
 	}
@@ -55,6 +66,29 @@ func (cube *DataCube) GetUserSummary(s string, e string) (resUserSummary ResUser
 	return
 }
 
+// GetUserSummaryRange 获取任意时间跨度的用户增减数据，按 7 天拆分为多次请求，结果按日期排序后合并
+func (cube *DataCube) GetUserSummaryRange(ctx context2.Context, begin, end time.Time) (resUserSummary ResUserSummary, err error) {
+	dateRange := util.DateRange{Begin: begin, End: end}
+	if err = dateRange.Validate(0); err != nil {
+		return
+	}
+	for _, window := range dateRange.Split(userSummaryMaxDays) {
+		if err = ctx.Err(); err != nil {
+			return ResUserSummary{}, err
+		}
+		s, e := window.FormatLayout(util.DashDateLayout)
+		var res ResUserSummary
+		if res, err = cube.GetUserSummaryContext(ctx, s, e); err != nil {
+			return ResUserSummary{}, err
+		}
+		resUserSummary.List = append(resUserSummary.List, res.List...)
+	}
+	sort.SliceStable(resUserSummary.List, func(i, j int) bool {
+		return resUserSummary.List[i].RefDate < resUserSummary.List[j].RefDate
+	})
+	return
+}
+
 // GetUserAccumulate 获取累计用户数据
 func (cube *DataCube) GetUserAccumulate(s string, e string) (resUserAccumulate ResUserAccumulate, err error) {
 	accessToken, err := cube.GetAccessToken()
@@ -63,12 +97,12 @@ func (cube *DataCube) GetUserAccumulate(s string, e string) (resUserAccumulate R
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", getUserAccumulate, accessToken)
-	reqDate := &reqDate{
-		BeginDate: s,
-		EndDate:   e,
+	req, err := newReqDate(s, e, 7)
+	if err != nil {
+		return
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "datacube.GetUserAccumulate"), uri, req)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,74 @@
+package datacube
+
+import (
+	context2 "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestDataCube() *DataCube {
+	return NewCube(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+}
+
+// cst 北京时间，避免测试结果依赖运行环境的时区
+var cst = time.FixedZone("CST", 8*3600)
+
+func TestGetUserSummaryRange(t *testing.T) {
+	defer gock.Off()
+	windows := [][2]string{
+		{"2024-01-01", "2024-01-07"},
+		{"2024-01-08", "2024-01-14"},
+		{"2024-01-15", "2024-01-20"},
+	}
+	for _, w := range windows {
+		gock.New("https://api.weixin.qq.com").Post("/datacube/getusersummary").
+			BodyString(`"begin_date":"` + w[0] + `","end_date":"` + w[1] + `"`).
+			Reply(200).JSON(map[string]interface{}{
+			"list": []map[string]interface{}{
+				{"ref_date": w[1], "user_source": 0, "new_user": 2, "cancel_user": 1},
+				{"ref_date": w[0], "user_source": 0, "new_user": 1, "cancel_user": 0},
+			},
+		})
+	}
+
+	begin := time.Date(2024, 1, 1, 0, 0, 0, 0, cst)
+	end := time.Date(2024, 1, 20, 0, 0, 0, 0, cst)
+	res, err := newTestDataCube().GetUserSummaryRange(context2.Background(), begin, end)
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+	if assert.Len(t, res.List, 6) {
+		dates := make([]string, 0, len(res.List))
+		for _, item := range res.List {
+			dates = append(dates, item.RefDate)
+		}
+		assert.Equal(t, []string{
+			"2024-01-01", "2024-01-07", "2024-01-08", "2024-01-14", "2024-01-15", "2024-01-20",
+		}, dates)
+	}
+}
+
+func TestGetUserSummaryRangeCanceled(t *testing.T) {
+	defer gock.Off()
+	ctx, cancel := context2.WithCancel(context2.Background())
+	cancel()
+
+	begin := time.Date(2024, 1, 1, 0, 0, 0, 0, cst)
+	end := time.Date(2024, 1, 20, 0, 0, 0, 0, cst)
+	_, err := newTestDataCube().GetUserSummaryRange(ctx, begin, end)
+	assert.ErrorIs(t, err, context2.Canceled)
+}
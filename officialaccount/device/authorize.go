@@ -2,6 +2,7 @@
 package device
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -99,7 +100,7 @@ func (d *Device) DeviceAuthorize(devices []ReqDevice, opType int, product string
 		ProductID:  product,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "device.DeviceAuthorize"), uri, req)
 	if err != nil {
 		return nil, err
 	}
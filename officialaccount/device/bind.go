@@ -1,6 +1,7 @@
 package device
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -25,7 +26,7 @@ func (d *Device) Bind(req ReqBind) (err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriBind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "device.Bind"), uri, req); err != nil {
 		return
 	}
 	var result resBind
@@ -47,7 +48,7 @@ func (d *Device) Unbind(req ReqBind) (err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriUnbind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "device.Unbind"), uri, req); err != nil {
 		return
 	}
 	var result resBind
@@ -69,7 +70,7 @@ func (d *Device) CompelBind(req ReqBind) (err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriCompelBind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "device.CompelBind"), uri, req); err != nil {
 		return
 	}
 	var result resBind
@@ -91,7 +92,7 @@ func (d *Device) CompelUnbind(req ReqBind) (err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriCompelUnbind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "device.CompelUnbind"), uri, req); err != nil {
 		return
 	}
 	var result resBind
@@ -1,6 +1,7 @@
 package device
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
@@ -46,7 +47,7 @@ func (d *Device) State(device string) (res ResDeviceState, err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s&device_id=%s", uriState, accessToken, device)
 	var response []byte
-	if response, err = util.HTTPGet(uri); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "device.State"), uri); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &res); err != nil {
@@ -1,6 +1,7 @@
 package device
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -29,7 +30,7 @@ func (d *Device) CreateQRCode(devices []string) (res ResCreateQRCode, err error)
 		"device_id_list": devices,
 	}
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "device.CreateQRCode"), uri, req); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &res); err != nil {
@@ -62,7 +63,7 @@ func (d *Device) VerifyQRCode(ticket string) (res ResVerifyQRCode, err error) {
 	}
 
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "device.VerifyQRCode"), uri, req); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &res); err != nil {
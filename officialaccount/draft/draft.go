@@ -1,6 +1,7 @@
 package draft
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -54,7 +55,7 @@ func (draft *Draft) AddDraft(articles []*Article) (mediaID string, err error) {
 	req.Articles = articles
 
 	uri := fmt.Sprintf("%s?access_token=%s", addURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "draft.AddDraft"), uri, req)
 	if err != nil {
 		return
 	}
@@ -80,7 +81,7 @@ func (draft *Draft) GetDraft(mediaID string) (articles []*Article, err error) {
 	req.MediaID = mediaID
 
 	uri := fmt.Sprintf("%s?access_token=%s", getURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "draft.GetDraft"), uri, req)
 	if err != nil {
 		return
 	}
@@ -107,7 +108,7 @@ func (draft *Draft) DeleteDraft(mediaID string) (err error) {
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", deleteURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "draft.DeleteDraft"), uri, req)
 	if err != nil {
 		return
 	}
@@ -135,7 +136,7 @@ func (draft *Draft) UpdateDraft(article *Article, mediaID string, index uint) (e
 
 	uri := fmt.Sprintf("%s?access_token=%s", updateURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "draft.UpdateDraft"), uri, req)
 	if err != nil {
 		return
 	}
@@ -153,7 +154,7 @@ func (draft *Draft) CountDraft() (total uint, err error) {
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", countURL, accessToken)
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "draft.CountDraft"), uri)
 	if err != nil {
 		return
 	}
@@ -204,7 +205,7 @@ func (draft *Draft) PaginateDraft(offset, count int64, noReturnContent bool) (li
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", paginateURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "draft.PaginateDraft"), uri, req)
 	if err != nil {
 		return
 	}
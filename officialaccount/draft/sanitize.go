@@ -0,0 +1,88 @@
+package draft
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// ImageUploader 将外部图片上传至微信（如 material.ImageUpload），返回图文消息内可用的图片 URL
+type ImageUploader func(src string) (url string, err error)
+
+var (
+	tagRegexp  = regexp.MustCompile(`(?s)<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:[^>"']|"[^"]*"|'[^']*')*?)(/?)>`)
+	attrRegexp = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+)))?`)
+	// dropContentRegexp 连同内容一起删除的标签
+	dropContentRegexp = regexp.MustCompile(`(?is)<(script|style|iframe|object|embed|form|textarea|select|noscript)\b.*?</(script|style|iframe|object|embed|form|textarea|select|noscript)\s*>`)
+	commentRegexp     = regexp.MustCompile(`(?s)<!--.*?-->`)
+)
+
+// allowedTags 图文消息支持的标签
+var allowedTags = map[string]bool{
+	"a": true, "b": true, "blockquote": true, "br": true, "code": true, "em": true, "figcaption": true, "figure": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "hr": true, "i": true, "img": true,
+	"li": true, "ol": true, "p": true, "pre": true, "section": true, "span": true, "strong": true, "sub": true,
+	"sup": true, "table": true, "tbody": true, "td": true, "th": true, "thead": true, "tr": true, "u": true, "ul": true,
+}
+
+// allowedAttrs 图文消息支持的属性
+var allowedAttrs = map[string]bool{
+	"style": true, "class": true, "href": true, "src": true, "data-src": true, "alt": true, "title": true,
+	"width": true, "height": true, "colspan": true, "rowspan": true, "align": true,
+}
+
+// SanitizeContent 清理图文消息内容，删除微信不支持的标签及属性（如 script、事件属性），
+// 并通过 uploadImage 将外部图片上传后替换 src；uploadImage 为空时保留原地址，上传失败的图片会被删除
+func SanitizeContent(content string, uploadImage ImageUploader) string {
+	content = commentRegexp.ReplaceAllString(content, "")
+	content = dropContentRegexp.ReplaceAllString(content, "")
+	return tagRegexp.ReplaceAllStringFunc(content, func(tag string) string {
+		match := tagRegexp.FindStringSubmatch(tag)
+		closing, name, rawAttrs, selfClosing := match[1], strings.ToLower(match[2]), match[3], match[4]
+		if !allowedTags[name] {
+			return ""
+		}
+		if closing != "" {
+			return "</" + name + ">"
+		}
+
+		var b strings.Builder
+		b.WriteString("<" + name)
+		for _, attr := range attrRegexp.FindAllStringSubmatch(rawAttrs, -1) {
+			attrName := strings.ToLower(attr[1])
+			if !allowedAttrs[attrName] {
+				continue
+			}
+			value := html.UnescapeString(attr[2] + attr[3] + attr[4])
+			if (attrName == "href" || attrName == "src" || attrName == "data-src") && isUnsafeURL(value) {
+				continue
+			}
+			if name == "img" && (attrName == "src" || attrName == "data-src") && !isWeChatImage(value) && uploadImage != nil {
+				uploaded, err := uploadImage(value)
+				if err != nil || uploaded == "" {
+					return ""
+				}
+				value = uploaded
+			}
+			b.WriteString(" " + attrName + `="` + html.EscapeString(value) + `"`)
+		}
+		b.WriteString(selfClosing + ">")
+		return b.String()
+	})
+}
+
+// isUnsafeURL 判断是否为 javascript: 等不安全的地址
+func isUnsafeURL(value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	return strings.HasPrefix(value, "javascript:") || strings.HasPrefix(value, "vbscript:") || strings.HasPrefix(value, "data:")
+}
+
+// isWeChatImage 判断是否为微信服务器上的图片
+func isWeChatImage(src string) bool {
+	for _, host := range []string{"mmbiz.qpic.cn", "mmbiz.qlogo.cn"} {
+		if strings.Contains(src, "://"+host+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package draft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeContentRemovesDisallowed(t *testing.T) {
+	content := `<p onclick="alert(1)" style="color:red">hello<script>alert("x")</script></p>` +
+		`<iframe src="https://example.com"></iframe><div><a href="javascript:alert(1)">link</a></div>` +
+		`<!-- comment --><input type="text"><br/>`
+	assert.Equal(t, `<p style="color:red">hello</p><a>link</a><br/>`, SanitizeContent(content, nil))
+}
+
+func TestSanitizeContentRewritesImages(t *testing.T) {
+	var uploaded []string
+	uploader := func(src string) (string, error) {
+		uploaded = append(uploaded, src)
+		if src == "https://example.com/broken.png" {
+			return "", errors.New("upload failed")
+		}
+		return "http://mmbiz.qpic.cn/mmbiz_png/uploaded/0", nil
+	}
+	content := `<p><img src="https://example.com/a.png?x=1&amp;y=2" alt="a"></p>` +
+		`<img src="http://mmbiz.qpic.cn/mmbiz_jpg/exists/0">` +
+		`<img src="https://example.com/broken.png">`
+	assert.Equal(t,
+		`<p><img src="http://mmbiz.qpic.cn/mmbiz_png/uploaded/0" alt="a"></p><img src="http://mmbiz.qpic.cn/mmbiz_jpg/exists/0">`,
+		SanitizeContent(content, uploader))
+	assert.Equal(t, []string{"https://example.com/a.png?x=1&y=2", "https://example.com/broken.png"}, uploaded)
+}
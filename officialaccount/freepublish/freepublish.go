@@ -1,6 +1,7 @@
 package freepublish
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -63,7 +64,7 @@ func (freePublish *FreePublish) Publish(mediaID string) (publishID int64, err er
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", publishURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "freepublish.SubmitFreePublish"), uri, req)
 	if err != nil {
 		return
 	}
@@ -112,7 +113,7 @@ func (freePublish *FreePublish) SelectStatus(publishID int64) (list PublishStatu
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", selectStateURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "freepublish.SelectStatusFreePublish"), uri, req)
 	if err != nil {
 		return
 	}
@@ -139,7 +140,7 @@ func (freePublish *FreePublish) Delete(articleID string, index uint) (err error)
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", deleteURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "freepublish.DeleteFreePublish"), uri, req)
 	if err != nil {
 		return err
 	}
@@ -176,7 +177,7 @@ func (freePublish *FreePublish) First(articleID string) (list []Article, err err
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", firstArticleURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "freepublish.FirstFreePublish"), uri, req)
 	if err != nil {
 		return
 	}
@@ -189,6 +190,20 @@ func (freePublish *FreePublish) First(articleID string) (list []Article, err err
 	return res.NewsItem, err
 }
 
+// GetArticleURL 通过 article_id 获取已发布图文的永久链接（多图文时返回第一篇），可用于预览
+func (freePublish *FreePublish) GetArticleURL(articleID string) (string, error) {
+	list, err := freePublish.First(articleID)
+	if err != nil {
+		return "", err
+	}
+	for _, article := range list {
+		if !article.IsDeleted && article.URL != "" {
+			return article.URL, nil
+		}
+	}
+	return "", fmt.Errorf("GetArticleURL error : no published article found for article_id=%s", articleID)
+}
+
 // ArticleList 发布列表
 type ArticleList struct {
 	util.CommonError
@@ -228,7 +243,7 @@ func (freePublish *FreePublish) Paginate(offset, count int64, noReturnContent bo
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", paginateURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "freepublish.PaginateFreePublish"), uri, req)
 	if err != nil {
 		return
 	}
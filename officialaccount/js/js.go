@@ -21,13 +21,24 @@ type Config struct {
 	Timestamp int64  `json:"timestamp"`
 	NonceStr  string `json:"nonce_str"`
 	Signature string `json:"signature"`
+	Debug     bool   `json:"debug"` // 对应前端 wx.config 的 debug 参数
+}
+
+// ConfigOption jssdk 配置参数选项
+type ConfigOption func(config *Config)
+
+// WithDebug 设置是否开启 wx.config 调试模式，可按环境由后端控制
+func WithDebug(debug bool) ConfigOption {
+	return func(config *Config) {
+		config.Debug = debug
+	}
 }
 
 // NewJs init
 func NewJs(context *context.Context) *Js {
 	js := new(Js)
 	js.Context = context
-	jsTicketHandle := credential.NewDefaultJsTicket(context.AppID, credential.CacheKeyOfficialAccountPrefix, context.Cache)
+	jsTicketHandle := credential.NewDefaultJsTicket(context.AppID, credential.CacheKeyOfficialAccountPrefix, context.GetTokenCache())
 	js.SetJsTicketHandle(jsTicketHandle)
 	return js
 }
@@ -39,45 +50,65 @@ func (js *Js) SetJsTicketHandle(ticketHandle credential.JsTicketHandle) {
 
 // GetConfig 获取jssdk需要的配置参数
 // uri 为当前网页地址
-func (js *Js) GetConfig(uri string) (config *Config, err error) {
-	return js.GetConfigContext(context2.Background(), uri)
+func (js *Js) GetConfig(uri string, opts ...ConfigOption) (config *Config, err error) {
+	return js.GetConfigContext(context2.Background(), uri, opts...)
 }
 
 // GetConfigContext  新方法，允许传入上下文，避免协程泄漏
-func (js *Js) GetConfigContext(ctx context2.Context, uri string) (config *Config, err error) {
-	var accessToken string
-	// 类型断言，如果断言成功，调用安全的 GetAccessTokenContext 方法
-	if ctxHandle, ok := js.Context.AccessTokenHandle.(credential.AccessTokenContextHandle); ok {
-		accessToken, err = ctxHandle.GetAccessTokenContext(ctx)
-	} else {
-		// 如果没有实现 AccessTokenContextHandle 接口，调用旧的 GetAccessToken 方法
-		accessToken, err = js.Context.GetAccessToken()
-	}
-	if err != nil {
+func (js *Js) GetConfigContext(ctx context2.Context, uri string, opts ...ConfigOption) (config *Config, err error) {
+	var ticketStr string
+	if ticketStr, err = js.getTicketContext(ctx); err != nil {
 		return
 	}
+	config = js.buildConfig(ticketStr, uri)
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config, nil
+}
 
+// GetConfigs 批量获取多个网页地址的 jssdk 配置参数，返回以网页地址为 key 的配置
+// 只获取一次 jsapi_ticket，适用于单页应用在路由切换时需要重新签名的场景
+func (js *Js) GetConfigs(ctx context2.Context, urls []string) (configs map[string]*Config, err error) {
 	var ticketStr string
-	// 类型断言 jsTicket
-	if ticketCtxHandle, ok := js.JsTicketHandle.(credential.JsTicketContextHandle); ok {
-		ticketStr, err = ticketCtxHandle.GetTicketContext(ctx, accessToken)
-	} else {
-		// 如果没有实现 JsTicketContextHandle 接口，调用旧的 GetTicket 方法
-		ticketStr, err = js.GetTicket(accessToken)
+	if ticketStr, err = js.getTicketContext(ctx); err != nil {
+		return
+	}
+	configs = make(map[string]*Config, len(urls))
+	for _, uri := range urls {
+		configs[uri] = js.buildConfig(ticketStr, uri)
 	}
+	return
+}
+
+// getTicketContext 获取 jsapi_ticket
+func (js *Js) getTicketContext(ctx context2.Context) (ticketStr string, err error) {
+	var accessToken string
+	// 支持通过 ctx 指定 access_token，获取方式实现了 AccessTokenContextHandle 时调用其 GetAccessTokenContext 方法
+	accessToken, err = js.Context.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
+	// 类型断言 jsTicket
+	if ticketCtxHandle, ok := js.JsTicketHandle.(credential.JsTicketContextHandle); ok {
+		return ticketCtxHandle.GetTicketContext(ctx, accessToken)
+	}
+	// 如果没有实现 JsTicketContextHandle 接口，调用旧的 GetTicket 方法
+	return js.GetTicket(accessToken)
+}
+
+// buildConfig 使用 jsapi_ticket 对网页地址签名，生成 jssdk 配置参数
+func (js *Js) buildConfig(ticketStr, uri string) *Config {
 	nonceStr := util.RandomStr(16)
 	timestamp := util.GetCurrTS()
 	str := fmt.Sprintf("jsapi_ticket=%s&noncestr=%s&timestamp=%d&url=%s", ticketStr, nonceStr, timestamp, uri)
 	sigStr := util.Signature(str)
 
-	config = new(Config)
+	config := new(Config)
 	config.AppID = js.AppID
 	config.NonceStr = nonceStr
 	config.Timestamp = timestamp
 	config.Signature = sigStr
-	return
+	return config
 }
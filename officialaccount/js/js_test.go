@@ -0,0 +1,64 @@
+package js
+
+import (
+	context2 "context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+// countingTicketHandle 记录获取 ticket 的次数
+type countingTicketHandle struct {
+	calls int
+}
+
+func (h *countingTicketHandle) GetTicket(_ string) (string, error) {
+	h.calls++
+	return "mock-ticket", nil
+}
+
+func TestGetConfigs(t *testing.T) {
+	ticketHandle := &countingTicketHandle{}
+	js := NewJs(&context.Context{Config: &config.Config{AppID: "mock-appid"}, AccessTokenHandle: mockAccessTokenHandle{}})
+	js.SetJsTicketHandle(ticketHandle)
+
+	urls := []string{"https://example.com/", "https://example.com/#/detail?id=1", "https://example.com/about"}
+	configs, err := js.GetConfigs(context2.Background(), urls)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, ticketHandle.calls)
+	assert.Len(t, configs, len(urls))
+	for _, uri := range urls {
+		cfg := configs[uri]
+		assert.Equal(t, "mock-appid", cfg.AppID)
+		str := fmt.Sprintf("jsapi_ticket=mock-ticket&noncestr=%s&timestamp=%d&url=%s", cfg.NonceStr, cfg.Timestamp, uri)
+		assert.Equal(t, util.Signature(str), cfg.Signature)
+	}
+}
+
+func TestGetConfigDebug(t *testing.T) {
+	js := NewJs(&context.Context{Config: &config.Config{AppID: "mock-appid"}, AccessTokenHandle: mockAccessTokenHandle{}})
+	js.SetJsTicketHandle(&countingTicketHandle{})
+
+	cfg, err := js.GetConfig("https://example.com/", WithDebug(true))
+	assert.Nil(t, err)
+	assert.True(t, cfg.Debug)
+	data, err := json.Marshal(cfg)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"debug":true`)
+
+	cfg, err = js.GetConfigContext(context2.Background(), "https://example.com/")
+	assert.Nil(t, err)
+	assert.False(t, cfg.Debug)
+}
@@ -1,6 +1,7 @@
 package material
 
 import (
+	context2 "context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -74,7 +75,7 @@ func (material *Material) GetNews(id string) ([]*Article, error) {
 		MediaID string `json:"media_id"`
 	}
 	req.MediaID = id
-	responseBytes, err := util.PostJSON(uri, req)
+	responseBytes, err := util.PostJSONContext(util.WithOperation(context2.Background(), "material.GetNews"), uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +114,7 @@ func (material *Material) AddNews(articles []*Article) (mediaID string, err erro
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", addNewsURL, accessToken)
-	responseBytes, err := util.PostJSON(uri, req)
+	responseBytes, err := util.PostJSONContext(util.WithOperation(context2.Background(), "material.AddNews"), uri, req)
 	if err != nil {
 		return
 	}
@@ -148,7 +149,7 @@ func (material *Material) UpdateNews(article *Article, mediaID string, index int
 
 	uri := fmt.Sprintf("%s?access_token=%s", updateNewsURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "material.UpdateNews"), uri, req)
 	if err != nil {
 		return
 	}
@@ -292,7 +293,7 @@ func (material *Material) DeleteMaterial(mediaID string) error {
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", delMaterialURL, accessToken)
-	response, err := util.PostJSON(uri, reqDeleteMaterial{mediaID})
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "material.DeleteMaterial"), uri, reqDeleteMaterial{mediaID})
 	if err != nil {
 		return err
 	}
@@ -335,8 +336,13 @@ type reqBatchGetMaterial struct {
 //
 //reference:https://developers.weixin.qq.com/doc/offiaccount/Asset_Management/Get_materials_list.html
 func (material *Material) BatchGetMaterial(permanentMaterialType PermanentMaterialType, offset, count int64) (list ArticleList, err error) {
+	return material.BatchGetMaterialContext(context2.Background(), permanentMaterialType, offset, count)
+}
+
+// BatchGetMaterialContext 批量获取永久素材
+func (material *Material) BatchGetMaterialContext(ctx context2.Context, permanentMaterialType PermanentMaterialType, offset, count int64) (list ArticleList, err error) {
 	var accessToken string
-	accessToken, err = material.GetAccessToken()
+	accessToken, err = material.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -349,7 +355,7 @@ func (material *Material) BatchGetMaterial(permanentMaterialType PermanentMateri
 	}
 
 	var response []byte
-	response, err = util.PostJSON(uri, req)
+	response, err = util.PostJSONContext(util.WithOperation(ctx, "material.BatchGetMaterial"), uri, req)
 	if err != nil {
 		return
 	}
@@ -369,17 +375,73 @@ type ResMaterialCount struct {
 
 // GetMaterialCount 获取素材总数。
 func (material *Material) GetMaterialCount() (res ResMaterialCount, err error) {
+	return material.GetMaterialCountContext(context2.Background())
+}
+
+// GetMaterialCountContext 获取素材总数。
+func (material *Material) GetMaterialCountContext(ctx context2.Context) (res ResMaterialCount, err error) {
 	var accessToken string
-	accessToken, err = material.GetAccessToken()
+	accessToken, err = material.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", getMaterialCountURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "material.GetMaterialCount"), uri)
 	if err != nil {
 		return
 	}
 	err = util.DecodeWithError(response, &res, "GetMaterialCount")
 	return
 }
+
+// countOf 返回指定类型的素材总数
+func (res ResMaterialCount) countOf(permanentMaterialType PermanentMaterialType) (int64, error) {
+	switch permanentMaterialType {
+	case PermanentMaterialTypeImage:
+		return res.ImageCount, nil
+	case PermanentMaterialTypeVideo:
+		return res.VideoCount, nil
+	case PermanentMaterialTypeVoice:
+		return res.VoiceCount, nil
+	case PermanentMaterialTypeNews:
+		return res.NewsCount, nil
+	}
+	return 0, fmt.Errorf("unknown permanent material type: %s", permanentMaterialType)
+}
+
+// MaterialItem 永久素材列表中的素材
+type MaterialItem = ArticleListItem
+
+// batchGetMaterialMaxCount 批量获取永久素材单次最多返回的数量
+const batchGetMaterialMaxCount = 20
+
+// ListAllMaterial 分页遍历指定类型的全部永久素材，每获取一页调用一次 onPage
+// 以 GetMaterialCount 返回的总数作为遍历上限，onPage 返回错误或 ctx 取消时停止遍历
+func (material *Material) ListAllMaterial(ctx context2.Context, materialType PermanentMaterialType, onPage func(items []MaterialItem) error) error {
+	countRes, err := material.GetMaterialCountContext(ctx)
+	if err != nil {
+		return err
+	}
+	total, err := countRes.countOf(materialType)
+	if err != nil {
+		return err
+	}
+	for offset := int64(0); offset < total; {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		var list ArticleList
+		if list, err = material.BatchGetMaterialContext(ctx, materialType, offset, batchGetMaterialMaxCount); err != nil {
+			return err
+		}
+		if len(list.Item) == 0 {
+			return nil
+		}
+		if err = onPage(list.Item); err != nil {
+			return err
+		}
+		offset += int64(len(list.Item))
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package material
+
+import (
+	context2 "context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+func mockMaterialPage(offset, count int) map[string]interface{} {
+	items := make([]map[string]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		items = append(items, map[string]interface{}{"media_id": fmt.Sprintf("media-%d", offset+i)})
+	}
+	return map[string]interface{}{"total_count": 45, "item_count": count, "item": items}
+}
+
+func TestListAllMaterial(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/material/get_materialcount").
+		Reply(200).JSON(map[string]interface{}{"image_count": 45, "news_count": 3})
+	for _, offset := range []int{0, 20, 40} {
+		count := 20
+		if offset == 40 {
+			count = 5
+		}
+		gock.New("https://api.weixin.qq.com").Post("/cgi-bin/material/batchget_material").
+			BodyString(fmt.Sprintf(`"type":"image","count":20,"offset":%d`, offset)).
+			Reply(200).JSON(mockMaterialPage(offset, count))
+	}
+
+	material := NewMaterial(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	var (
+		pages    int
+		mediaIDs []string
+	)
+	err := material.ListAllMaterial(context2.Background(), PermanentMaterialTypeImage, func(items []MaterialItem) error {
+		pages++
+		for _, item := range items {
+			mediaIDs = append(mediaIDs, item.MediaID)
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, pages)
+	assert.Len(t, mediaIDs, 45)
+	assert.Equal(t, "media-0", mediaIDs[0])
+	assert.Equal(t, "media-44", mediaIDs[44])
+	assert.True(t, gock.IsDone())
+}
+
+func TestListAllMaterialCanceled(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/material/get_materialcount").
+		Reply(200).JSON(map[string]interface{}{"image_count": 45})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/material/batchget_material").
+		Reply(200).JSON(mockMaterialPage(0, 20))
+
+	material := NewMaterial(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	ctx, cancel := context2.WithCancel(context2.Background())
+	var pages int
+	err := material.ListAllMaterial(ctx, PermanentMaterialTypeImage, func(items []MaterialItem) error {
+		pages++
+		cancel()
+		return nil
+	})
+	assert.ErrorIs(t, err, context2.Canceled)
+	assert.Equal(t, 1, pages)
+}
@@ -1,10 +1,13 @@
 package material
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 
+	"github.com/silenceper/wechat/v2/credential"
 	"github.com/silenceper/wechat/v2/util"
 )
 
@@ -65,19 +68,56 @@ func (material *Material) MediaUpload(mediaType MediaType, filename string) (med
 
 // MediaUploadFromReader 临时素材上传
 func (material *Material) MediaUploadFromReader(mediaType MediaType, filename string, reader io.Reader) (media Media, err error) {
-	var accessToken string
-	accessToken, err = material.GetAccessToken()
+	var byteData []byte
+	byteData, err = io.ReadAll(reader)
 	if err != nil {
 		return
 	}
+	return material.mediaUploadBytes(mediaType, filename, byteData)
+}
 
-	uri := fmt.Sprintf("%s?access_token=%s&type=%s", mediaUploadURL, accessToken, mediaType)
+// mediaCacheTTL 临时素材在微信服务器上保存 3 天，缓存时间需小于 3 天
+const mediaCacheTTL = 3*24*time.Hour - time.Hour
 
+// MediaUploadFromReaderWithCache 临时素材上传，相同内容的素材在有效期内只上传一次
+// 以素材内容的 sha256 作为 key，将上传结果缓存至 Cache 中，命中缓存时直接返回缓存的 media_id
+func (material *Material) MediaUploadFromReaderWithCache(mediaType MediaType, filename string, reader io.Reader) (media Media, err error) {
 	var byteData []byte
 	byteData, err = io.ReadAll(reader)
 	if err != nil {
 		return
 	}
+	if material.Cache == nil {
+		return material.mediaUploadBytes(mediaType, filename, byteData)
+	}
+
+	cacheKey := fmt.Sprintf("%s_media_%s_%s_%x", credential.CacheKeyOfficialAccountPrefix, material.AppID, mediaType, sha256.Sum256(byteData))
+	if val, ok := material.Cache.Get(cacheKey).(string); ok && val != "" {
+		if err = json.Unmarshal([]byte(val), &media); err == nil && media.MediaID != "" {
+			return
+		}
+	}
+
+	if media, err = material.mediaUploadBytes(mediaType, filename, byteData); err != nil {
+		return
+	}
+	var cacheVal []byte
+	if cacheVal, err = json.Marshal(media); err != nil {
+		return
+	}
+	err = material.Cache.Set(cacheKey, string(cacheVal), mediaCacheTTL)
+	return
+}
+
+// mediaUploadBytes 上传临时素材
+func (material *Material) mediaUploadBytes(mediaType MediaType, filename string, byteData []byte) (media Media, err error) {
+	var accessToken string
+	accessToken, err = material.GetAccessToken()
+	if err != nil {
+		return
+	}
+
+	uri := fmt.Sprintf("%s?access_token=%s&type=%s", mediaUploadURL, accessToken, mediaType)
 
 	var response []byte
 	response, err = util.PostFileByStream("media", filename, uri, byteData)
@@ -0,0 +1,43 @@
+package material
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (m mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func TestMediaUploadFromReaderWithCache(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/media/upload").Times(2).
+		Reply(200).JSON(map[string]interface{}{"type": "image", "media_id": "mock-media-id", "created_at": 1700000000})
+
+	material := NewMaterial(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid", Cache: cache.NewMemory()},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	content := []byte("mock image content")
+	for i := 0; i < 3; i++ {
+		media, err := material.MediaUploadFromReaderWithCache(MediaTypeImage, "reply.png", bytes.NewReader(content))
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-media-id", media.MediaID)
+		assert.Equal(t, MediaTypeImage, media.Type)
+	}
+	// 相同内容只上传一次，剩余一次 mock 未被使用
+	assert.Len(t, gock.Pending(), 1)
+
+	_, err := material.MediaUploadFromReaderWithCache(MediaTypeImage, "other.png", bytes.NewReader([]byte("other content")))
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
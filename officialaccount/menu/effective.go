@@ -0,0 +1,56 @@
+package menu
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// errCodeMenuNotMatch MenuTryMatch 未匹配到菜单（46002 不存在的菜单版本）
+const errCodeMenuNotMatch = 46002
+
+// EffectiveMenu 指定用户实际看到的菜单
+type EffectiveMenu struct {
+	Button []Button
+	// MenuID 菜单 id，来自默认菜单或命中的个性化菜单
+	MenuID int64
+	// Conditional 为 true 时表示命中了个性化菜单，MatchRule 为其匹配规则
+	Conditional bool
+	MatchRule   *MatchRule
+}
+
+// EffectiveMenu 返回指定用户实际看到的菜单，用于排查用户为何看到某个菜单
+// userID 可以是粉丝的 OpenID，也可以是粉丝的微信号
+// 优先使用 MenuTryMatch 的匹配结果并对应到具体的个性化菜单，接口返回未匹配（46002）时回退到默认菜单，其他错误直接返回
+func (menu *Menu) EffectiveMenu(userID string) (*EffectiveMenu, error) {
+	resMenu, err := menu.GetMenu()
+	if err != nil {
+		return nil, err
+	}
+	defaultMenu := &EffectiveMenu{Button: resMenu.Menu.Button, MenuID: resMenu.Menu.MenuID}
+	if len(resMenu.Conditionalmenu) == 0 {
+		return defaultMenu, nil
+	}
+
+	buttons, err := menu.MenuTryMatch(userID)
+	if err != nil {
+		var commonErr *util.CommonError
+		if errors.As(err, &commonErr) && commonErr.ErrCode == errCodeMenuNotMatch {
+			return defaultMenu, nil
+		}
+		return nil, err
+	}
+	for i := range resMenu.Conditionalmenu {
+		conditional := &resMenu.Conditionalmenu[i]
+		if reflect.DeepEqual(conditional.Button, buttons) {
+			return &EffectiveMenu{
+				Button:      conditional.Button,
+				MenuID:      conditional.MenuID,
+				Conditional: true,
+				MatchRule:   &conditional.MatchRule,
+			}, nil
+		}
+	}
+	return defaultMenu, nil
+}
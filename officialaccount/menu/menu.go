@@ -1,6 +1,7 @@
 package menu
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
@@ -39,13 +40,19 @@ type reqMenuTryMatch struct {
 	UserID string `json:"user_id"`
 }
 
-// resConditionalMenu 个性化菜单返回结果
-type resConditionalMenu struct {
+// ConditionalMenu 个性化菜单
+type ConditionalMenu struct {
 	Button    []Button  `json:"button"`
 	MatchRule MatchRule `json:"matchrule"`
 	MenuID    int64     `json:"menuid"`
 }
 
+// DefaultMenu 通过接口设置的默认菜单
+type DefaultMenu struct {
+	Button []Button `json:"button"`
+	MenuID int64    `json:"menuid"`
+}
+
 // resMenuTryMatch 菜单匹配请求结果
 type resMenuTryMatch struct {
 	util.CommonError
@@ -57,11 +64,8 @@ type resMenuTryMatch struct {
 type ResMenu struct {
 	util.CommonError
 
-	Menu struct {
-		Button []Button `json:"button"`
-		MenuID int64    `json:"menuid"`
-	} `json:"menu"`
-	Conditionalmenu []resConditionalMenu `json:"conditionalmenu"`
+	Menu            DefaultMenu       `json:"menu"`
+	Conditionalmenu []ConditionalMenu `json:"conditionalmenu"`
 }
 
 // ResSelfMenuInfo 自定义菜单配置返回结果
@@ -101,7 +105,9 @@ type ButtonNew struct {
 }
 
 // MatchRule 个性化菜单规则
+// 查询菜单时微信可能以数字形式返回 sex、client_platform_type 等字段，解析时统一转换为字符串
 type MatchRule struct {
+	TagID              string `json:"tag_id,omitempty"`
 	GroupID            string `json:"group_id,omitempty"`
 	Sex                string `json:"sex,omitempty"`
 	Country            string `json:"country,omitempty"`
@@ -111,6 +117,39 @@ type MatchRule struct {
 	Language           string `json:"language,omitempty"`
 }
 
+// UnmarshalJSON 兼容字符串及数字形式的规则字段
+func (rule *MatchRule) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	targets := map[string]*string{
+		"tag_id":               &rule.TagID,
+		"group_id":             &rule.GroupID,
+		"sex":                  &rule.Sex,
+		"country":              &rule.Country,
+		"province":             &rule.Province,
+		"city":                 &rule.City,
+		"client_platform_type": &rule.ClientPlatformType,
+		"language":             &rule.Language,
+	}
+	for key, target := range targets {
+		raw, ok := fields[key]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(raw, target); err == nil {
+			continue
+		}
+		var number json.Number
+		if err := json.Unmarshal(raw, &number); err != nil {
+			return fmt.Errorf("invalid matchrule %s: %s", key, raw)
+		}
+		*target = number.String()
+	}
+	return nil
+}
+
 // NewMenu 实例
 func NewMenu(context *context.Context) *Menu {
 	menu := new(Menu)
@@ -130,7 +169,7 @@ func (menu *Menu) SetMenu(buttons []*Button) error {
 		Button: buttons,
 	}
 
-	response, err := util.PostJSON(uri, reqMenu)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "menu.SetMenu"), uri, reqMenu)
 	if err != nil {
 		return err
 	}
@@ -147,7 +186,7 @@ func (menu *Menu) SetMenuByJSON(jsonInfo string) error {
 
 	uri := fmt.Sprintf("%s?access_token=%s", menuCreateURL, accessToken)
 
-	response, err := util.HTTPPost(uri, jsonInfo)
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "menu.SetMenuByJSON"), uri, []byte(jsonInfo), nil)
 	if err != nil {
 		return err
 	}
@@ -164,7 +203,7 @@ func (menu *Menu) GetMenu() (resMenu ResMenu, err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", menuGetURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "menu.GetMenu"), uri)
 	if err != nil {
 		return
 	}
@@ -186,7 +225,7 @@ func (menu *Menu) DeleteMenu() error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", menuDeleteURL, accessToken)
-	response, err := util.HTTPGet(uri)
+	response, err := util.HTTPGetContext(util.WithOperation(context2.Background(), "menu.GetMenu"), uri)
 	if err != nil {
 		return err
 	}
@@ -207,7 +246,7 @@ func (menu *Menu) AddConditional(buttons []*Button, matchRule *MatchRule) error
 		MatchRule: matchRule,
 	}
 
-	response, err := util.PostJSON(uri, reqMenu)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "menu.AddConditional"), uri, reqMenu)
 	if err != nil {
 		return err
 	}
@@ -223,7 +262,7 @@ func (menu *Menu) AddConditionalByJSON(jsonInfo string) error {
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", menuAddConditionalURL, accessToken)
-	response, err := util.HTTPPost(uri, jsonInfo)
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "menu.AddConditional"), uri, []byte(jsonInfo), nil)
 	if err != nil {
 		return err
 	}
@@ -243,7 +282,7 @@ func (menu *Menu) DeleteConditional(menuID int64) error {
 		MenuID: menuID,
 	}
 
-	response, err := util.PostJSON(uri, reqDeleteConditional)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "menu.DeleteConditional"), uri, reqDeleteConditional)
 	if err != nil {
 		return err
 	}
@@ -261,17 +300,12 @@ func (menu *Menu) MenuTryMatch(userID string) (buttons []Button, err error) {
 	uri := fmt.Sprintf("%s?access_token=%s", menuTryMatchURL, accessToken)
 	reqMenuTryMatch := &reqMenuTryMatch{userID}
 	var response []byte
-	response, err = util.PostJSON(uri, reqMenuTryMatch)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "menu.MenuTryMatch"), uri, reqMenuTryMatch)
 	if err != nil {
 		return
 	}
 	var resMenuTryMatch resMenuTryMatch
-	err = json.Unmarshal(response, &resMenuTryMatch)
-	if err != nil {
-		return
-	}
-	if resMenuTryMatch.ErrCode != 0 {
-		err = fmt.Errorf("MenuTryMatch Error , errcode=%d , errmsg=%s", resMenuTryMatch.ErrCode, resMenuTryMatch.ErrMsg)
+	if err = util.DecodeWithError(response, &resMenuTryMatch, "MenuTryMatch"); err != nil {
 		return
 	}
 	buttons = resMenuTryMatch.Button
@@ -287,7 +321,7 @@ func (menu *Menu) GetCurrentSelfMenuInfo() (resSelfMenuInfo ResSelfMenuInfo, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", menuSelfMenuInfoURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "menu.GetCurrentSelfMenuInfo"), uri)
 	if err != nil {
 		return
 	}
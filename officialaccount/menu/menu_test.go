@@ -0,0 +1,133 @@
+package menu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestMenu() *Menu {
+	return NewMenu(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestGetMenu(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/menu/get").
+		Reply(200).BodyString(`{
+	"menu": {
+		"button": [
+			{"type": "click", "name": "今日歌曲", "key": "V1001_TODAY_MUSIC", "sub_button": []},
+			{"name": "菜单", "sub_button": [{"type": "view", "name": "搜索", "url": "http://www.soso.com/", "sub_button": []}]}
+		],
+		"menuid": 208396938
+	},
+	"conditionalmenu": [
+		{
+			"button": [{"type": "click", "name": "今日歌曲", "key": "V1001_TODAY_MUSIC", "sub_button": []}],
+			"matchrule": {"group_id": 2, "sex": 1, "country": "中国", "province": "广东", "city": "广州", "client_platform_type": 2},
+			"menuid": 208396993
+		},
+		{
+			"button": [{"type": "view", "name": "官网", "url": "https://example.com", "sub_button": []}],
+			"matchrule": {"tag_id": "2", "language": "zh_CN"},
+			"menuid": 208397001
+		}
+	]
+}`)
+
+	res, err := newTestMenu().GetMenu()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(208396938), res.Menu.MenuID)
+	if assert.Len(t, res.Menu.Button, 2) {
+		assert.Equal(t, "V1001_TODAY_MUSIC", res.Menu.Button[0].Key)
+		assert.Equal(t, "http://www.soso.com/", res.Menu.Button[1].SubButtons[0].URL)
+	}
+
+	if assert.Len(t, res.Conditionalmenu, 2) {
+		assert.Equal(t, ConditionalMenu{
+			Button: res.Conditionalmenu[0].Button,
+			MatchRule: MatchRule{
+				GroupID:            "2",
+				Sex:                "1",
+				Country:            "中国",
+				Province:           "广东",
+				City:               "广州",
+				ClientPlatformType: "2",
+			},
+			MenuID: 208396993,
+		}, res.Conditionalmenu[0])
+		assert.Equal(t, MatchRule{TagID: "2", Language: "zh_CN"}, res.Conditionalmenu[1].MatchRule)
+		assert.Equal(t, int64(208397001), res.Conditionalmenu[1].MenuID)
+	}
+}
+
+func TestEffectiveMenu(t *testing.T) {
+	defer gock.Off()
+	menuJSON := `{
+	"menu": {"button": [{"type": "click", "name": "默认", "key": "DEFAULT"}], "menuid": 100},
+	"conditionalmenu": [
+		{"button": [{"type": "click", "name": "男性", "key": "MALE"}], "matchrule": {"sex": 1}, "menuid": 101},
+		{"button": [{"type": "click", "name": "女性", "key": "FEMALE"}], "matchrule": {"sex": 2}, "menuid": 102}
+	]
+}`
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/menu/get").Times(2).
+		Reply(200).BodyString(menuJSON)
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/menu/trymatch").
+		BodyString(`"user_id":"female-user"`).
+		Reply(200).JSON(map[string]interface{}{
+		"button": []map[string]string{{"type": "click", "name": "女性", "key": "FEMALE"}},
+	})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/menu/trymatch").
+		BodyString(`"user_id":"unknown-user"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 46002, "errmsg": "menu not match"})
+
+	effective, err := newTestMenu().EffectiveMenu("female-user")
+	assert.NoError(t, err)
+	assert.True(t, effective.Conditional)
+	assert.Equal(t, int64(102), effective.MenuID)
+	assert.Equal(t, "2", effective.MatchRule.Sex)
+	assert.Equal(t, "FEMALE", effective.Button[0].Key)
+
+	// 未命中个性化菜单时回退到默认菜单
+	effective, err = newTestMenu().EffectiveMenu("unknown-user")
+	assert.NoError(t, err)
+	assert.False(t, effective.Conditional)
+	assert.Nil(t, effective.MatchRule)
+	assert.Equal(t, int64(100), effective.MenuID)
+	assert.Equal(t, "DEFAULT", effective.Button[0].Key)
+	assert.True(t, gock.IsDone())
+}
+
+// TestEffectiveMenuTryMatchError MenuTryMatch 返回未匹配以外的错误时不回退到默认菜单
+func TestEffectiveMenuTryMatchError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/menu/get").Times(2).
+		Reply(200).BodyString(`{"menu": {"button": [{"type": "click", "name": "默认", "key": "DEFAULT"}], "menuid": 100},
+	"conditionalmenu": [{"button": [{"type": "click", "name": "男性", "key": "MALE"}], "matchrule": {"sex": 1}, "menuid": 101}]}`)
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/menu/trymatch").
+		Reply(200).JSON(map[string]interface{}{"errcode": 40001, "errmsg": "invalid credential"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/menu/trymatch").
+		Reply(200).JSON(map[string]interface{}{"errcode": 45009, "errmsg": "reach max api daily quota limit"})
+
+	for _, errCode := range []string{"40001", "45009"} {
+		effective, err := newTestMenu().EffectiveMenu("mock-openid")
+		assert.Nil(t, effective)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "errcode="+errCode)
+		}
+	}
+	assert.True(t, gock.IsDone())
+}
@@ -1,6 +1,7 @@
 package message
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
@@ -166,7 +167,7 @@ func (manager *Manager) Send(msg *CustomerMessage) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerSendMessage, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "message.Send"), uri, msg)
 	if err != nil {
 		return err
 	}
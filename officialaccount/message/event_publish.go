@@ -0,0 +1,39 @@
+package message
+
+import "github.com/silenceper/wechat/v2/officialaccount/freepublish"
+
+// PublishEvent 发布任务完成事件（PUBLISHJOBFINISH）
+type PublishEvent struct {
+	OpenID        string                           // 公众号的 openid
+	CreateTime    int64                            // 消息创建时间
+	PublishID     int64                            // 发布任务 id
+	PublishStatus freepublish.PublishStatus        // 发布状态
+	ArticleID     string                           // 发布成功时返回图文的 article_id
+	Articles      []freepublish.PublishArticleItem // 发布成功时返回的文章编号及永久链接
+	FailIndex     []uint                           // 发布状态为 2 或 4 时，返回不通过的文章编号，第一篇为 1
+}
+
+// IsSuccess 是否发布成功
+func (e *PublishEvent) IsSuccess() bool {
+	return e.PublishStatus == freepublish.PublishStatusSuccess
+}
+
+// GetPublishEvent 解析发布任务完成事件，非此类事件时返回 nil
+func (s *MixMessage) GetPublishEvent() *PublishEvent {
+	if s.MsgType != MsgTypeEvent || s.Event != EventPublishJobFinish {
+		return nil
+	}
+	info := s.PublishEventInfo
+	event := &PublishEvent{
+		OpenID:        s.GetOpenID(),
+		CreateTime:    s.CreateTime,
+		PublishID:     info.PublishID,
+		PublishStatus: info.PublishStatus,
+		ArticleID:     info.ArticleID,
+		FailIndex:     info.FailIndex,
+	}
+	for _, item := range info.ArticleDetail.Item {
+		event.Articles = append(event.Articles, freepublish.PublishArticleItem{Index: item.Index, ArticleURL: item.ArticleURL})
+	}
+	return event
+}
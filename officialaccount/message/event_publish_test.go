@@ -0,0 +1,40 @@
+package message
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/officialaccount/freepublish"
+)
+
+func TestGetPublishEvent(t *testing.T) {
+	rawXML := `<xml><ToUserName><![CDATA[gh_4d00ed8d6399]]></ToUserName><FromUserName><![CDATA[oV5CrjpxgaGXNHIQigzNlgLTnwic]]></FromUserName><CreateTime>1481013459</CreateTime><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[PUBLISHJOBFINISH]]></Event>` +
+		`<PublishEventInfo><publish_id>2247503051</publish_id><publish_status>0</publish_status><article_id><![CDATA[b5O2OUs25HBxRceL7hfReg-U9QGeq9zQjiDvy]]></article_id>` +
+		`<article_detail><count>1</count><item><idx>1</idx><article_url><![CDATA[ARTICLE_URL]]></article_url></item></article_detail></PublishEventInfo></xml>`
+	var msg MixMessage
+	assert.Nil(t, xml.Unmarshal([]byte(rawXML), &msg))
+
+	event := msg.GetPublishEvent()
+	if assert.NotNil(t, event) {
+		assert.True(t, event.IsSuccess())
+		assert.Equal(t, int64(2247503051), event.PublishID)
+		assert.Equal(t, "b5O2OUs25HBxRceL7hfReg-U9QGeq9zQjiDvy", event.ArticleID)
+		assert.Equal(t, []freepublish.PublishArticleItem{{Index: 1, ArticleURL: "ARTICLE_URL"}}, event.Articles)
+	}
+
+	msg = MixMessage{}
+	rawXML = `<xml><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[PUBLISHJOBFINISH]]></Event>` +
+		`<PublishEventInfo><publish_id>2247503051</publish_id><publish_status>2</publish_status><fail_idx>1</fail_idx><fail_idx>2</fail_idx></PublishEventInfo></xml>`
+	assert.Nil(t, xml.Unmarshal([]byte(rawXML), &msg))
+	event = msg.GetPublishEvent()
+	if assert.NotNil(t, event) {
+		assert.False(t, event.IsSuccess())
+		assert.Equal(t, freepublish.PublishStatusOriginalFail, event.PublishStatus)
+		assert.Equal(t, []uint{1, 2}, event.FailIndex)
+		assert.Empty(t, event.Articles)
+	}
+
+	assert.Nil(t, (&MixMessage{CommonToken: CommonToken{MsgType: MsgTypeEvent}, Event: EventClick}).GetPublishEvent())
+}
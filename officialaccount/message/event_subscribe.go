@@ -0,0 +1,37 @@
+package message
+
+import "strings"
+
+// qrScenePrefix 未关注用户扫描带参数二维码关注时，EventKey 的前缀
+const qrScenePrefix = "qrscene_"
+
+// SubscribeEvent 关注/取消关注事件
+type SubscribeEvent struct {
+	OpenID     string    // 用户 openid
+	Event      EventType // subscribe 或 unsubscribe
+	CreateTime int64     // 消息创建时间
+	Scene      string    // 二维码场景值，已去除 qrscene_ 前缀，非扫码关注时为空
+	Ticket     string    // 二维码的 ticket，可用来换取二维码图片，非扫码关注时为空
+}
+
+// IsScanSubscribe 是否为扫描带参数二维码关注
+func (e *SubscribeEvent) IsScanSubscribe() bool {
+	return e.Event == EventSubscribe && (e.Scene != "" || e.Ticket != "")
+}
+
+// GetSubscribeEvent 解析关注/取消关注事件，非此类事件时返回 nil
+func (s *MixMessage) GetSubscribeEvent() *SubscribeEvent {
+	if s.MsgType != MsgTypeEvent || (s.Event != EventSubscribe && s.Event != EventUnsubscribe) {
+		return nil
+	}
+	event := &SubscribeEvent{
+		OpenID:     s.GetOpenID(),
+		Event:      s.Event,
+		CreateTime: s.CreateTime,
+	}
+	if s.Event == EventSubscribe {
+		event.Scene = strings.TrimPrefix(s.EventKey, qrScenePrefix)
+		event.Ticket = s.Ticket
+	}
+	return event
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSubscribeEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawXML   string
+		expected *SubscribeEvent
+		isScan   bool
+	}{
+		{
+			name:     "subscribe",
+			rawXML:   `<xml><FromUserName><![CDATA[oUser]]></FromUserName><CreateTime>123456789</CreateTime><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[subscribe]]></Event></xml>`,
+			expected: &SubscribeEvent{OpenID: "oUser", Event: EventSubscribe, CreateTime: 123456789},
+		},
+		{
+			name:     "scan subscribe",
+			rawXML:   `<xml><FromUserName><![CDATA[oUser]]></FromUserName><CreateTime>123456789</CreateTime><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[subscribe]]></Event><EventKey><![CDATA[qrscene_promo_2024]]></EventKey><Ticket><![CDATA[TICKET]]></Ticket></xml>`,
+			expected: &SubscribeEvent{OpenID: "oUser", Event: EventSubscribe, CreateTime: 123456789, Scene: "promo_2024", Ticket: "TICKET"},
+			isScan:   true,
+		},
+		{
+			name:     "unsubscribe",
+			rawXML:   `<xml><FromUserName><![CDATA[oUser]]></FromUserName><CreateTime>123456789</CreateTime><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[unsubscribe]]></Event><EventKey><![CDATA[]]></EventKey></xml>`,
+			expected: &SubscribeEvent{OpenID: "oUser", Event: EventUnsubscribe, CreateTime: 123456789},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &MixMessage{}
+			assert.Nil(t, xml.Unmarshal([]byte(tt.rawXML), msg))
+			event := msg.GetSubscribeEvent()
+			assert.Equal(t, tt.expected, event)
+			assert.Equal(t, tt.isScan, event.IsScanSubscribe())
+		})
+	}
+}
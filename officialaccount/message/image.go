@@ -15,3 +15,17 @@ func NewImage(mediaID string) *Image {
 	image.Image.MediaID = mediaID
 	return image
 }
+
+// Validate 校验图片消息，媒体 id 必填，且只能由可见的 ASCII 字符组成（不含空白及 XML 特殊字符）
+func (image *Image) Validate() error {
+	return validateReplyMediaID(MsgTypeImage, image.Image.MediaID)
+}
+
+// NewImageReply 构造被动回复的图片消息，媒体 id 为空或格式不正确时返回错误
+func NewImageReply(mediaID string) (*Reply, error) {
+	image := NewImage(mediaID)
+	if err := image.Validate(); err != nil {
+		return nil, err
+	}
+	return &Reply{MsgType: MsgTypeImage, MsgData: image}, nil
+}
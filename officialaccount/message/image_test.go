@@ -0,0 +1,33 @@
+package message
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImageReply(t *testing.T) {
+	reply, err := NewImageReply("mock-Media_id1")
+	assert.NoError(t, err)
+	assert.Equal(t, MsgTypeImage, reply.MsgType)
+
+	image := reply.MsgData.(*Image)
+	image.SetToUserName("to-user")
+	image.SetFromUserName("from-user")
+	image.SetCreateTime(12345678)
+	image.SetMsgType(MsgTypeImage)
+	data, err := xml.Marshal(image)
+	assert.NoError(t, err)
+	assert.Equal(t, "<xml><ToUserName><![CDATA[to-user]]></ToUserName><FromUserName><![CDATA[from-user]]></FromUserName>"+
+		"<CreateTime>12345678</CreateTime><MsgType>image</MsgType>"+
+		"<Image><MediaId>mock-Media_id1</MediaId></Image></xml>", string(data))
+}
+
+func TestNewImageReplyInvalidMediaID(t *testing.T) {
+	_, err := NewImageReply("")
+	assert.EqualError(t, err, "image reply requires media_id")
+
+	_, err = NewImageReply("mock media id")
+	assert.EqualError(t, err, `image reply has invalid media_id "mock media id"`)
+}
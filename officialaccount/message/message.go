@@ -2,9 +2,11 @@ package message
 
 import (
 	"encoding/xml"
+	"time"
 
 	"github.com/silenceper/wechat/v2/officialaccount/device"
 	"github.com/silenceper/wechat/v2/officialaccount/freepublish"
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // MsgType 基本消息类型
@@ -316,6 +318,12 @@ func (msg *CommonToken) SetCreateTime(createTime int64) {
 	msg.CreateTime = createTime
 }
 
+// GetCreateTime 返回消息创建时间
+func (msg *CommonToken) GetCreateTime() time.Time {
+	t, _ := util.ParseWeChatTime(msg.CreateTime)
+	return t
+}
+
 // SetMsgType set MsgType
 func (msg *CommonToken) SetMsgType(msgType MsgType) {
 	msg.MsgType = msgType
@@ -1,14 +1,16 @@
 package message
 
+import "errors"
+
 // Music 音乐消息
 type Music struct {
 	CommonToken
 
 	Music struct {
-		Title        string `xml:"Title"        `
-		Description  string `xml:"Description"  `
-		MusicURL     string `xml:"MusicUrl"     `
-		HQMusicURL   string `xml:"HQMusicUrl"   `
+		Title        string `xml:"Title,omitempty"`
+		Description  string `xml:"Description,omitempty"`
+		MusicURL     string `xml:"MusicUrl,omitempty"`
+		HQMusicURL   string `xml:"HQMusicUrl,omitempty"`
 		ThumbMediaID string `xml:"ThumbMediaId"`
 	} `xml:"Music"`
 }
@@ -19,6 +21,24 @@ func NewMusic(title, description, musicURL, hQMusicURL, thumbMediaID string) *Mu
 	music.Music.Title = title
 	music.Music.Description = description
 	music.Music.MusicURL = musicURL
+	music.Music.HQMusicURL = hQMusicURL
 	music.Music.ThumbMediaID = thumbMediaID
 	return music
 }
+
+// Validate 校验音乐消息，缩略图的媒体 id 必填
+func (music *Music) Validate() error {
+	if music.Music.ThumbMediaID == "" {
+		return errors.New("music reply requires thumb_media_id")
+	}
+	return nil
+}
+
+// NewMusicReply 构造被动回复的音乐消息，缺少缩略图的媒体 id 时返回错误
+func NewMusicReply(title, description, musicURL, hQMusicURL, thumbMediaID string) (*Reply, error) {
+	music := NewMusic(title, description, musicURL, hQMusicURL, thumbMediaID)
+	if err := music.Validate(); err != nil {
+		return nil, err
+	}
+	return &Reply{MsgType: MsgTypeMusic, MsgData: music}, nil
+}
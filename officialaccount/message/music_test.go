@@ -0,0 +1,32 @@
+package message
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMusicReply(t *testing.T) {
+	reply, err := NewMusicReply("title", "description", "https://example.com/a.mp3", "https://example.com/a_hq.mp3", "mock-thumb-media-id")
+	assert.NoError(t, err)
+	assert.Equal(t, MsgTypeMusic, reply.MsgType)
+
+	music := reply.MsgData.(*Music)
+	music.SetToUserName("to-user")
+	music.SetFromUserName("from-user")
+	music.SetCreateTime(12345678)
+	music.SetMsgType(MsgTypeMusic)
+	data, err := xml.Marshal(music)
+	assert.NoError(t, err)
+	assert.Equal(t, "<xml><ToUserName><![CDATA[to-user]]></ToUserName><FromUserName><![CDATA[from-user]]></FromUserName>"+
+		"<CreateTime>12345678</CreateTime><MsgType>music</MsgType>"+
+		"<Music><Title>title</Title><Description>description</Description>"+
+		"<MusicUrl>https://example.com/a.mp3</MusicUrl><HQMusicUrl>https://example.com/a_hq.mp3</HQMusicUrl>"+
+		"<ThumbMediaId>mock-thumb-media-id</ThumbMediaId></Music></xml>", string(data))
+}
+
+func TestNewMusicReplyMissingThumb(t *testing.T) {
+	_, err := NewMusicReply("title", "", "https://example.com/a.mp3", "", "")
+	assert.EqualError(t, err, "music reply requires thumb_media_id")
+}
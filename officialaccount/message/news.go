@@ -1,5 +1,14 @@
 package message
 
+import "fmt"
+
+const (
+	// ReplyNewsMaxArticles 被动回复图文消息的最大图文数
+	ReplyNewsMaxArticles = 1
+	// NewsMaxArticles 图文消息最多支持的图文数
+	NewsMaxArticles = 8
+)
+
 // News 图文消息
 type News struct {
 	CommonToken
@@ -16,6 +25,26 @@ func NewNews(articles []*Article) *News {
 	return news
 }
 
+// Validate 校验图文数量，不能为空且不能超过 maxArticles
+func (news *News) Validate(maxArticles int) error {
+	if len(news.Articles) == 0 {
+		return fmt.Errorf("news has no articles")
+	}
+	if len(news.Articles) > maxArticles {
+		return fmt.Errorf("news has %d articles, exceeds the limit of %d", len(news.Articles), maxArticles)
+	}
+	return nil
+}
+
+// NewNewsReply 构造被动回复的图文消息，图文数量超过 ReplyNewsMaxArticles 时返回错误
+func NewNewsReply(articles ...*Article) (*Reply, error) {
+	news := NewNews(articles)
+	if err := news.Validate(ReplyNewsMaxArticles); err != nil {
+		return nil, err
+	}
+	return &Reply{MsgType: MsgTypeNews, MsgData: news}, nil
+}
+
 // Article 单篇文章
 type Article struct {
 	Title       string `xml:"Title,omitempty"`
@@ -0,0 +1,35 @@
+package message
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNewsReply(t *testing.T) {
+	reply, err := NewNewsReply(NewArticle("title", "description", "https://example.com/a.png", "https://example.com"))
+	assert.NoError(t, err)
+	assert.Equal(t, MsgTypeNews, reply.MsgType)
+
+	news := reply.MsgData.(*News)
+	assert.Equal(t, 1, news.ArticleCount)
+	data, err := xml.Marshal(news)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "<ArticleCount>1</ArticleCount><Articles><item><Title>title</Title>")
+}
+
+func TestNewNewsReplyOverLimit(t *testing.T) {
+	_, err := NewNewsReply(NewArticle("a", "", "", ""), NewArticle("b", "", "", ""))
+	assert.EqualError(t, err, "news has 2 articles, exceeds the limit of 1")
+
+	_, err = NewNewsReply()
+	assert.EqualError(t, err, "news has no articles")
+
+	articles := make([]*Article, NewsMaxArticles+1)
+	for i := range articles {
+		articles[i] = NewArticle("title", "", "", "")
+	}
+	assert.NoError(t, NewNews(articles[:NewsMaxArticles]).Validate(NewsMaxArticles))
+	assert.Error(t, NewNews(articles).Validate(NewsMaxArticles))
+}
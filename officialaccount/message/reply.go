@@ -1,6 +1,10 @@
 package message
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // ErrInvalidReply 无效的回复
 var ErrInvalidReply = errors.New("无效的回复消息")
@@ -13,3 +17,16 @@ type Reply struct {
 	MsgType MsgType
 	MsgData interface{}
 }
+
+// validateReplyMediaID 校验被动回复的媒体 id，必填，且只能由可见的 ASCII 字符组成（不含空白及 XML 特殊字符）
+func validateReplyMediaID(msgType MsgType, mediaID string) error {
+	if mediaID == "" {
+		return fmt.Errorf("%s reply requires media_id", msgType)
+	}
+	for _, r := range mediaID {
+		if r <= ' ' || r > '~' || strings.ContainsRune(`<>&"'`, r) {
+			return fmt.Errorf("%s reply has invalid media_id %q", msgType, mediaID)
+		}
+	}
+	return nil
+}
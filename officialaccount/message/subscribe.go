@@ -1,7 +1,9 @@
 package message
 
 import (
+	context2 "context"
 	"fmt"
+	"strings"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
 	"github.com/silenceper/wechat/v2/util"
@@ -54,7 +56,7 @@ func (tpl *Subscribe) Send(msg *SubscribeMessage) (err error) {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeSendURL, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := util.PostJSONContext(util.WithOperation(context2.Background(), "message.SendSubscribeMessage"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -84,7 +86,7 @@ func (tpl *Subscribe) List() (templateList []*PrivateSubscribeItem, err error) {
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateListURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "message.ListSubscribe"), uri)
 	if err != nil {
 		return
 	}
@@ -113,7 +115,7 @@ func (tpl *Subscribe) Add(ShortID string, kidList []int, sceneDesc string) (temp
 	}{TemplateIDShort: ShortID, SceneDesc: sceneDesc, KidList: kidList}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateAddURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "message.AddSubscribe"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -134,7 +136,7 @@ func (tpl *Subscribe) Delete(templateID string) (err error) {
 	}{TemplateID: templateID}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateDelURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "message.DeleteSubscribe"), uri, msg)
 	if err != nil {
 		return
 	}
@@ -161,7 +163,7 @@ func (tpl *Subscribe) GetCategory() (categoryList []*PublicTemplateCategory, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateGetCategoryURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "message.GetCategory"), uri)
 	if err != nil {
 		return
 	}
@@ -185,14 +187,24 @@ type resPublicTemplateKeyWordsList struct {
 
 // GetPubTplKeyWordsByID 获取模板中的关键词
 func (tpl *Subscribe) GetPubTplKeyWordsByID(titleID string) (keyWordsList []*PublicTemplateKeyWords, err error) {
+	return tpl.GetPubTemplateKeyWordsByIDContext(context2.Background(), titleID)
+}
+
+// GetPubTemplateKeyWordsByID 获取模板标题下的关键词，返回的关键词 id 用于 Add 时组合模板
+func (tpl *Subscribe) GetPubTemplateKeyWordsByID(tid string) ([]*PublicTemplateKeyWords, error) {
+	return tpl.GetPubTemplateKeyWordsByIDContext(context2.Background(), tid)
+}
+
+// GetPubTemplateKeyWordsByIDContext 获取模板标题下的关键词
+func (tpl *Subscribe) GetPubTemplateKeyWordsByIDContext(ctx context2.Context, tid string) (keyWordsList []*PublicTemplateKeyWords, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
-	uri := fmt.Sprintf("%s?access_token=%s&tid=%s", subscribeTemplateGetPubTplKeyWorksURL, accessToken, titleID)
+	uri := fmt.Sprintf("%s?access_token=%s&tid=%s", subscribeTemplateGetPubTplKeyWorksURL, accessToken, tid)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "message.GetPublicTemplateKeyWords"), uri)
 	if err != nil {
 		return
 	}
@@ -215,16 +227,26 @@ type resPublicTemplateTitleList struct {
 	TemplateTitleList []*PublicTemplateTitle `json:"data"`  // 模板标题列表
 }
 
-// GetPublicTemplateTitleList 获取类目下的公共模板
+// GetPublicTemplateTitleList 获取类目下的公共模板，ids 为以逗号分隔的类目 id
 func (tpl *Subscribe) GetPublicTemplateTitleList(ids string, start int, limit int) (count int, templateTitleList []*PublicTemplateTitle, err error) {
+	return tpl.GetPubTemplateTitleListContext(context2.Background(), strings.Split(ids, ","), start, limit)
+}
+
+// GetPubTemplateTitleList 获取类目下的公共模板标题，ids 为 GetCategory 返回的类目 id，limit 最大为 30
+func (tpl *Subscribe) GetPubTemplateTitleList(ids []string, start, limit int) (count int, templateTitleList []*PublicTemplateTitle, err error) {
+	return tpl.GetPubTemplateTitleListContext(context2.Background(), ids, start, limit)
+}
+
+// GetPubTemplateTitleListContext 获取类目下的公共模板标题
+func (tpl *Subscribe) GetPubTemplateTitleListContext(ctx context2.Context, ids []string, start, limit int) (count int, templateTitleList []*PublicTemplateTitle, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
-	uri := fmt.Sprintf("%s?access_token=%s&ids=%s&start=%d&limit=%d", subscribeTemplateGetPubTplTitles, accessToken, ids, start, limit)
+	uri := fmt.Sprintf("%s?access_token=%s&ids=%s&start=%d&limit=%d", subscribeTemplateGetPubTplTitles, accessToken, strings.Join(ids, ","), start, limit)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "message.GetPublicTemplateTitle"), uri)
 	if err != nil {
 		return
 	}
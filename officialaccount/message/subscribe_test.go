@@ -0,0 +1,90 @@
+package message
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/credential"
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestSubscribe() *Subscribe {
+	return NewSubscribe(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestGetPubTemplateTitleList(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxaapi/newtmpl/getpubtemplatetitles").
+		MatchParam("ids", "2,616").
+		MatchParam("start", "0").
+		MatchParam("limit", "1").
+		Reply(200).JSON(map[string]interface{}{
+		"errcode": 0,
+		"count":   55,
+		"data":    []map[string]interface{}{{"tid": 99, "title": "付款成功通知", "type": 2, "categoryId": "616"}},
+	})
+
+	count, titles, err := newTestSubscribe().GetPubTemplateTitleList([]string{"2", "616"}, 0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 55, count)
+	if assert.Len(t, titles, 1) {
+		assert.Equal(t, 99, titles[0].TitleID)
+		assert.Equal(t, "付款成功通知", titles[0].Title)
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetPubTemplateKeyWordsByID(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxaapi/newtmpl/getpubtemplatekeywords").
+		MatchParam("tid", "99").
+		Reply(200).JSON(map[string]interface{}{
+		"errcode": 0,
+		"count":   2,
+		"data": []map[string]interface{}{
+			{"kid": 1, "name": "物品名称", "example": "名称", "rule": "thing"},
+			{"kid": 2, "name": "支付时间", "example": "2019-10-18 18:00:00", "rule": "time"},
+		},
+	})
+
+	keyWords, err := newTestSubscribe().GetPubTemplateKeyWordsByID("99")
+	assert.Nil(t, err)
+	if assert.Len(t, keyWords, 2) {
+		assert.Equal(t, 2, keyWords[1].KeyWordsID)
+		assert.Equal(t, "time", keyWords[1].Rule)
+	}
+	assert.True(t, gock.IsDone())
+}
+
+// TestGetPubTemplateContextAccessToken Context 版本使用 ctx 中的 access_token
+func TestGetPubTemplateContextAccessToken(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxaapi/newtmpl/getpubtemplatekeywords").
+		MatchParam("access_token", "override-token").
+		MatchParam("tid", "99").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "data": []map[string]interface{}{{"kid": 1, "name": "物品名称"}}})
+	gock.New("https://api.weixin.qq.com").Get("/wxaapi/newtmpl/getpubtemplatetitles").
+		MatchParam("access_token", "override-token").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "count": 0, "data": []interface{}{}})
+
+	ctx := credential.WithAccessToken(context2.Background(), "override-token")
+	keywords, err := newTestSubscribe().GetPubTemplateKeyWordsByIDContext(ctx, "99")
+	assert.Nil(t, err)
+	assert.Len(t, keywords, 1)
+	_, _, err = newTestSubscribe().GetPubTemplateTitleListContext(ctx, []string{"616"}, 0, 1)
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
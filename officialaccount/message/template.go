@@ -1,9 +1,10 @@
 package message
 
 import (
-	"encoding/json"
+	context2 "context"
 	"fmt"
 
+	"github.com/silenceper/wechat/v2/internal/openapi"
 	"github.com/silenceper/wechat/v2/officialaccount/context"
 	"github.com/silenceper/wechat/v2/util"
 )
@@ -36,10 +37,13 @@ type TemplateMessage struct {
 	Data        map[string]*TemplateDataItem `json:"data"`                    // 必须, 模板数据
 	ClientMsgID string                       `json:"client_msg_id,omitempty"` // 可选, 防重入ID
 
-	MiniProgram struct {
-		AppID    string `json:"appid"`    // 所需跳转到的小程序appid（该小程序appid必须与发模板消息的公众号是绑定关联关系）
-		PagePath string `json:"pagepath"` // 所需跳转到小程序的具体页面路径，支持带参数,（示例index?foo=bar）
-	} `json:"miniprogram"` // 可选,跳转至小程序地址
+	MiniProgram TemplateMiniProgram `json:"miniprogram"` // 可选,跳转至小程序地址
+}
+
+// TemplateMiniProgram 模板消息跳转的小程序
+type TemplateMiniProgram struct {
+	AppID    string `json:"appid"`    // 所需跳转到的小程序appid（该小程序appid必须与发模板消息的公众号是绑定关联关系）
+	PagePath string `json:"pagepath"` // 所需跳转到小程序的具体页面路径，支持带参数,（示例index?foo=bar）
 }
 
 // TemplateDataItem 模版内某个 .DATA 的值
@@ -55,9 +59,25 @@ type resTemplateSend struct {
 }
 
 // Send 发送模板消息
+// 接口调用超过限制时按照配置的 QuotaExceededPolicy 进行处理
 func (tpl *Template) Send(msg *TemplateMessage) (msgID int64, err error) {
+	return tpl.SendContext(context2.Background(), msg)
+}
+
+// SendContext 发送模板消息
+// 接口调用超过限制时按照配置的 QuotaExceededPolicy 进行处理
+func (tpl *Template) SendContext(ctx context2.Context, msg *TemplateMessage) (msgID int64, err error) {
+	err = util.DoWithQuotaPolicy(ctx, tpl.QuotaExceededPolicy, openapi.NewOpenAPI(tpl.Context).ClearQuota, func() (sendErr error) {
+		msgID, sendErr = tpl.send(ctx, msg)
+		return
+	})
+	return
+}
+
+// send 发送模板消息
+func (tpl *Template) send(ctx context2.Context, msg *TemplateMessage) (msgID int64, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -65,15 +85,11 @@ func (tpl *Template) Send(msg *TemplateMessage) (msgID int64, err error) {
 		uri      = fmt.Sprintf("%s?access_token=%s", templateSendURL, accessToken)
 		response []byte
 	)
-	if response, err = util.PostJSON(uri, msg); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "message.SendTemplate"), uri, msg); err != nil {
 		return
 	}
 	var result resTemplateSend
-	if err = json.Unmarshal(response, &result); err != nil {
-		return
-	}
-	if result.ErrCode != 0 {
-		err = fmt.Errorf("template msg send error : errcode=%v , errmsg=%v", result.ErrCode, result.ErrMsg)
+	if err = util.DecodeWithError(response, &result, "SendTemplate"); err != nil {
 		return
 	}
 	msgID = result.MsgID
@@ -98,8 +114,13 @@ type resTemplateList struct {
 
 // List 获取模板列表
 func (tpl *Template) List() (templateList []*TemplateItem, err error) {
+	return tpl.ListContext(context2.Background())
+}
+
+// ListContext 获取模板列表
+func (tpl *Template) ListContext(ctx context2.Context) (templateList []*TemplateItem, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -107,7 +128,7 @@ func (tpl *Template) List() (templateList []*TemplateItem, err error) {
 		uri      = fmt.Sprintf("%s?access_token=%s", templateListURL, accessToken)
 		response []byte
 	)
-	if response, err = util.HTTPGet(uri); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(ctx, "message.ListTemplate"), uri); err != nil {
 		return
 	}
 	var res resTemplateList
@@ -123,8 +144,13 @@ type resTemplateAdd struct {
 
 // Add 添加模板.
 func (tpl *Template) Add(shortID string, keyNameList []string) (templateID string, err error) {
+	return tpl.AddContext(context2.Background(), shortID, keyNameList)
+}
+
+// AddContext 添加模板.
+func (tpl *Template) AddContext(ctx context2.Context, shortID string, keyNameList []string) (templateID string, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -136,18 +162,26 @@ func (tpl *Template) Add(shortID string, keyNameList []string) (templateID strin
 		uri      = fmt.Sprintf("%s?access_token=%s", templateAddURL, accessToken)
 		response []byte
 	)
-	if response, err = util.PostJSON(uri, msg); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "message.AddTemplate"), uri, msg); err != nil {
 		return
 	}
 	var result resTemplateAdd
-	err = util.DecodeWithError(response, &result, "AddTemplate")
-	return result.TemplateID, err
+	if err = util.DecodeWithError(response, &result, "AddTemplate"); err != nil {
+		return
+	}
+	tpl.invalidateTemplateList(ctx)
+	return result.TemplateID, nil
 }
 
 // Delete 删除私有模板.
 func (tpl *Template) Delete(templateID string) (err error) {
+	return tpl.DeleteContext(context2.Background(), templateID)
+}
+
+// DeleteContext 删除私有模板.
+func (tpl *Template) DeleteContext(ctx context2.Context, templateID string) (err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -158,8 +192,12 @@ func (tpl *Template) Delete(templateID string) (err error) {
 		uri      = fmt.Sprintf("%s?access_token=%s", templateDelURL, accessToken)
 		response []byte
 	)
-	if response, err = util.PostJSON(uri, msg); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(ctx, "message.DeleteTemplate"), uri, msg); err != nil {
+		return
+	}
+	if err = util.DecodeWithCommonError(response, "DeleteTemplate"); err != nil {
 		return
 	}
-	return util.DecodeWithCommonError(response, "DeleteTemplate")
+	tpl.invalidateTemplateList(ctx)
+	return nil
 }
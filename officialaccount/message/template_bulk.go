@@ -0,0 +1,127 @@
+package message
+
+import (
+	context2 "context"
+	"errors"
+	"time"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// errCodeSystemBusy 系统繁忙，此时请开发者稍候再试
+const errCodeSystemBusy = -1
+
+// Recipient 批量发送模板消息的接收者，每个接收者可以有不同的跳转链接及模板数据
+type Recipient struct {
+	OpenID      string
+	URL         string
+	MiniProgram TemplateMiniProgram
+	Data        map[string]*TemplateDataItem
+}
+
+// RateLimitOpts 批量发送的限速及重试配置
+type RateLimitOpts struct {
+	Interval   time.Duration // 两次发送之间的最小间隔，为 0 时不限速
+	MaxRetries int           // 单个接收者发送失败（网络错误或系统繁忙）后的最大重试次数
+	Backoff    time.Duration // 首次重试前的等待时长，之后每次翻倍
+	// Throttle 额度自适应限速器，每次发送（含重试）前等待，可通过 OpenAPI.NewQuotaThrottle 创建
+	Throttle *util.QuotaThrottle
+}
+
+// BulkSendResult 单个接收者的发送结果
+type BulkSendResult struct {
+	OpenID string
+	MsgID  int64
+	Err    error
+}
+
+// SendBulk 按顺序向多个接收者发送同一模板的消息，返回与 recipients 一一对应的发送结果
+// 单个接收者发送失败不会中断发送，ctx 取消时未发送的接收者结果为 ctx.Err()，并返回该错误
+func (tpl *Template) SendBulk(ctx context2.Context, templateID string, recipients []Recipient, opts RateLimitOpts) ([]BulkSendResult, error) {
+	results := make([]BulkSendResult, len(recipients))
+	for i, recipient := range recipients {
+		results[i].OpenID = recipient.OpenID
+	}
+
+	var last time.Time
+	for i, recipient := range recipients {
+		if opts.Interval > 0 && !last.IsZero() {
+			if err := sleepContext(ctx, opts.Interval-time.Since(last)); err != nil {
+				return results, cancelBulkResults(results[i:], err)
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return results, cancelBulkResults(results[i:], err)
+		}
+
+		msg := &TemplateMessage{
+			ToUser:      recipient.OpenID,
+			TemplateID:  templateID,
+			URL:         recipient.URL,
+			Data:        recipient.Data,
+			MiniProgram: recipient.MiniProgram,
+		}
+		backoff := opts.Backoff
+		for attempt := 0; ; attempt++ {
+			if opts.Throttle != nil {
+				if err := opts.Throttle.Wait(ctx); err != nil {
+					return results, cancelBulkResults(results[i:], err)
+				}
+			}
+			last = time.Now()
+			results[i].MsgID, results[i].Err = tpl.SendContext(ctx, msg)
+			if results[i].Err == nil || attempt >= opts.MaxRetries || !isRetryableSendErr(results[i].Err) {
+				break
+			}
+			if err := sleepContext(ctx, backoff); err != nil {
+				return results, cancelBulkResults(results[i:], err)
+			}
+			backoff *= 2
+		}
+	}
+	return results, nil
+}
+
+// BulkSendReport 将 SendBulk 的发送结果汇总为 util.BatchResult，条目标识为 openid
+func BulkSendReport(results []BulkSendResult) *util.BatchResult {
+	report := new(util.BatchResult)
+	for _, result := range results {
+		report.Add(result.OpenID, result.Err)
+	}
+	return report
+}
+
+// isRetryableSendErr 网络错误及系统繁忙时可重试，其他业务错误（如用户未关注）重试无意义
+func isRetryableSendErr(err error) bool {
+	if errors.Is(err, context2.Canceled) || errors.Is(err, context2.DeadlineExceeded) {
+		return false
+	}
+	var commonErr *util.CommonError
+	if errors.As(err, &commonErr) {
+		return commonErr.ErrCode == errCodeSystemBusy
+	}
+	return true
+}
+
+// cancelBulkResults 将未完成的发送结果标记为 err
+func cancelBulkResults(results []BulkSendResult, err error) error {
+	for i := range results {
+		results[i].Err = err
+	}
+	return err
+}
+
+// sleepContext 等待 d，期间响应 ctx 取消
+func sleepContext(ctx context2.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+package message
+
+import (
+	context2 "context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func newTestTemplate() *Template {
+	return NewTemplate(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+}
+
+func TestSendBulk(t *testing.T) {
+	defer gock.Off()
+	const n = 30
+	recipients := make([]Recipient, 0, n)
+	for i := 0; i < n; i++ {
+		openID := fmt.Sprintf("openid-%d", i)
+		recipients = append(recipients, Recipient{
+			OpenID: openID,
+			Data:   map[string]*TemplateDataItem{"name": {Value: fmt.Sprintf("user-%d", i)}},
+		})
+
+		mock := gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+			BodyString(fmt.Sprintf(`"touser":"%s".*"template_id":"mock-template".*"name":\{"value":"user-%d"\}`, openID, i))
+		switch i {
+		case 3:
+			// 系统繁忙，重试后成功
+			mock.Reply(200).JSON(map[string]interface{}{"errcode": -1, "errmsg": "system error"})
+			gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+				BodyString(fmt.Sprintf(`"touser":"%s"`, openID)).
+				Reply(200).JSON(map[string]interface{}{"errcode": 0, "msgid": 1000 + i})
+		case 7:
+			// 用户拒收，不重试
+			mock.Reply(200).JSON(map[string]interface{}{"errcode": 43101, "errmsg": "user refuse to accept the msg"})
+		default:
+			mock.Reply(200).JSON(map[string]interface{}{"errcode": 0, "msgid": 1000 + i})
+		}
+	}
+
+	results, err := newTestTemplate().SendBulk(context2.Background(), "mock-template", recipients, RateLimitOpts{Interval: time.Millisecond, MaxRetries: 2, Backoff: time.Millisecond})
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+	if assert.Len(t, results, n) {
+		for i, res := range results {
+			assert.Equal(t, fmt.Sprintf("openid-%d", i), res.OpenID)
+			if i == 7 {
+				var commonErr *util.CommonError
+				assert.ErrorAs(t, res.Err, &commonErr)
+				assert.Equal(t, int64(43101), commonErr.ErrCode.Int64())
+				continue
+			}
+			assert.NoError(t, res.Err, res.OpenID)
+			assert.Equal(t, int64(1000+i), res.MsgID)
+		}
+	}
+
+	report := BulkSendReport(results)
+	assert.Equal(t, n, report.Total)
+	assert.Equal(t, n-1, report.Succeeded)
+	if assert.Len(t, report.Failed, 1) {
+		assert.Equal(t, "openid-7", report.Failed[0].Item)
+	}
+	assert.Error(t, report.Err())
+}
+
+func TestSendBulkCanceled(t *testing.T) {
+	ctx, cancel := context2.WithCancel(context2.Background())
+	cancel()
+	results, err := newTestTemplate().SendBulk(ctx, "mock-template", []Recipient{{OpenID: "a"}, {OpenID: "b"}}, RateLimitOpts{})
+	assert.ErrorIs(t, err, context2.Canceled)
+	for _, res := range results {
+		assert.ErrorIs(t, res.Err, context2.Canceled)
+	}
+}
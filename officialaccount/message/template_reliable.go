@@ -0,0 +1,194 @@
+package message
+
+import (
+	context2 "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/silenceper/wechat/v2/credential"
+	"github.com/silenceper/wechat/v2/internal/openapi"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// defaultSendResultTTL 默认等待送达结果的时长
+const defaultSendResultTTL = 10 * time.Minute
+
+// TemplateSendJobFinish 事件中的发送状态
+const (
+	// TemplateSendStatusSuccess 送达成功
+	TemplateSendStatusSuccess = "success"
+	// TemplateSendStatusUserBlock 用户拒收
+	TemplateSendStatusUserBlock = "failed:user block"
+	// TemplateSendStatusSystemFailed 其他原因发送失败
+	TemplateSendStatusSystemFailed = "failed: system failed"
+)
+
+// ErrSendResultTimeout 等待送达结果超时
+var ErrSendResultTimeout = errors.New("template send result timeout")
+
+// ReliableSendOpts SendReliable 的限速、重试及结果关联配置
+type ReliableSendOpts struct {
+	Throttle   *util.QuotaThrottle // 额度自适应限速器，每次发送（含重试）前等待
+	MaxRetries int                 // 网络错误或系统繁忙时的最大重试次数，超过调用限制时按 QuotaExceededPolicy 处理
+	Backoff    time.Duration       // 首次重试前的等待时长，之后每次翻倍
+	// Metadata 业务数据，收到 TEMPLATESENDJOBFINISH 事件时随送达结果返回
+	Metadata map[string]string
+	// ResultTTL 等待送达结果的时长，msgid 与 Metadata 的对应关系以该时长保存在 Cache 中，默认 10 分钟
+	ResultTTL time.Duration
+}
+
+// TemplateSendResult 模板消息的送达结果
+type TemplateSendResult struct {
+	MsgID    int64
+	OpenID   string
+	Status   string // 发送状态，见 TemplateSendStatus 系列常量
+	Metadata map[string]string
+}
+
+// IsSuccess 是否送达成功
+func (result *TemplateSendResult) IsSuccess() bool {
+	return result.Status == TemplateSendStatusSuccess
+}
+
+// SendHandle SendReliable 发送成功后返回，用于等待送达结果
+type SendHandle struct {
+	MsgID    int64
+	Metadata map[string]string
+
+	once   sync.Once
+	done   chan struct{}
+	result *TemplateSendResult
+}
+
+// Await 等待 TEMPLATESENDJOBFINISH 事件的送达结果，timeout 为 0 时仅受 ctx 控制
+// 超时返回 ErrSendResultTimeout，可通过 errors.Is 判断
+func (handle *SendHandle) Await(ctx context2.Context, timeout time.Duration) (*TemplateSendResult, error) {
+	if timeout > 0 {
+		var cancel context2.CancelFunc
+		ctx, cancel = context2.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	select {
+	case <-handle.done:
+		return handle.result, nil
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context2.DeadlineExceeded) {
+			return nil, fmt.Errorf("%w: msgid=%d", ErrSendResultTimeout, handle.MsgID)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+func (handle *SendHandle) resolve(result *TemplateSendResult) {
+	handle.once.Do(func() {
+		handle.result = result
+		close(handle.done)
+	})
+}
+
+// pendingSends 等待送达结果的 SendHandle，key 为 appid 及 msgid
+var pendingSends = struct {
+	sync.Mutex
+	handles map[string]*SendHandle
+}{handles: make(map[string]*SendHandle)}
+
+// sendRecord 保存在 Cache 中的 msgid 关联信息，供其他实例收到事件时关联
+type sendRecord struct {
+	OpenID   string            `json:"openid"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// SendReliable 发送模板消息，按 opts 限速并在网络错误、系统繁忙时退避重试，
+// 接口调用超过限制时按照配置的 QuotaExceededPolicy 进行处理，发送成功后保存 msgid 与 Metadata 的对应关系，返回可等待送达结果的 SendHandle
+// 需在收到 TEMPLATESENDJOBFINISH 事件时调用 HandleSendJobFinish 关联送达结果
+func (tpl *Template) SendReliable(ctx context2.Context, msg *TemplateMessage, opts ReliableSendOpts) (*SendHandle, error) {
+	var (
+		msgID   int64
+		err     error
+		backoff = opts.Backoff
+	)
+	for attempt := 0; ; attempt++ {
+		err = util.DoWithQuotaPolicy(ctx, tpl.QuotaExceededPolicy, openapi.NewOpenAPI(tpl.Context).ClearQuota, func() (sendErr error) {
+			if opts.Throttle != nil {
+				if sendErr = opts.Throttle.Wait(ctx); sendErr != nil {
+					return
+				}
+			}
+			msgID, sendErr = tpl.send(ctx, msg)
+			return
+		})
+		if err == nil {
+			break
+		}
+		if attempt >= opts.MaxRetries || !isRetryableSendErr(err) {
+			return nil, err
+		}
+		if err = sleepContext(ctx, backoff); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+	}
+
+	ttl := opts.ResultTTL
+	if ttl <= 0 {
+		ttl = defaultSendResultTTL
+	}
+	handle := &SendHandle{MsgID: msgID, Metadata: opts.Metadata, done: make(chan struct{})}
+	key := tpl.sendRecordCacheKey(msgID)
+	pendingSends.Lock()
+	pendingSends.handles[key] = handle
+	pendingSends.Unlock()
+	time.AfterFunc(ttl, func() {
+		pendingSends.Lock()
+		defer pendingSends.Unlock()
+		if pendingSends.handles[key] == handle {
+			delete(pendingSends.handles, key)
+		}
+	})
+	if tpl.Cache != nil {
+		if val, err := json.Marshal(sendRecord{OpenID: msg.ToUser, Metadata: opts.Metadata}); err == nil {
+			_ = tpl.Cache.Set(key, string(val), ttl)
+		}
+	}
+	return handle, nil
+}
+
+// HandleSendJobFinish 处理 TEMPLATESENDJOBFINISH 事件，关联 SendReliable 保存的 Metadata 并通知等待中的 SendHandle
+// 非该事件时 ok 为 false；未找到关联信息（如非 SendReliable 发送或已过期）时 Metadata 为空
+func (tpl *Template) HandleSendJobFinish(msg *MixMessage) (result *TemplateSendResult, ok bool) {
+	if msg.Event != EventTemplateSendJobFinish {
+		return nil, false
+	}
+	result = &TemplateSendResult{MsgID: msg.TemplateMsgID, OpenID: string(msg.FromUserName), Status: msg.Status}
+	key := tpl.sendRecordCacheKey(msg.TemplateMsgID)
+
+	pendingSends.Lock()
+	handle := pendingSends.handles[key]
+	delete(pendingSends.handles, key)
+	pendingSends.Unlock()
+
+	if handle != nil {
+		result.Metadata = handle.Metadata
+	} else if tpl.Cache != nil {
+		if val, isString := tpl.Cache.Get(key).(string); isString {
+			var record sendRecord
+			if err := json.Unmarshal([]byte(val), &record); err == nil {
+				result.Metadata = record.Metadata
+			}
+		}
+	}
+	if tpl.Cache != nil {
+		_ = tpl.Cache.Delete(key)
+	}
+	if handle != nil {
+		handle.resolve(result)
+	}
+	return result, true
+}
+
+func (tpl *Template) sendRecordCacheKey(msgID int64) string {
+	return fmt.Sprintf("%s_template_send_%s_%d", credential.CacheKeyOfficialAccountPrefix, tpl.AppID, msgID)
+}
@@ -0,0 +1,118 @@
+package message
+
+import (
+	context2 "context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestSendReliable(t *testing.T) {
+	defer gock.Off()
+	// 系统繁忙后重试成功
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": -1, "errmsg": "system error"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+		BodyString(`"touser":"mock-openid"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "msgid": 200228332})
+
+	ctx := &context.Context{
+		Config:            &config.Config{AppID: "mock-appid", Cache: cache.NewMemory()},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	}
+	tpl := NewTemplate(ctx)
+	handle, err := tpl.SendReliable(context2.Background(), &TemplateMessage{ToUser: "mock-openid", TemplateID: "mock-template"},
+		ReliableSendOpts{MaxRetries: 2, Backoff: time.Millisecond, Metadata: map[string]string{"order_id": "mock-order"}})
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, int64(200228332), handle.MsgID)
+
+	// 尚未收到事件时等待超时
+	_, err = handle.Await(context2.Background(), time.Millisecond)
+	assert.True(t, errors.Is(err, ErrSendResultTimeout))
+
+	// 事件由另一个 Template 实例处理
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_, _ = NewTemplate(ctx).HandleSendJobFinish(&MixMessage{
+			CommonToken:   CommonToken{FromUserName: "mock-openid"},
+			Event:         EventTemplateSendJobFinish,
+			TemplateMsgID: 200228332,
+			Status:        TemplateSendStatusSuccess,
+		})
+	}()
+	result, err := handle.Await(context2.Background(), time.Second)
+	assert.NoError(t, err)
+	assert.True(t, result.IsSuccess())
+	assert.Equal(t, "mock-openid", result.OpenID)
+	assert.Equal(t, map[string]string{"order_id": "mock-order"}, result.Metadata)
+}
+
+func TestSendReliableNotRetryable(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": 43101, "errmsg": "user refuse to accept the msg"})
+
+	_, err := newTestTemplate().SendReliable(context2.Background(), &TemplateMessage{ToUser: "mock-openid", TemplateID: "mock-template"},
+		ReliableSendOpts{MaxRetries: 2, Backoff: time.Millisecond})
+	assert.Error(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+// TestSendReliableQuotaExceeded 超过调用限制时不退避重试，按 QuotaExceededPolicy 处理
+func TestSendReliableQuotaExceeded(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": 45009, "errmsg": "reach max api daily quota limit"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "msgid": 200228332})
+
+	// 默认直接返回错误
+	tpl := newTestTemplate()
+	_, err := tpl.SendReliable(context2.Background(), &TemplateMessage{ToUser: "mock-openid", TemplateID: "mock-template"},
+		ReliableSendOpts{MaxRetries: 2, Backoff: time.Millisecond})
+	assert.True(t, util.IsQuotaExceeded(err))
+	assert.Len(t, gock.Pending(), 1)
+	gock.Off()
+
+	// 重置调用次数后重试一次
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": 45009, "errmsg": "reach max api daily quota limit"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/clear_quota").
+		BodyString(`"appid":"mock-appid"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "msgid": 200228332})
+
+	tpl.QuotaExceededPolicy = util.QuotaExceededClearAndRetry
+	handle, err := tpl.SendReliable(context2.Background(), &TemplateMessage{ToUser: "mock-openid", TemplateID: "mock-template"},
+		ReliableSendOpts{MaxRetries: 2, Backoff: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200228332), handle.MsgID)
+	assert.True(t, gock.IsDone())
+}
+
+// TestHandleSendJobFinishFromCache 其他实例发送的消息，从 Cache 中关联 Metadata
+func TestHandleSendJobFinishFromCache(t *testing.T) {
+	tpl := NewTemplate(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid", Cache: cache.NewMemory()},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	assert.NoError(t, tpl.Cache.Set(tpl.sendRecordCacheKey(1001), `{"openid":"mock-openid","metadata":{"order_id":"mock-order"}}`, time.Minute))
+
+	result, ok := tpl.HandleSendJobFinish(&MixMessage{Event: EventTemplateSendJobFinish, TemplateMsgID: 1001, Status: TemplateSendStatusUserBlock})
+	assert.True(t, ok)
+	assert.False(t, result.IsSuccess())
+	assert.Equal(t, map[string]string{"order_id": "mock-order"}, result.Metadata)
+
+	_, ok = tpl.HandleSendJobFinish(&MixMessage{Event: EventSubscribe})
+	assert.False(t, ok)
+}
@@ -0,0 +1,35 @@
+package message
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// templatePlaceholder 模板内容中的占位符，如 {{first.DATA}}
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([^{}\s]+?)\.DATA\s*\}\}`)
+
+// RenderTemplate 使用模板数据替换模板内容中的 {{key.DATA}} 占位符，生成预览文本，忽略颜色
+// data 中不存在的占位符保持原样，便于发现遗漏的字段
+func RenderTemplate(content string, data map[string]*TemplateDataItem) string {
+	return templatePlaceholder.ReplaceAllStringFunc(content, func(placeholder string) string {
+		key := templatePlaceholder.FindStringSubmatch(placeholder)[1]
+		if item, ok := data[key]; ok && item != nil {
+			return item.Value
+		}
+		return placeholder
+	})
+}
+
+// Preview 获取模板内容并渲染预览文本
+func (tpl *Template) Preview(templateID string, data map[string]*TemplateDataItem) (string, error) {
+	templateList, err := tpl.List()
+	if err != nil {
+		return "", err
+	}
+	for _, item := range templateList {
+		if item.TemplateID == templateID {
+			return RenderTemplate(item.Content, data), nil
+		}
+	}
+	return "", fmt.Errorf("template not found, template_id=%s", templateID)
+}
@@ -0,0 +1,50 @@
+package message
+
+import (
+	context2 "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/credential"
+)
+
+func TestTemplateDataItemColor(t *testing.T) {
+	data, err := json.Marshal(map[string]*TemplateDataItem{
+		"first":    {Value: "恭喜你购买成功！", Color: "#173177"},
+		"keyword1": {Value: "巧克力"},
+	})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"first":{"value":"恭喜你购买成功！","color":"#173177"},"keyword1":{"value":"巧克力"}}`, string(data))
+	assert.NotContains(t, string(data), `"color":""`)
+}
+
+func TestRenderTemplate(t *testing.T) {
+	content := "{{first.DATA}}\n商品名称：{{keyword1.DATA}}\n购买数量：{{ keyword2.DATA }}\n{{remark.DATA}}"
+	preview := RenderTemplate(content, map[string]*TemplateDataItem{
+		"first":    {Value: "恭喜你购买成功！", Color: "#173177"},
+		"keyword1": {Value: "巧克力"},
+		"keyword2": {Value: "2"},
+	})
+	assert.Equal(t, "恭喜你购买成功！\n商品名称：巧克力\n购买数量：2\n{{remark.DATA}}", preview)
+}
+
+// TestTemplateAccessTokenOverride ctx 中的 access_token 覆盖默认账号
+func TestTemplateAccessTokenOverride(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/template/api_add_template").
+		MatchParam("access_token", "override-token").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "template_id": "mock-template-id"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/template/del_private_template").
+		MatchParam("access_token", "override-token").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+
+	ctx := credential.WithAccessToken(context2.Background(), "override-token")
+	templateID, err := newTestTemplate().AddContext(ctx, "TM00015", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-template-id", templateID)
+	assert.NoError(t, newTestTemplate().DeleteContext(ctx, templateID))
+	assert.True(t, gock.IsDone())
+}
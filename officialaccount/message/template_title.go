@@ -0,0 +1,123 @@
+package message
+
+import (
+	context2 "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/silenceper/wechat/v2/credential"
+)
+
+// templateListCacheTTL 模板列表的缓存时长
+const templateListCacheTTL = 10 * time.Minute
+
+var (
+	// ErrTemplateNotFound 没有标题匹配的模板
+	ErrTemplateNotFound = errors.New("template not found")
+	// ErrTemplateTitleAmbiguous 有多个标题相同的模板，需要使用 template_id 发送
+	ErrTemplateTitleAmbiguous = errors.New("template title is ambiguous")
+)
+
+// SendByTitle 根据模板标题发送模板消息，标题与模板列表中的 title 完全匹配
+// 模板列表优先从 Cache 中获取，未唯一匹配时从微信服务器重新拉取一次，添加或删除模板后缓存失效；
+// 没有匹配的模板时返回 ErrTemplateNotFound，匹配多个模板时返回 ErrTemplateTitleAmbiguous，可通过 errors.Is 判断
+func (tpl *Template) SendByTitle(ctx context2.Context, title string, recipient Recipient) (msgID int64, err error) {
+	templateID, err := tpl.ResolveTemplateID(ctx, title)
+	if err != nil {
+		return 0, err
+	}
+	return tpl.SendContext(ctx, &TemplateMessage{
+		ToUser:      recipient.OpenID,
+		TemplateID:  templateID,
+		URL:         recipient.URL,
+		Data:        recipient.Data,
+		MiniProgram: recipient.MiniProgram,
+	})
+}
+
+// ResolveTemplateID 根据模板标题查找 template_id
+// ctx 中通过 credential.WithAccessToken 指定了其他账号时，模板列表属于该账号，不读写以 AppID 为 key 的缓存
+func (tpl *Template) ResolveTemplateID(ctx context2.Context, title string) (string, error) {
+	if _, overridden := credential.AccessTokenHandleFromContext(ctx); overridden {
+		list, err := tpl.ListContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		return matchTemplateTitle(list, title)
+	}
+	if list, ok := tpl.cachedTemplateList(); ok {
+		if templateID, err := matchTemplateTitle(list, title); err == nil {
+			return templateID, nil
+		}
+	}
+	// 缓存未命中，或缓存中没有唯一匹配的模板（如刚添加、删除），从微信服务器拉取
+	list, err := tpl.ListContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	tpl.cacheTemplateList(list)
+	return matchTemplateTitle(list, title)
+}
+
+// matchTemplateTitle 在模板列表中查找标题唯一匹配的模板
+func matchTemplateTitle(list []*TemplateItem, title string) (string, error) {
+	var templateIDs []string
+	for _, item := range list {
+		if item.Title == title {
+			templateIDs = append(templateIDs, item.TemplateID)
+		}
+	}
+	switch len(templateIDs) {
+	case 0:
+		return "", fmt.Errorf("%w: title %q", ErrTemplateNotFound, title)
+	case 1:
+		return templateIDs[0], nil
+	default:
+		return "", fmt.Errorf("%w: title %q matches templates %s", ErrTemplateTitleAmbiguous, title, strings.Join(templateIDs, ", "))
+	}
+}
+
+func (tpl *Template) templateListCacheKey() string {
+	return fmt.Sprintf("%s_template_list_%s", credential.CacheKeyOfficialAccountPrefix, tpl.AppID)
+}
+
+// cachedTemplateList 从 Cache 中获取模板列表
+func (tpl *Template) cachedTemplateList() ([]*TemplateItem, bool) {
+	if tpl.Cache == nil {
+		return nil, false
+	}
+	val, ok := tpl.Cache.Get(tpl.templateListCacheKey()).(string)
+	if !ok || val == "" {
+		return nil, false
+	}
+	var list []*TemplateItem
+	if err := json.Unmarshal([]byte(val), &list); err != nil {
+		return nil, false
+	}
+	return list, true
+}
+
+// invalidateTemplateList 添加或删除模板后删除缓存的模板列表
+// ctx 中指定了其他账号时操作的不是本账号的模板，不需要删除
+func (tpl *Template) invalidateTemplateList(ctx context2.Context) {
+	if tpl.Cache == nil {
+		return
+	}
+	if _, overridden := credential.AccessTokenHandleFromContext(ctx); overridden {
+		return
+	}
+	_ = tpl.Cache.Delete(tpl.templateListCacheKey())
+}
+
+// cacheTemplateList 将模板列表写入 Cache，写入失败时忽略
+func (tpl *Template) cacheTemplateList(list []*TemplateItem) {
+	if tpl.Cache == nil {
+		return
+	}
+	if val, err := json.Marshal(list); err == nil {
+		_ = tpl.Cache.Set(tpl.templateListCacheKey(), string(val), templateListCacheTTL)
+	}
+}
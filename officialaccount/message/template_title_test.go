@@ -0,0 +1,139 @@
+package message
+
+import (
+	context2 "context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/credential"
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+func mockTemplateList() {
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/template/get_all_private_template").
+		Reply(200).JSON(map[string]interface{}{"template_list": []map[string]string{
+		{"template_id": "tpl-order", "title": "订单支付成功通知"},
+		{"template_id": "tpl-refund-1", "title": "退款通知"},
+		{"template_id": "tpl-refund-2", "title": "退款通知"},
+	}})
+}
+
+func TestSendByTitle(t *testing.T) {
+	defer gock.Off()
+	mockTemplateList()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/message/template/send").Times(2).
+		BodyString(`"touser":"mock-openid","template_id":"tpl-order"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "msgid": 200228332})
+
+	tpl := NewTemplate(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid", Cache: cache.NewMemory()},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	recipient := Recipient{OpenID: "mock-openid", Data: map[string]*TemplateDataItem{"amount": {Value: "9.90"}}}
+	for i := 0; i < 2; i++ {
+		msgID, err := tpl.SendByTitle(context2.Background(), "订单支付成功通知", recipient)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(200228332), msgID)
+	}
+	// 第二次发送使用缓存的模板列表
+	assert.True(t, gock.IsDone())
+}
+
+func TestSendByTitleError(t *testing.T) {
+	defer gock.Off()
+	mockTemplateList()
+	mockTemplateList()
+
+	_, err := newTestTemplate().SendByTitle(context2.Background(), "退款通知", Recipient{OpenID: "mock-openid"})
+	assert.True(t, errors.Is(err, ErrTemplateTitleAmbiguous))
+	assert.Contains(t, err.Error(), "tpl-refund-1, tpl-refund-2")
+
+	_, err = newTestTemplate().SendByTitle(context2.Background(), "发货通知", Recipient{OpenID: "mock-openid"})
+	assert.True(t, errors.Is(err, ErrTemplateNotFound))
+	assert.True(t, gock.IsDone())
+}
+
+// TestSendByTitleAccessTokenOverride 指定其他账号的 access_token 时不读写默认账号的模板列表缓存
+func TestSendByTitleAccessTokenOverride(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/template/get_all_private_template").
+		MatchParam("access_token", "other-account-token").
+		Reply(200).JSON(map[string]interface{}{"template_list": []map[string]string{
+		{"template_id": "tpl-other-order", "title": "订单支付成功通知"},
+	}})
+	mockTemplateList()
+
+	tpl := NewTemplate(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid", Cache: cache.NewMemory()},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	ctx := credential.WithAccessToken(context2.Background(), "other-account-token")
+	templateID, err := tpl.ResolveTemplateID(ctx, "订单支付成功通知")
+	assert.NoError(t, err)
+	assert.Equal(t, "tpl-other-order", templateID)
+
+	// 默认账号仍从微信服务器拉取自己的模板列表
+	templateID, err = tpl.ResolveTemplateID(context2.Background(), "订单支付成功通知")
+	assert.NoError(t, err)
+	assert.Equal(t, "tpl-order", templateID)
+	assert.True(t, gock.IsDone())
+}
+
+// TestResolveTemplateIDAfterDeleteAndAdd 删除并重新添加同标题的模板后，不使用缓存中已删除的 template_id
+func TestResolveTemplateIDAfterDeleteAndAdd(t *testing.T) {
+	defer gock.Off()
+	mockTemplateList()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/template/del_private_template").
+		BodyString(`"template_id":"tpl-order"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/template/api_add_template").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "template_id": "tpl-order-new"})
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/template/get_all_private_template").
+		Reply(200).JSON(map[string]interface{}{"template_list": []map[string]string{
+		{"template_id": "tpl-order-new", "title": "订单支付成功通知"},
+	}})
+
+	tpl := NewTemplate(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid", Cache: cache.NewMemory()},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	templateID, err := tpl.ResolveTemplateID(context2.Background(), "订单支付成功通知")
+	assert.NoError(t, err)
+	assert.Equal(t, "tpl-order", templateID)
+
+	assert.NoError(t, tpl.Delete("tpl-order"))
+	_, err = tpl.Add("OPENTM200000000", nil)
+	assert.NoError(t, err)
+
+	templateID, err = tpl.ResolveTemplateID(context2.Background(), "订单支付成功通知")
+	assert.NoError(t, err)
+	assert.Equal(t, "tpl-order-new", templateID)
+	assert.True(t, gock.IsDone())
+}
+
+// TestResolveTemplateIDRefetchOnAmbiguous 缓存中有多个同标题模板时重新拉取一次
+func TestResolveTemplateIDRefetchOnAmbiguous(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/template/get_all_private_template").
+		Reply(200).JSON(map[string]interface{}{"template_list": []map[string]string{
+		{"template_id": "tpl-refund-1", "title": "退款通知"},
+	}})
+
+	tpl := NewTemplate(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid", Cache: cache.NewMemory()},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+	tpl.cacheTemplateList([]*TemplateItem{
+		{TemplateID: "tpl-refund-1", Title: "退款通知"},
+		{TemplateID: "tpl-refund-2", Title: "退款通知"},
+	})
+	templateID, err := tpl.ResolveTemplateID(context2.Background(), "退款通知")
+	assert.NoError(t, err)
+	assert.Equal(t, "tpl-refund-1", templateID)
+	assert.True(t, gock.IsDone())
+}
@@ -11,7 +11,7 @@ type Video struct {
 	} `xml:"Video"`
 }
 
-// NewVideo 回复图片消息
+// NewVideo 回复视频消息
 func NewVideo(mediaID, title, description string) *Video {
 	video := new(Video)
 	video.Video.MediaID = mediaID
@@ -19,3 +19,18 @@ func NewVideo(mediaID, title, description string) *Video {
 	video.Video.Description = description
 	return video
 }
+
+// Validate 校验视频消息，媒体 id 必填且格式同图片消息；标题及描述可选
+// 与音乐消息不同，视频消息没有 MusicUrl、HQMusicUrl 及 ThumbMediaId，媒体 id 为视频本身的 MediaId
+func (video *Video) Validate() error {
+	return validateReplyMediaID(MsgTypeVideo, video.Video.MediaID)
+}
+
+// NewVideoReply 构造被动回复的视频消息，媒体 id 为空或格式不正确时返回错误
+func NewVideoReply(mediaID, title, description string) (*Reply, error) {
+	video := NewVideo(mediaID, title, description)
+	if err := video.Validate(); err != nil {
+		return nil, err
+	}
+	return &Reply{MsgType: MsgTypeVideo, MsgData: video}, nil
+}
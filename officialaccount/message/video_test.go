@@ -0,0 +1,30 @@
+package message
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVideoReply(t *testing.T) {
+	reply, err := NewVideoReply("mock-media-id", "title", "description")
+	assert.NoError(t, err)
+	assert.Equal(t, MsgTypeVideo, reply.MsgType)
+
+	video := reply.MsgData.(*Video)
+	video.SetToUserName("to-user")
+	video.SetFromUserName("from-user")
+	video.SetCreateTime(12345678)
+	video.SetMsgType(MsgTypeVideo)
+	data, err := xml.Marshal(video)
+	assert.NoError(t, err)
+	assert.Equal(t, "<xml><ToUserName><![CDATA[to-user]]></ToUserName><FromUserName><![CDATA[from-user]]></FromUserName>"+
+		"<CreateTime>12345678</CreateTime><MsgType>video</MsgType>"+
+		"<Video><MediaId>mock-media-id</MediaId><Title>title</Title><Description>description</Description></Video></xml>", string(data))
+}
+
+func TestNewVideoReplyMissingMediaID(t *testing.T) {
+	_, err := NewVideoReply("", "title", "description")
+	assert.EqualError(t, err, "video reply requires media_id")
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
 	"github.com/silenceper/wechat/v2/util"
@@ -34,14 +33,19 @@ func NewOauth(context *context.Context) *Oauth {
 
 // GetRedirectURL 获取跳转的url地址
 func (oauth *Oauth) GetRedirectURL(redirectURI, scope, state string) (string, error) {
-	// url encode
-	urlStr := url.QueryEscape(redirectURI)
+	urlStr, err := NormalizeRedirectURI(redirectURI)
+	if err != nil {
+		return "", err
+	}
 	return fmt.Sprintf(redirectOauthURL, oauth.AppID, urlStr, scope, state), nil
 }
 
 // GetWebAppRedirectURL 获取网页应用跳转的url地址
 func (oauth *Oauth) GetWebAppRedirectURL(redirectURI, scope, state string) (string, error) {
-	urlStr := url.QueryEscape(redirectURI)
+	urlStr, err := NormalizeRedirectURI(redirectURI)
+	if err != nil {
+		return "", err
+	}
 	return fmt.Sprintf(webAppRedirectOauthURL, oauth.AppID, urlStr, scope, state), nil
 }
 
@@ -74,6 +78,11 @@ type ResAccessToken struct {
 	UnionID string `json:"unionid"`
 }
 
+// GetUserInfoByCode 通过网页授权的code 换取用户的信息
+func (oauth *Oauth) GetUserInfoByCode(code string) (result UserInfo, err error) {
+	return oauth.GetUserInfoByCodeContext(ctx2.Background(), code)
+}
+
 // GetUserInfoByCodeContext 通过网页授权的code 换取用户的信息
 func (oauth *Oauth) GetUserInfoByCodeContext(ctx ctx2.Context, code string) (result UserInfo, err error) {
 	var (
@@ -95,7 +104,7 @@ func (oauth *Oauth) GetUserAccessToken(code string) (result ResAccessToken, err
 func (oauth *Oauth) GetUserAccessTokenContext(ctx ctx2.Context, code string) (result ResAccessToken, err error) {
 	urlStr := fmt.Sprintf(accessTokenURL, oauth.AppID, oauth.AppSecret, code)
 	var response []byte
-	response, err = util.HTTPGetContext(ctx, urlStr)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "oauth.GetUserAccessTokenContext"), urlStr)
 	if err != nil {
 		return
 	}
@@ -119,7 +128,7 @@ func (oauth *Oauth) RefreshAccessToken(refreshToken string) (result ResAccessTok
 func (oauth *Oauth) RefreshAccessTokenContext(ctx ctx2.Context, refreshToken string) (result ResAccessToken, err error) {
 	urlStr := fmt.Sprintf(refreshAccessTokenURL, oauth.AppID, refreshToken)
 	var response []byte
-	response, err = util.HTTPGetContext(ctx, urlStr)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "oauth.RefreshAccessTokenContext"), urlStr)
 	if err != nil {
 		return
 	}
@@ -143,7 +152,7 @@ func (oauth *Oauth) CheckAccessToken(accessToken, openID string) (b bool, err er
 func (oauth *Oauth) CheckAccessTokenContext(ctx ctx2.Context, accessToken, openID string) (b bool, err error) {
 	urlStr := fmt.Sprintf(checkAccessTokenURL, accessToken, openID)
 	var response []byte
-	response, err = util.HTTPGetContext(ctx, urlStr)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "oauth.CheckAccessTokenContext"), urlStr)
 	if err != nil {
 		return
 	}
@@ -187,7 +196,7 @@ func (oauth *Oauth) GetUserInfoContext(ctx ctx2.Context, accessToken, openID, la
 	}
 	urlStr := fmt.Sprintf(userInfoURL, accessToken, openID, lang)
 	var response []byte
-	response, err = util.HTTPGetContext(ctx, urlStr)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "oauth.GetUserInfoContext"), urlStr)
 	if err != nil {
 		return
 	}
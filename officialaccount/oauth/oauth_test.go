@@ -0,0 +1,69 @@
+package oauth
+
+import (
+	ctx2 "context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// newBlockingServer 返回一个直到请求被取消才结束的服务，并将微信接口地址指向该服务
+func newBlockingServer() func() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	util.SetURIModifier(func(uri string) string {
+		return strings.Replace(uri, "https://api.weixin.qq.com", server.URL, 1)
+	})
+	return func() {
+		util.SetURIModifier(nil)
+		server.Close()
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	defer newBlockingServer()()
+
+	oauth := NewOauth(&context.Context{
+		Config: &config.Config{AppID: "mock-appid", AppSecret: "mock-secret"},
+	})
+	calls := map[string]func(ctx ctx2.Context) error{
+		"GetUserAccessToken": func(ctx ctx2.Context) error {
+			_, err := oauth.GetUserAccessTokenContext(ctx, "mock-code")
+			return err
+		},
+		"RefreshAccessToken": func(ctx ctx2.Context) error {
+			_, err := oauth.RefreshAccessTokenContext(ctx, "mock-refresh-token")
+			return err
+		},
+		"CheckAccessToken": func(ctx ctx2.Context) error {
+			_, err := oauth.CheckAccessTokenContext(ctx, "mock-access-token", "mock-openid")
+			return err
+		},
+		"GetUserInfo": func(ctx ctx2.Context) error {
+			_, err := oauth.GetUserInfoContext(ctx, "mock-access-token", "mock-openid", "")
+			return err
+		},
+		"GetUserInfoByCode": func(ctx ctx2.Context) error {
+			_, err := oauth.GetUserInfoByCodeContext(ctx, "mock-code")
+			return err
+		},
+	}
+	for name, call := range calls {
+		ctx, cancel := ctx2.WithTimeout(ctx2.Background(), 50*time.Millisecond)
+		start := time.Now()
+		err := call(ctx)
+		cancel()
+		assert.True(t, errors.Is(err, ctx2.DeadlineExceeded), "%s: %v", name, err)
+		assert.Less(t, int64(time.Since(start)), int64(5*time.Second), name)
+	}
+}
@@ -0,0 +1,35 @@
+package oauth
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// NormalizeRedirectURI 校验网页授权回调地址，并返回 url encode 后可直接拼接到授权链接中的结果
+// 回调地址必须为 http 或 https 的绝对地址；已经 url encode 过的地址会先解码，避免重复编码
+func NormalizeRedirectURI(uri string) (string, error) {
+	uri = strings.TrimSpace(uri)
+	if uri == "" {
+		return "", fmt.Errorf("redirect_uri is empty")
+	}
+	lower := strings.ToLower(uri)
+	if strings.HasPrefix(lower, "http%3a") || strings.HasPrefix(lower, "https%3a") {
+		decoded, err := url.QueryUnescape(uri)
+		if err != nil {
+			return "", fmt.Errorf("invalid redirect_uri %q: %v", uri, err)
+		}
+		uri = decoded
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect_uri %q: %v", uri, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid redirect_uri %q: scheme must be http or https", uri)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid redirect_uri %q: host is empty", uri)
+	}
+	return url.QueryEscape(u.String()), nil
+}
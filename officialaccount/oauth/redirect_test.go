@@ -0,0 +1,41 @@
+package oauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+func TestNormalizeRedirectURI(t *testing.T) {
+	uri, err := NormalizeRedirectURI("https://example.com/callback")
+	assert.NoError(t, err)
+	assert.Equal(t, "https%3A%2F%2Fexample.com%2Fcallback", uri)
+
+	// 包含查询参数，需要编码
+	uri, err = NormalizeRedirectURI(" https://example.com/callback?from=menu&id=1 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "https%3A%2F%2Fexample.com%2Fcallback%3Ffrom%3Dmenu%26id%3D1", uri)
+
+	// 已经编码过的地址不重复编码
+	uri, err = NormalizeRedirectURI("https%3A%2F%2Fexample.com%2Fcallback%3Ffrom%3Dmenu%26id%3D1")
+	assert.NoError(t, err)
+	assert.Equal(t, "https%3A%2F%2Fexample.com%2Fcallback%3Ffrom%3Dmenu%26id%3D1", uri)
+
+	for _, invalid := range []string{"", "ftp://example.com/callback", "javascript:alert(1)", "/callback", "https:///callback"} {
+		_, err = NormalizeRedirectURI(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestGetRedirectURL(t *testing.T) {
+	oauth := NewOauth(&context.Context{Config: &config.Config{AppID: "mock-appid"}})
+	location, err := oauth.GetRedirectURL("https://example.com/callback?id=1", "snsapi_base", "state")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://open.weixin.qq.com/connect/oauth2/authorize?appid=mock-appid&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback%3Fid%3D1&response_type=code&scope=snsapi_base&state=state#wechat_redirect", location)
+
+	_, err = oauth.GetWebAppRedirectURL("ftp://example.com/callback", "snsapi_login", "state")
+	assert.Error(t, err)
+}
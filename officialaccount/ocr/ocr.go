@@ -1,6 +1,7 @@
 package ocr
 
 import (
+	context2 "context"
 	"fmt"
 	"net/url"
 
@@ -160,7 +161,7 @@ func (ocr *OCR) IDCard(path string) (resIDCard ResIDCard, err error) {
 		return
 	}
 
-	response, err := util.HTTPPost(fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrIDCardURL, url.QueryEscape(path), accessToken), "")
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "ocr.OCRIDCard"), fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrIDCardURL, url.QueryEscape(path), accessToken), nil, nil)
 	if err != nil {
 		return
 	}
@@ -177,7 +178,7 @@ func (ocr *OCR) BankCard(path string) (resBankCard ResBankCard, err error) {
 		return
 	}
 
-	response, err := util.HTTPPost(fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrBankCardURL, url.QueryEscape(path), accessToken), "")
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "ocr.OCRBankCard"), fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrBankCardURL, url.QueryEscape(path), accessToken), nil, nil)
 	if err != nil {
 		return
 	}
@@ -194,7 +195,7 @@ func (ocr *OCR) Driving(path string) (resDriving ResDriving, err error) {
 		return
 	}
 
-	response, err := util.HTTPPost(fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrDrivingURL, url.QueryEscape(path), accessToken), "")
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "ocr.OCRDriving"), fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrDrivingURL, url.QueryEscape(path), accessToken), nil, nil)
 	if err != nil {
 		return
 	}
@@ -211,7 +212,7 @@ func (ocr *OCR) DrivingLicense(path string) (resDrivingLicense ResDrivingLicense
 		return
 	}
 
-	response, err := util.HTTPPost(fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrDrivingLicenseURL, url.QueryEscape(path), accessToken), "")
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "ocr.OCRDrivingLicense"), fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrDrivingLicenseURL, url.QueryEscape(path), accessToken), nil, nil)
 	if err != nil {
 		return
 	}
@@ -228,7 +229,7 @@ func (ocr *OCR) BizLicense(path string) (resBizLicense ResBizLicense, err error)
 		return
 	}
 
-	response, err := util.HTTPPost(fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrBizLicenseURL, url.QueryEscape(path), accessToken), "")
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "ocr.OCRBizLicense"), fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrBizLicenseURL, url.QueryEscape(path), accessToken), nil, nil)
 	if err != nil {
 		return
 	}
@@ -245,7 +246,7 @@ func (ocr *OCR) Common(path string) (resCommon ResCommon, err error) {
 		return
 	}
 
-	response, err := util.HTTPPost(fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrCommonURL, url.QueryEscape(path), accessToken), "")
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "ocr.OCRCommon"), fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrCommonURL, url.QueryEscape(path), accessToken), nil, nil)
 	if err != nil {
 		return
 	}
@@ -262,7 +263,7 @@ func (ocr *OCR) PlateNumber(path string) (resPlateNumber ResPlateNumber, err err
 		return
 	}
 
-	response, err := util.HTTPPost(fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrPlateNumberURL, url.QueryEscape(path), accessToken), "")
+	response, err := util.HTTPPostContext(util.WithOperation(context2.Background(), "ocr.OCRPlateNumber"), fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrPlateNumberURL, url.QueryEscape(path), accessToken), nil, nil)
 	if err != nil {
 		return
 	}
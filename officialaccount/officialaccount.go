@@ -2,9 +2,11 @@ package officialaccount
 
 import (
 	stdcontext "context"
+	"io"
 	"net/http"
 
 	"github.com/silenceper/wechat/v2/internal/openapi"
+	"github.com/silenceper/wechat/v2/officialaccount/comment"
 	"github.com/silenceper/wechat/v2/officialaccount/draft"
 	"github.com/silenceper/wechat/v2/officialaccount/freepublish"
 	"github.com/silenceper/wechat/v2/officialaccount/ocr"
@@ -36,6 +38,7 @@ type OfficialAccount struct {
 	material     *material.Material
 	draft        *draft.Draft
 	freepublish  *freepublish.FreePublish
+	comment      *comment.Comment
 	js           *js.Js
 	user         *user.User
 	templateMsg  *message.Template
@@ -45,16 +48,32 @@ type OfficialAccount struct {
 	datacube     *datacube.DataCube
 	ocr          *ocr.OCR
 	subscribeMsg *message.Subscribe
+	csManager    *customerservice.Manager
+	openAPI      *openapi.OpenAPI
 }
 
 // NewOfficialAccount 实例化公众号API
 func NewOfficialAccount(cfg *config.Config) *OfficialAccount {
 	var defaultAkHandle credential.AccessTokenContextHandle
 	const cacheKeyPrefix = credential.CacheKeyOfficialAccountPrefix
+	secretProvider := credential.StaticSecret(cfg.AppSecret)
+	if cfg.SecretProvider != nil {
+		secretProvider = cfg.SecretProvider
+	}
 	if cfg.UseStableAK {
-		defaultAkHandle = credential.NewStableAccessToken(cfg.AppID, cfg.AppSecret, cacheKeyPrefix, cfg.Cache)
+		defaultAkHandle = credential.NewStableAccessTokenWithSecretProvider(cfg.AppID, secretProvider, cacheKeyPrefix, cfg.GetTokenCache())
 	} else {
-		defaultAkHandle = credential.NewDefaultAccessToken(cfg.AppID, cfg.AppSecret, cacheKeyPrefix, cfg.Cache)
+		defaultAkHandle = credential.NewDefaultAccessTokenWithSecretProvider(cfg.AppID, secretProvider, cacheKeyPrefix, cfg.GetTokenCache())
+	}
+	if locker, ok := defaultAkHandle.(credential.TokenLocker); ok && cfg.TokenLock != nil {
+		locker.SetLock(cfg.TokenLock)
+	}
+	if observer, ok := defaultAkHandle.(credential.TokenRefreshObserver); ok && cfg.OnTokenRefresh != nil {
+		hook := credential.TokenRefreshHook(cfg.OnTokenRefresh)
+		if !cfg.RevealRefreshedToken {
+			hook = credential.RedactRefreshHook(hook)
+		}
+		observer.SetRefreshHook(hook)
 	}
 	ctx := &context.Context{
 		Config:            cfg,
@@ -68,6 +87,15 @@ func (officialAccount *OfficialAccount) SetAccessTokenHandle(accessTokenHandle c
 	officialAccount.ctx.AccessTokenHandle = accessTokenHandle
 }
 
+// Close 中止正在进行的 access_token 刷新，用于服务优雅退出
+// access_token 获取方式实现了 io.Closer 时调用其 Close 方法
+func (officialAccount *OfficialAccount) Close() error {
+	if closer, ok := officialAccount.ctx.AccessTokenHandle.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // GetContext get Context
 func (officialAccount *OfficialAccount) GetContext() *context.Context {
 	return officialAccount.ctx
@@ -217,10 +245,59 @@ func (officialAccount *OfficialAccount) GetSubscribe() *message.Subscribe {
 
 // GetCustomerServiceManager 客服管理
 func (officialAccount *OfficialAccount) GetCustomerServiceManager() *customerservice.Manager {
-	return customerservice.NewCustomerServiceManager(officialAccount.ctx)
+	if officialAccount.csManager == nil {
+		officialAccount.csManager = customerservice.NewCustomerServiceManager(officialAccount.ctx)
+	}
+	return officialAccount.csManager
 }
 
 // GetOpenAPI openApi管理接口
 func (officialAccount *OfficialAccount) GetOpenAPI() *openapi.OpenAPI {
-	return openapi.NewOpenAPI(officialAccount.ctx)
+	if officialAccount.openAPI == nil {
+		officialAccount.openAPI = openapi.NewOpenAPI(officialAccount.ctx)
+	}
+	return officialAccount.openAPI
+}
+
+// GetComment 图文消息留言管理
+func (officialAccount *OfficialAccount) GetComment() *comment.Comment {
+	if officialAccount.comment == nil {
+		officialAccount.comment = comment.NewComment(officialAccount.ctx)
+	}
+	return officialAccount.comment
+}
+
+// User 用户管理接口，同 GetUser
+func (officialAccount *OfficialAccount) User() *user.User {
+	return officialAccount.GetUser()
+}
+
+// Menu 菜单管理接口，同 GetMenu
+func (officialAccount *OfficialAccount) Menu() *menu.Menu {
+	return officialAccount.GetMenu()
+}
+
+// JS js-sdk配置，同 GetJs
+func (officialAccount *OfficialAccount) JS() *js.Js {
+	return officialAccount.GetJs()
+}
+
+// Material 素材管理，同 GetMaterial
+func (officialAccount *OfficialAccount) Material() *material.Material {
+	return officialAccount.GetMaterial()
+}
+
+// Template 模板消息接口，同 GetTemplate
+func (officialAccount *OfficialAccount) Template() *message.Template {
+	return officialAccount.GetTemplate()
+}
+
+// OAuth oauth2网页授权，同 GetOauth
+func (officialAccount *OfficialAccount) OAuth() *oauth.Oauth {
+	return officialAccount.GetOauth()
+}
+
+// Broadcast 群发消息，同 GetBroadcast
+func (officialAccount *OfficialAccount) Broadcast() *broadcast.Broadcast {
+	return officialAccount.GetBroadcast()
 }
@@ -0,0 +1,192 @@
+package officialaccount
+
+import (
+	"bytes"
+	stdcontext "context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/material"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// recordingCache 记录写入的 key
+type recordingCache struct {
+	cache.Cache
+	keys []string
+}
+
+func (c *recordingCache) Set(key string, val interface{}, timeout time.Duration) error {
+	c.keys = append(c.keys, key)
+	return c.Cache.Set(key, val, timeout)
+}
+
+func TestTokenCache(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(map[string]interface{}{"access_token": "mock-access-token", "expires_in": 7200})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/media/upload").
+		MatchParam("access_token", "mock-access-token").
+		Reply(200).JSON(map[string]interface{}{"type": "image", "media_id": "mock-media-id", "created_at": 1})
+
+	generalCache := &recordingCache{Cache: cache.NewMemory()}
+	tokenCache := &recordingCache{Cache: cache.NewMemory()}
+	oa := NewOfficialAccount(&config.Config{
+		AppID:      "mock-appid",
+		AppSecret:  "mock-secret",
+		Cache:      generalCache,
+		TokenCache: tokenCache,
+	})
+
+	for i := 0; i < 2; i++ {
+		media, err := oa.GetMaterial().MediaUploadFromReaderWithCache(material.MediaTypeImage, "a.png", bytes.NewReader([]byte("mock-image")))
+		assert.NoError(t, err)
+		assert.Equal(t, "mock-media-id", media.MediaID)
+	}
+	assert.True(t, gock.IsDone())
+
+	// access_token 只写入 TokenCache，素材上传结果只写入 Cache
+	for _, key := range tokenCache.keys {
+		assert.Contains(t, key, "access_token")
+	}
+	assert.Contains(t, tokenCache.keys, "gowechat_officialaccount__access_token_mock-appid")
+	if assert.Len(t, generalCache.keys, 1) {
+		assert.True(t, strings.Contains(generalCache.keys[0], "_media_mock-appid_image_"))
+	}
+}
+
+func TestTokenCacheDefault(t *testing.T) {
+	memory := cache.NewMemory()
+	cfg := &config.Config{Cache: memory}
+	assert.Equal(t, cache.Cache(memory), cfg.GetTokenCache())
+}
+
+func TestOnTokenRefresh(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Times(2).
+		Reply(200).JSON(map[string]interface{}{"access_token": "mock-access-token", "expires_in": 7200})
+
+	var tokens []string
+	var expires []int64
+	hook := func(appID string, token string, expiresIn int64, err error) {
+		assert.Equal(t, "mock-appid", appID)
+		assert.NoError(t, err)
+		tokens = append(tokens, token)
+		expires = append(expires, expiresIn)
+	}
+
+	// 默认脱敏
+	oa := NewOfficialAccount(&config.Config{AppID: "mock-appid", AppSecret: "mock-secret", Cache: cache.NewMemory(), OnTokenRefresh: hook})
+	_, err := oa.GetAccessToken()
+	assert.NoError(t, err)
+
+	oa = NewOfficialAccount(&config.Config{AppID: "mock-appid", AppSecret: "mock-secret", Cache: cache.NewMemory(), OnTokenRefresh: hook, RevealRefreshedToken: true})
+	_, err = oa.GetAccessToken()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"mock*********oken", "mock-access-token"}, tokens)
+	assert.Equal(t, []int64{7200, 7200}, expires)
+}
+
+func TestSubClientsShareContext(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").
+		Reply(200).JSON(map[string]interface{}{"access_token": "mock-access-token", "expires_in": 7200})
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").
+		MatchParam("access_token", "mock-access-token").
+		Reply(200).JSON(map[string]interface{}{"subscribe": 1, "openid": "mock-openid"})
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/get_current_selfmenu_info").
+		MatchParam("access_token", "mock-access-token").
+		Reply(200).JSON(map[string]interface{}{"is_menu_open": 1})
+
+	oa := NewOfficialAccount(&config.Config{AppID: "mock-appid", AppSecret: "mock-secret", Cache: cache.NewMemory()})
+	ctx := oa.GetContext()
+
+	// 子模块延迟初始化后缓存，且共享同一个 Context
+	assert.Same(t, oa.GetUser(), oa.GetUser())
+	assert.Same(t, oa.GetMenu(), oa.GetMenu())
+	assert.Same(t, oa.GetJs(), oa.GetJs())
+	assert.Same(t, oa.GetMaterial(), oa.GetMaterial())
+	assert.Same(t, oa.GetTemplate(), oa.GetTemplate())
+	assert.Same(t, oa.GetOauth(), oa.GetOauth())
+	assert.Same(t, oa.GetBroadcast(), oa.GetBroadcast())
+	assert.Same(t, oa.GetCustomerServiceManager(), oa.GetCustomerServiceManager())
+	assert.Same(t, oa.GetOpenAPI(), oa.GetOpenAPI())
+	assert.Same(t, ctx, oa.GetUser().Context)
+	assert.Same(t, ctx, oa.GetMenu().Context)
+	assert.Same(t, ctx, oa.GetJs().Context)
+	assert.Same(t, ctx, oa.GetMaterial().Context)
+	assert.Same(t, ctx, oa.GetTemplate().Context)
+	assert.Same(t, ctx, oa.GetOauth().Context)
+	assert.Same(t, ctx, oa.GetBroadcast().Context)
+
+	// User()、Menu() 等与对应的 Get 方法返回同一个实例
+	assert.Same(t, oa.GetUser(), oa.User())
+	assert.Same(t, oa.GetMenu(), oa.Menu())
+	assert.Same(t, oa.GetJs(), oa.JS())
+	assert.Same(t, oa.GetMaterial(), oa.Material())
+	assert.Same(t, oa.GetTemplate(), oa.Template())
+	assert.Same(t, oa.GetOauth(), oa.OAuth())
+	assert.Same(t, oa.GetBroadcast(), oa.Broadcast())
+
+	// 只从微信服务器获取一次 access_token，各子模块共享
+	info, err := oa.User().GetUserInfo("mock-openid")
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-openid", info.OpenID)
+	_, err = oa.Menu().GetCurrentSelfMenuInfo()
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+// TestOfficialAccountClose 通过 OfficialAccount.Close 中止正在进行的 access_token 获取，包括稳定版 access_token
+func TestOfficialAccountClose(t *testing.T) {
+	for _, useStableAK := range []bool{false, true} {
+		received := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// 读完请求体后才能感知客户端断开
+			_, _ = io.Copy(io.Discard, r.Body)
+			close(received)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(10 * time.Second):
+			}
+		}))
+		util.SetURIModifier(func(uri string) string {
+			return strings.Replace(uri, "https://api.weixin.qq.com", server.URL, 1)
+		})
+
+		officialAccount := NewOfficialAccount(&config.Config{
+			AppID: "mock-appid", AppSecret: "mock-secret", Cache: cache.NewMemory(), UseStableAK: useStableAK,
+		})
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := officialAccount.GetAccessTokenContext(stdcontext.Background())
+			errCh <- err
+		}()
+
+		<-received
+		assert.Nil(t, officialAccount.Close())
+		select {
+		case err := <-errCh:
+			assert.True(t, errors.Is(err, stdcontext.Canceled), "stable=%v: %v", useStableAK, err)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("stable=%v: access_token fetch should return promptly after Close", useStableAK)
+		}
+		_, err := officialAccount.GetAccessTokenContext(stdcontext.Background())
+		assert.True(t, errors.Is(err, stdcontext.Canceled), "stable=%v: %v", useStableAK, err)
+
+		util.SetURIModifier(nil)
+		server.Close()
+	}
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	context2 "context"
+
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+// MessageHandler 按消息类型或事件类型注册的处理方法
+type MessageHandler func(ctx context2.Context, msg *message.MixMessage) (*message.Reply, error)
+
+// On 注册指定消息类型的处理方法，如 message.MsgTypeText
+// 事件消息（message.MsgTypeEvent）优先按 OnEvent 注册的事件类型分发
+func (srv *Server) On(msgType message.MsgType, handler MessageHandler) {
+	if srv.msgTypeHandlers == nil {
+		srv.msgTypeHandlers = make(map[message.MsgType]MessageHandler)
+	}
+	srv.msgTypeHandlers[msgType] = handler
+}
+
+// OnEvent 注册指定事件类型的处理方法，如 message.EventClick
+func (srv *Server) OnEvent(event message.EventType, handler MessageHandler) {
+	if srv.eventHandlers == nil {
+		srv.eventHandlers = make(map[message.EventType]MessageHandler)
+	}
+	srv.eventHandlers[event] = handler
+}
+
+// OnDefault 注册未匹配到 On/OnEvent 时的默认处理方法
+// 未设置时回退到 SetMessageHandler 设置的处理方法
+func (srv *Server) OnDefault(handler MessageHandler) {
+	srv.defaultHandler = handler
+}
+
+// dispatch 将消息分发到注册的处理方法，未找到处理方法时 handled 为 false
+func (srv *Server) dispatch(msg *message.MixMessage) (reply *message.Reply, handled bool, err error) {
+	handler := srv.lookupHandler(msg)
+	if handler == nil {
+		return nil, false, nil
+	}
+	reply, err = handler(srv.requestContext(), msg)
+	return reply, true, err
+}
+
+// lookupHandler 查找消息对应的处理方法：事件类型 > 消息类型 > 默认
+func (srv *Server) lookupHandler(msg *message.MixMessage) MessageHandler {
+	if msg.MsgType == message.MsgTypeEvent {
+		if handler, ok := srv.eventHandlers[msg.Event]; ok {
+			return handler
+		}
+	}
+	if handler, ok := srv.msgTypeHandlers[msg.MsgType]; ok {
+		return handler
+	}
+	return srv.defaultHandler
+}
+
+// fallback 按未注册事件处理：消息类型 > 默认 > SetMessageHandler 设置的处理方法
+func (srv *Server) fallback(ctx context2.Context, msg *message.MixMessage) (*message.Reply, error) {
+	if handler, ok := srv.msgTypeHandlers[msg.MsgType]; ok {
+		return handler(ctx, msg)
+	}
+	if srv.defaultHandler != nil {
+		return srv.defaultHandler(ctx, msg)
+	}
+	if srv.messageHandler != nil {
+		return srv.messageHandler(msg), nil
+	}
+	return nil, nil
+}
+
+func (srv *Server) requestContext() context2.Context {
+	if srv.Request != nil {
+		return srv.Request.Context()
+	}
+	return context2.Background()
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	context2 "context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/officialaccount/freepublish"
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+func newTestServer(body string) *Server {
+	srv := NewServer(&context.Context{Config: &config.Config{AppID: "mock-appid", Token: "mock-token"}})
+	srv.Request = httptest.NewRequest("POST", "/wechat", strings.NewReader(body))
+	srv.Writer = httptest.NewRecorder()
+	srv.SkipValidate(true)
+	return srv
+}
+
+func registerTestHandlers(srv *Server, dispatched *string) {
+	srv.On(message.MsgTypeText, func(_ context2.Context, msg *message.MixMessage) (*message.Reply, error) {
+		*dispatched = "text"
+		return &message.Reply{MsgType: message.MsgTypeText, MsgData: message.NewText("echo: " + msg.Content)}, nil
+	})
+	srv.OnEvent(message.EventClick, func(_ context2.Context, msg *message.MixMessage) (*message.Reply, error) {
+		*dispatched = "click:" + msg.EventKey
+		return nil, nil
+	})
+	srv.OnDefault(func(_ context2.Context, _ *message.MixMessage) (*message.Reply, error) {
+		*dispatched = "default"
+		return nil, nil
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected string
+	}{
+		{`<xml><MsgType><![CDATA[text]]></MsgType><Content><![CDATA[hello]]></Content></xml>`, "text"},
+		{`<xml><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[CLICK]]></Event><EventKey><![CDATA[menu_1]]></EventKey></xml>`, "click:menu_1"},
+		{`<xml><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[VIEW]]></Event></xml>`, "default"},
+		{`<xml><MsgType><![CDATA[image]]></MsgType></xml>`, "default"},
+	}
+	for _, c := range cases {
+		var dispatched string
+		srv := newTestServer(c.body)
+		registerTestHandlers(srv, &dispatched)
+		reply, err := srv.handleRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, c.expected, dispatched, c.body)
+		if c.expected == "text" {
+			assert.Equal(t, message.CDATA("echo: hello"), reply.MsgData.(*message.Text).Content)
+		}
+	}
+}
+
+func TestDispatchFallbackToMessageHandler(t *testing.T) {
+	srv := newTestServer(`<xml><MsgType><![CDATA[image]]></MsgType></xml>`)
+	srv.On(message.MsgTypeText, func(_ context2.Context, _ *message.MixMessage) (*message.Reply, error) {
+		t.Fatal("text handler should not be called")
+		return nil, nil
+	})
+	var called bool
+	srv.SetMessageHandler(func(_ *message.MixMessage) *message.Reply {
+		called = true
+		return nil
+	})
+	_, err := srv.handleRequest()
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestBuildResponseNewsOverLimit(t *testing.T) {
+	srv := newTestServer("")
+	srv.RequestMsg = &message.MixMessage{}
+	news := message.NewNews([]*message.Article{
+		message.NewArticle("a", "", "", ""),
+		message.NewArticle("b", "", "", ""),
+	})
+	err := srv.buildResponse(&message.Reply{MsgType: message.MsgTypeNews, MsgData: news})
+	assert.EqualError(t, err, "news has 2 articles, exceeds the limit of 1")
+	assert.Nil(t, srv.ResponseRawXMLMsg)
+}
+
+func TestOnPublishResult(t *testing.T) {
+	srv := newTestServer(`<xml><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[PUBLISHJOBFINISH]]></Event><PublishEventInfo><publish_id>2247503051</publish_id><publish_status>4</publish_status><fail_idx>1</fail_idx></PublishEventInfo></xml>`)
+	srv.OnEvent(message.EventPublishJobFinish, func(_ context2.Context, _ *message.MixMessage) (*message.Reply, error) {
+		t.Fatal("event handler should not be called")
+		return nil, nil
+	})
+	// OnPublishResult 注册到同一个事件处理表，后注册的覆盖先注册的
+	var event *message.PublishEvent
+	srv.OnPublishResult(func(_ context2.Context, _ *message.MixMessage, e *message.PublishEvent) (*message.Reply, error) {
+		event = e
+		return nil, nil
+	})
+	_, err := srv.handleRequest()
+	assert.Nil(t, err)
+	if assert.NotNil(t, event) {
+		assert.Equal(t, int64(2247503051), event.PublishID)
+		assert.Equal(t, freepublish.PublishStatusAuditRefused, event.PublishStatus)
+		assert.False(t, event.IsSuccess())
+		assert.Equal(t, []uint{1}, event.FailIndex)
+	}
+}
+
+func TestOnPublishResultOverriddenByOnEvent(t *testing.T) {
+	srv := newTestServer(`<xml><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[PUBLISHJOBFINISH]]></Event><PublishEventInfo><publish_id>2247503051</publish_id><publish_status>0</publish_status></PublishEventInfo></xml>`)
+	srv.OnPublishResult(func(_ context2.Context, _ *message.MixMessage, _ *message.PublishEvent) (*message.Reply, error) {
+		t.Fatal("publish result handler should not be called")
+		return nil, nil
+	})
+	var called bool
+	srv.OnEvent(message.EventPublishJobFinish, func(_ context2.Context, _ *message.MixMessage) (*message.Reply, error) {
+		called = true
+		return nil, errors.New("mock error")
+	})
+	_, err := srv.handleRequest()
+	assert.EqualError(t, err, "mock error")
+	assert.True(t, called)
+}
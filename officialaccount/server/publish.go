@@ -0,0 +1,18 @@
+package server
+
+import (
+	context2 "context"
+
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+// PublishResultHandler 发布任务完成事件的处理方法
+type PublishResultHandler func(ctx context2.Context, msg *message.MixMessage, event *message.PublishEvent) (*message.Reply, error)
+
+// OnPublishResult 设置发布任务完成事件（发布结果推送）的处理方法，等同于 OnEvent(message.EventPublishJobFinish, ...)
+// 成功及失败均会回调，可通过 event.IsSuccess 判断
+func (srv *Server) OnPublishResult(handler PublishResultHandler) {
+	srv.OnEvent(message.EventPublishJobFinish, func(ctx context2.Context, msg *message.MixMessage) (*message.Reply, error) {
+		return handler(ctx, msg, msg.GetPublishEvent())
+	})
+}
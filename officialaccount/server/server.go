@@ -32,6 +32,13 @@ type Server struct {
 
 	messageHandler func(*message.MixMessage) *message.Reply
 
+	subscribeHandler     SubscribeHandler
+	scanSubscribeHandler SubscribeHandler
+
+	msgTypeHandlers map[message.MsgType]MessageHandler
+	eventHandlers   map[message.EventType]MessageHandler
+	defaultHandler  MessageHandler
+
 	RequestRawXMLMsg  []byte
 	RequestMsg        *message.MixMessage
 	ResponseRawXMLMsg []byte
@@ -121,9 +128,16 @@ func (srv *Server) handleRequest() (reply *message.Reply, err error) {
 	mixMessage, success := msg.(*message.MixMessage)
 	if !success {
 		err = errors.New("消息类型转换失败")
+		return
 	}
 	srv.RequestMsg = mixMessage
-	reply = srv.messageHandler(mixMessage)
+	var handled bool
+	if reply, handled, err = srv.dispatch(mixMessage); handled {
+		return
+	}
+	if srv.messageHandler != nil {
+		reply = srv.messageHandler(mixMessage)
+	}
 	return
 }
 
@@ -240,7 +254,17 @@ func (srv *Server) buildResponse(reply *message.Reply) (err error) {
 	case message.MsgTypeVoice:
 	case message.MsgTypeVideo:
 	case message.MsgTypeMusic:
+		if music, ok := reply.MsgData.(*message.Music); ok {
+			if err = music.Validate(); err != nil {
+				return
+			}
+		}
 	case message.MsgTypeNews:
+		if news, ok := reply.MsgData.(*message.News); ok {
+			if err = news.Validate(message.ReplyNewsMaxArticles); err != nil {
+				return
+			}
+		}
 	case message.MsgTypeTransfer:
 	default:
 		err = message.ErrUnsupportReply
@@ -0,0 +1,44 @@
+package server
+
+import (
+	context2 "context"
+
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+// SubscribeHandler 关注/取消关注事件的处理方法
+type SubscribeHandler func(ctx context2.Context, msg *message.MixMessage, event *message.SubscribeEvent) (*message.Reply, error)
+
+// OnSubscribe 设置普通关注事件（非扫码关注）的处理方法，等同于 OnEvent(message.EventSubscribe, ...)
+// 未设置 OnScanSubscribe 时，扫码关注也由该方法处理
+func (srv *Server) OnSubscribe(handler SubscribeHandler) {
+	srv.subscribeHandler = handler
+	srv.OnEvent(message.EventSubscribe, srv.handleSubscribe)
+}
+
+// OnScanSubscribe 设置扫描带参数二维码关注事件的处理方法，等同于 OnEvent(message.EventSubscribe, ...)
+func (srv *Server) OnScanSubscribe(handler SubscribeHandler) {
+	srv.scanSubscribeHandler = handler
+	srv.OnEvent(message.EventSubscribe, srv.handleSubscribe)
+}
+
+// OnUnsubscribe 设置取消关注事件的处理方法，等同于 OnEvent(message.EventUnsubscribe, ...)
+func (srv *Server) OnUnsubscribe(handler SubscribeHandler) {
+	srv.OnEvent(message.EventUnsubscribe, func(ctx context2.Context, msg *message.MixMessage) (*message.Reply, error) {
+		return handler(ctx, msg, msg.GetSubscribeEvent())
+	})
+}
+
+// handleSubscribe 按是否为扫码关注选择 OnSubscribe/OnScanSubscribe 设置的处理方法
+// 对应的处理方法未设置时按未注册事件处理
+func (srv *Server) handleSubscribe(ctx context2.Context, msg *message.MixMessage) (*message.Reply, error) {
+	event := msg.GetSubscribeEvent()
+	handler := srv.subscribeHandler
+	if event.IsScanSubscribe() && srv.scanSubscribeHandler != nil {
+		handler = srv.scanSubscribeHandler
+	}
+	if handler == nil {
+		return srv.fallback(ctx, msg)
+	}
+	return handler(ctx, msg, event)
+}
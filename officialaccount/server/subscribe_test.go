@@ -0,0 +1,97 @@
+package server
+
+import (
+	context2 "context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+func registerSubscribeHandlers(srv *Server, called *string) {
+	srv.OnSubscribe(func(_ context2.Context, _ *message.MixMessage, event *message.SubscribeEvent) (*message.Reply, error) {
+		*called = "subscribe"
+		return &message.Reply{MsgType: message.MsgTypeText, MsgData: message.NewText("welcome " + event.OpenID)}, nil
+	})
+	srv.OnScanSubscribe(func(_ context2.Context, _ *message.MixMessage, event *message.SubscribeEvent) (*message.Reply, error) {
+		*called = "scan_subscribe"
+		return &message.Reply{MsgType: message.MsgTypeText, MsgData: message.NewText("scene " + event.Scene)}, nil
+	})
+	srv.OnUnsubscribe(func(_ context2.Context, _ *message.MixMessage, event *message.SubscribeEvent) (*message.Reply, error) {
+		*called = "unsubscribe:" + event.OpenID
+		return nil, nil
+	})
+}
+
+// TestServeSubscribeHooks 通过 Serve 处理关注/扫码关注/取消关注事件，回复写入响应
+func TestServeSubscribeHooks(t *testing.T) {
+	cases := []struct {
+		body     string
+		called   string
+		response string
+	}{
+		{
+			body:     `<xml><ToUserName><![CDATA[gh_mock]]></ToUserName><FromUserName><![CDATA[mock-openid]]></FromUserName><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[subscribe]]></Event></xml>`,
+			called:   "subscribe",
+			response: "<Content><![CDATA[welcome mock-openid]]></Content>",
+		},
+		{
+			body:     `<xml><ToUserName><![CDATA[gh_mock]]></ToUserName><FromUserName><![CDATA[mock-openid]]></FromUserName><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[subscribe]]></Event><EventKey><![CDATA[qrscene_123]]></EventKey><Ticket><![CDATA[mock-ticket]]></Ticket></xml>`,
+			called:   "scan_subscribe",
+			response: "<Content><![CDATA[scene 123]]></Content>",
+		},
+		{
+			body:     `<xml><ToUserName><![CDATA[gh_mock]]></ToUserName><FromUserName><![CDATA[mock-openid]]></FromUserName><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[unsubscribe]]></Event></xml>`,
+			called:   "unsubscribe:mock-openid",
+			response: "success",
+		},
+	}
+	for _, c := range cases {
+		var called string
+		srv := newTestServer(c.body)
+		registerSubscribeHandlers(srv, &called)
+		if !assert.NoError(t, srv.Serve(), c.called) {
+			continue
+		}
+		assert.NoError(t, srv.Send())
+		assert.Equal(t, c.called, called)
+
+		body := srv.Writer.(*httptest.ResponseRecorder).Body.String()
+		assert.Contains(t, body, c.response)
+		if c.response != "success" {
+			assert.Contains(t, body, "<ToUserName><![CDATA[mock-openid]]></ToUserName>")
+			assert.Contains(t, body, "<FromUserName><![CDATA[gh_mock]]></FromUserName>")
+		}
+	}
+}
+
+// TestServeScanSubscribeFallback 未设置 OnScanSubscribe 时扫码关注由 OnSubscribe 处理
+func TestServeScanSubscribeFallback(t *testing.T) {
+	srv := newTestServer(`<xml><FromUserName><![CDATA[mock-openid]]></FromUserName><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[subscribe]]></Event><EventKey><![CDATA[qrscene_123]]></EventKey></xml>`)
+	var scene string
+	srv.OnSubscribe(func(_ context2.Context, _ *message.MixMessage, event *message.SubscribeEvent) (*message.Reply, error) {
+		scene = event.Scene
+		return nil, nil
+	})
+	assert.NoError(t, srv.Serve())
+	assert.Equal(t, "123", scene)
+	assert.Equal(t, "success", srv.Writer.(*httptest.ResponseRecorder).Body.String())
+}
+
+// TestServeSubscribeWithoutHandler 只设置 OnScanSubscribe 时，普通关注回退到 SetMessageHandler
+func TestServeSubscribeWithoutHandler(t *testing.T) {
+	srv := newTestServer(`<xml><FromUserName><![CDATA[mock-openid]]></FromUserName><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[subscribe]]></Event></xml>`)
+	srv.OnScanSubscribe(func(_ context2.Context, _ *message.MixMessage, _ *message.SubscribeEvent) (*message.Reply, error) {
+		t.Fatal("scan subscribe handler should not be called")
+		return nil, nil
+	})
+	var called bool
+	srv.SetMessageHandler(func(_ *message.MixMessage) *message.Reply {
+		called = true
+		return nil
+	})
+	assert.NoError(t, srv.Serve())
+	assert.True(t, called)
+}
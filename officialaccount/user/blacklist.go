@@ -3,6 +3,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -40,7 +41,7 @@ func (user *User) GetBlackList(beginOpenid ...string) (userlist *OpenidList, err
 	// 调用接口
 	var resp []byte
 	url := fmt.Sprintf(getblacklistURL, accessToken)
-	if resp, err = util.PostJSON(url, &request); err != nil {
+	if resp, err = util.PostJSONContext(util.WithOperation(context.Background(), "user.GetBlackList"), url, &request); err != nil {
 		return nil, err
 	}
 
@@ -89,6 +90,37 @@ func (user *User) BatchUnBlackList(openidList ...string) (err error) {
 	return user.batch(batchunblacklistURL, "BatchUnBlackList", openidList...)
 }
 
+// batchBlackListMaxOpenIDs 拉黑/取消拉黑每次最多传入的 openid 数量
+const batchBlackListMaxOpenIDs = 20
+
+// BatchBlackListReport 拉黑任意数量的用户，自动按每次最多 20 个拆分为多次请求，单次请求失败不会中断后续请求
+// 返回每个 openid 的处理结果汇总，openid 列表校验失败时返回 error
+func (user *User) BatchBlackListReport(openIDList []string) (*util.BatchResult, error) {
+	return batchBlackListReport(openIDList, "BatchBlackListReport", user.BatchBlackList)
+}
+
+// BatchUnBlackListReport 取消拉黑任意数量的用户，自动按每次最多 20 个拆分为多次请求，单次请求失败不会中断后续请求
+// 返回每个 openid 的处理结果汇总，openid 列表校验失败时返回 error
+func (user *User) BatchUnBlackListReport(openIDList []string) (*util.BatchResult, error) {
+	return batchBlackListReport(openIDList, "BatchUnBlackListReport", user.BatchUnBlackList)
+}
+
+// batchBlackListReport 拆分后依次调用 send，同一次请求中的 openid 共享该请求的结果
+func batchBlackListReport(openIDList []string, apiName string, send func(...string) error) (*util.BatchResult, error) {
+	uniqueOpenIDs, err := uniqueOpenIDList(openIDList, apiName)
+	if err != nil {
+		return nil, err
+	}
+	result := new(util.BatchResult)
+	for _, chunk := range util.SliceChunk(uniqueOpenIDs, batchBlackListMaxOpenIDs) {
+		sendErr := send(chunk...)
+		for _, openID := range chunk {
+			result.Add(openID, sendErr)
+		}
+	}
+	return result, nil
+}
+
 // batch 公共方法
 func (user *User) batch(url, apiName string, openidList ...string) (err error) {
 	// 检查参数
@@ -108,7 +140,7 @@ func (user *User) batch(url, apiName string, openidList ...string) (err error) {
 	// 调用接口
 	var resp []byte
 	url = fmt.Sprintf(url, accessToken)
-	if resp, err = util.PostJSON(url, &request); err != nil {
+	if resp, err = util.PostJSONContext(util.WithOperation(context.Background(), "user."+apiName), url, &request); err != nil {
 		return
 	}
 
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestBatchBlackListReport(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchblacklist").
+		BodyString(`"openid-0"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchblacklist").
+		BodyString(`"openid-20"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 49003, "errmsg": "not match openid"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchblacklist").
+		BodyString(`"openid-40"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+
+	// 重复的 openid 只拉黑一次
+	result, err := newTestUser().BatchBlackListReport(append(mockOpenIDs(45), "openid-0"))
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, 45, result.Total)
+	assert.Equal(t, 25, result.Succeeded)
+	if assert.Len(t, result.Failed, 20) {
+		assert.Equal(t, "openid-20", result.Failed[0].Item)
+		assert.Equal(t, "openid-39", result.Failed[19].Item)
+	}
+	// 同一次请求的错误只展示一次
+	assert.Equal(t, 1, strings.Count(result.Err().Error(), "errcode=49003"))
+
+	_, err = newTestUser().BatchUnBlackListReport([]string{"openid-0", ""})
+	assert.EqualError(t, err, "BatchUnBlackListReport error : empty openid at index 1")
+}
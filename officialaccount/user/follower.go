@@ -0,0 +1,37 @@
+package user
+
+import context2 "context"
+
+// DiffFollowers 拉取当前全部关注者，并与调用方保存的上一次快照 previous 比较
+// added 为新增关注的用户，removed 为已取消关注的用户，current 为当前全部关注者，可作为下一次比较的快照
+func (user *User) DiffFollowers(ctx context2.Context, previous []string) (added, removed, current []string, err error) {
+	current, err = user.ListAllUserOpenIDsContext(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	added, removed = diffOpenIDs(previous, current)
+	return added, removed, current, nil
+}
+
+// diffOpenIDs 比较两次快照，结果去重并保持在原列表中的顺序
+func diffOpenIDs(previous, current []string) (added, removed []string) {
+	previousSet := make(map[string]struct{}, len(previous))
+	for _, openID := range previous {
+		previousSet[openID] = struct{}{}
+	}
+	currentSet := make(map[string]struct{}, len(current))
+	for _, openID := range current {
+		currentSet[openID] = struct{}{}
+		if _, ok := previousSet[openID]; !ok {
+			added = append(added, openID)
+			previousSet[openID] = struct{}{}
+		}
+	}
+	for _, openID := range previous {
+		if _, ok := currentSet[openID]; !ok {
+			removed = append(removed, openID)
+			currentSet[openID] = struct{}{}
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestDiffFollowers(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/get").
+		Reply(200).JSON(map[string]interface{}{
+		"total": 4, "count": 2, "next_openid": "openid-c",
+		"data": map[string]interface{}{"openid": []string{"openid-b", "openid-c"}},
+	})
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/get").
+		MatchParam("next_openid", "openid-c").
+		Reply(200).JSON(map[string]interface{}{
+		"total": 4, "count": 2, "next_openid": "openid-e",
+		"data": map[string]interface{}{"openid": []string{"openid-d", "openid-e"}},
+	})
+
+	previous := []string{"openid-a", "openid-b", "openid-c", "openid-f"}
+	added, removed, current, err := newTestUser().DiffFollowers(context2.Background(), previous)
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, []string{"openid-d", "openid-e"}, added)
+	assert.Equal(t, []string{"openid-a", "openid-f"}, removed)
+	assert.Equal(t, []string{"openid-b", "openid-c", "openid-d", "openid-e"}, current)
+}
+
+func TestDiffOpenIDs(t *testing.T) {
+	added, removed := diffOpenIDs(nil, []string{"a", "b", "b"})
+	assert.Equal(t, []string{"a", "b"}, added)
+	assert.Nil(t, removed)
+
+	added, removed = diffOpenIDs([]string{"a", "a", "b"}, []string{"b"})
+	assert.Nil(t, added)
+	assert.Equal(t, []string{"a"}, removed)
+}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	context2 "context"
+	"errors"
+	"time"
+
+	"github.com/silenceper/wechat/v2/internal/openapi"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// ErrMaxPagesReached 已拉取 MaxPages 页但仍有未拉取的用户
+var ErrMaxPagesReached = errors.New("max pages reached before all openids were listed")
+
+// ListAllOptions 拉取全部用户列表的选项
+type ListAllOptions struct {
+	// Interval 两次拉取之间的最小间隔，为 0 时不限速
+	Interval time.Duration
+	// MaxPages 最多拉取的页数（每页最多 10000 个），为 0 时不限制
+	MaxPages int
+}
+
+// ListAllUserOpenIDsWithOptions 按照 opts 限速拉取全部用户 OpenID 列表
+// 接口调用超过限制时按照配置的 QuotaExceededPolicy 进行处理；
+// 达到 MaxPages 仍未拉取完时，返回已拉取的 OpenID 及 ErrMaxPagesReached，可使用 ListUserOpenIDsContext 从最后一个 OpenID 继续拉取
+func (user *User) ListAllUserOpenIDsWithOptions(ctx context2.Context, opts ListAllOptions) ([]string, error) {
+	nextOpenid := ""
+	openids := make([]string, 0)
+	count := 0
+	var last time.Time
+	for page := 0; ; page++ {
+		if opts.MaxPages > 0 && page >= opts.MaxPages {
+			return openids, ErrMaxPagesReached
+		}
+		if opts.Interval > 0 && !last.IsZero() {
+			if err := sleepContext(ctx, opts.Interval-time.Since(last)); err != nil {
+				return nil, err
+			}
+		}
+		last = time.Now()
+
+		var ul *OpenidList
+		err := util.DoWithQuotaPolicy(ctx, user.QuotaExceededPolicy, openapi.NewOpenAPI(user.Context).ClearQuota, func() (listErr error) {
+			ul, listErr = user.ListUserOpenIDsContext(ctx, nextOpenid)
+			return
+		})
+		if err != nil {
+			return nil, err
+		}
+		openids = append(openids, ul.Data.OpenIDs...)
+		count += ul.Count
+		if ul.Total <= count || ul.Count == 0 {
+			return openids, nil
+		}
+		nextOpenid = ul.NextOpenID
+	}
+}
+
+// sleepContext 等待 d，期间响应 ctx 取消
+func sleepContext(ctx context2.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+package user
+
+import (
+	context2 "context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// mockUserListPages 注册 pages 页用户列表，每页 1 个用户，记录每次请求的时间
+func mockUserListPages(pages int, fetchedAt *[]time.Time) {
+	record := func(_ *http.Request, _ *gock.Request) (bool, error) {
+		*fetchedAt = append(*fetchedAt, time.Now())
+		return true, nil
+	}
+	for i := 0; i < pages; i++ {
+		mock := gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/get")
+		if i > 0 {
+			mock.MatchParam("next_openid", "openid-"+string(rune('a'+i-1)))
+		}
+		mock.AddMatcher(record).Reply(200).JSON(map[string]interface{}{
+			"total": pages, "count": 1, "next_openid": "openid-" + string(rune('a'+i)),
+			"data": map[string]interface{}{"openid": []string{"openid-" + string(rune('a'+i))}},
+		})
+	}
+}
+
+func TestListAllUserOpenIDsWithOptionsInterval(t *testing.T) {
+	defer gock.Off()
+	var fetchedAt []time.Time
+	mockUserListPages(3, &fetchedAt)
+
+	const interval = 50 * time.Millisecond
+	openids, err := newTestUser().ListAllUserOpenIDsWithOptions(context2.Background(), ListAllOptions{Interval: interval})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"openid-a", "openid-b", "openid-c"}, openids)
+	assert.True(t, gock.IsDone())
+	if assert.Len(t, fetchedAt, 3) {
+		for i := 1; i < len(fetchedAt); i++ {
+			assert.GreaterOrEqual(t, int64(fetchedAt[i].Sub(fetchedAt[i-1])), int64(interval))
+		}
+	}
+}
+
+func TestListAllUserOpenIDsWithOptionsMaxPages(t *testing.T) {
+	defer gock.Off()
+	var fetchedAt []time.Time
+	mockUserListPages(3, &fetchedAt)
+
+	openids, err := newTestUser().ListAllUserOpenIDsWithOptions(context2.Background(), ListAllOptions{MaxPages: 2})
+	assert.Equal(t, ErrMaxPagesReached, err)
+	assert.Equal(t, []string{"openid-a", "openid-b"}, openids)
+	assert.Len(t, fetchedAt, 2)
+}
+
+func TestListAllUserOpenIDsWithOptionsCanceled(t *testing.T) {
+	defer gock.Off()
+	var fetchedAt []time.Time
+	mockUserListPages(3, &fetchedAt)
+
+	ctx, cancel := context2.WithTimeout(context2.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := newTestUser().ListAllUserOpenIDsWithOptions(ctx, ListAllOptions{Interval: time.Second})
+	assert.Equal(t, context2.DeadlineExceeded, err)
+	assert.Len(t, fetchedAt, 1)
+}
@@ -3,6 +3,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -56,7 +57,7 @@ func (user *User) ListChangeOpenIDs(fromAppID string, openIDs ...string) (list *
 	}
 	req.FromAppID = fromAppID
 	req.OpenidList = append(req.OpenidList, openIDs...)
-	resp, err = util.PostJSON(uri, req)
+	resp, err = util.PostJSONContext(util.WithOperation(context.Background(), "user.ListChangeOpenIDs"), uri, req)
 	if err != nil {
 		return
 	}
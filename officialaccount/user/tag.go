@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -49,7 +50,7 @@ func (user *User) CreateTag(tagName string) (tagInfo *TagInfo, err error) {
 		} `json:"tag"`
 	}
 	request.Tag.Name = tagName
-	response, err = util.PostJSON(uri, &request)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "user.CreateTag"), uri, &request)
 	if err != nil {
 		return
 	}
@@ -81,7 +82,7 @@ func (user *User) DeleteTag(tagID int32) (err error) {
 		} `json:"tag"`
 	}
 	request.Tag.ID = tagID
-	resp, err := util.PostJSON(url, &request)
+	resp, err := util.PostJSONContext(util.WithOperation(context.Background(), "user.DeleteTag"), url, &request)
 	if err != nil {
 		return
 	}
@@ -103,7 +104,7 @@ func (user *User) UpdateTag(tagID int32, tagName string) (err error) {
 	}
 	request.Tag.ID = tagID
 	request.Tag.Name = tagName
-	resp, err := util.PostJSON(url, &request)
+	resp, err := util.PostJSONContext(util.WithOperation(context.Background(), "user.UpdateTag"), url, &request)
 	if err != nil {
 		return
 	}
@@ -117,7 +118,7 @@ func (user *User) GetTag() (tags []*TagInfo, err error) {
 		return nil, err
 	}
 	url := fmt.Sprintf(tagGetURL, accessToken)
-	response, err := util.HTTPGet(url)
+	response, err := util.HTTPGetContext(util.WithOperation(context.Background(), "user.GetTag"), url)
 	if err != nil {
 		return
 	}
@@ -145,7 +146,7 @@ func (user *User) OpenIDListByTag(tagID int32, nextOpenID ...string) (userList *
 	if len(nextOpenID) > 0 {
 		request.OpenID = nextOpenID[0]
 	}
-	response, err := util.PostJSON(url, &request)
+	response, err := util.PostJSONContext(util.WithOperation(context.Background(), "user.OpenIDListByTag"), url, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -171,13 +172,107 @@ func (user *User) BatchTag(openIDList []string, tagID int32) (err error) {
 		TagID:      tagID,
 	}
 	url := fmt.Sprintf(tagBatchtaggingURL, accessToken)
-	resp, err := util.PostJSON(url, &request)
+	resp, err := util.PostJSONContext(util.WithOperation(context.Background(), "user.BatchTag"), url, &request)
 	if err != nil {
 		return
 	}
 	return util.DecodeWithCommonError(resp, "BatchTag")
 }
 
+// batchTagMaxOpenIDs 批量打标签/取消标签每次最多传入的 openid 数量
+const batchTagMaxOpenIDs = 50
+
+// BatchTagRequest 批量打标签/取消标签的单次请求
+type BatchTagRequest struct {
+	OpenIDList []string `json:"openid_list"`
+	TagID      int32    `json:"tagid"`
+}
+
+// PlanBatchTag 校验 openid 列表并去重，按照每次最多 50 个拆分为多次请求
+func PlanBatchTag(openIDList []string, tagID int32) ([]BatchTagRequest, error) {
+	uniqueOpenIDs, err := uniqueOpenIDList(openIDList, "PlanBatchTag")
+	if err != nil {
+		return nil, err
+	}
+	chunks := util.SliceChunk(uniqueOpenIDs, batchTagMaxOpenIDs)
+	plan := make([]BatchTagRequest, 0, len(chunks))
+	for _, chunk := range chunks {
+		plan = append(plan, BatchTagRequest{OpenIDList: chunk, TagID: tagID})
+	}
+	return plan, nil
+}
+
+// uniqueOpenIDList 校验 openid 列表不含空值并去重，保持原有顺序
+func uniqueOpenIDList(openIDList []string, apiName string) ([]string, error) {
+	seen := make(map[string]struct{}, len(openIDList))
+	uniqueOpenIDs := make([]string, 0, len(openIDList))
+	for i, openID := range openIDList {
+		if openID == "" {
+			return nil, fmt.Errorf("%s error : empty openid at index %d", apiName, i)
+		}
+		if _, ok := seen[openID]; ok {
+			continue
+		}
+		seen[openID] = struct{}{}
+		uniqueOpenIDs = append(uniqueOpenIDs, openID)
+	}
+	return uniqueOpenIDs, nil
+}
+
+// BatchTagAll 为任意数量的用户打标签，自动拆分为多次请求
+// dryRun 为 true 时只进行校验及拆分，返回将要发送的请求而不实际调用接口
+func (user *User) BatchTagAll(openIDList []string, tagID int32, dryRun bool) ([]BatchTagRequest, error) {
+	return user.batchTagAll(openIDList, tagID, dryRun, user.BatchTag)
+}
+
+// BatchUntagAll 为任意数量的用户取消标签，自动拆分为多次请求
+// dryRun 为 true 时只进行校验及拆分，返回将要发送的请求而不实际调用接口
+func (user *User) BatchUntagAll(openIDList []string, tagID int32, dryRun bool) ([]BatchTagRequest, error) {
+	return user.batchTagAll(openIDList, tagID, dryRun, user.BatchUntag)
+}
+
+// batchTagAll 按计划依次调用 send，返回已发送（dryRun 时为将要发送）的请求
+func (user *User) batchTagAll(openIDList []string, tagID int32, dryRun bool, send func([]string, int32) error) ([]BatchTagRequest, error) {
+	plan, err := PlanBatchTag(openIDList, tagID)
+	if err != nil || dryRun {
+		return plan, err
+	}
+	for i, request := range plan {
+		if err = send(request.OpenIDList, request.TagID); err != nil {
+			return plan[:i], err
+		}
+	}
+	return plan, nil
+}
+
+// BatchTagReport 为任意数量的用户打标签，自动拆分为多次请求，单次请求失败不会中断后续请求
+// 返回每个 openid 的处理结果汇总，openid 列表校验失败时返回 error
+func (user *User) BatchTagReport(openIDList []string, tagID int32) (*util.BatchResult, error) {
+	return batchTagReport(openIDList, tagID, user.BatchTag)
+}
+
+// BatchUntagReport 为任意数量的用户取消标签，自动拆分为多次请求，单次请求失败不会中断后续请求
+// 返回每个 openid 的处理结果汇总，openid 列表校验失败时返回 error
+func (user *User) BatchUntagReport(openIDList []string, tagID int32) (*util.BatchResult, error) {
+	return batchTagReport(openIDList, tagID, user.BatchUntag)
+}
+
+// batchTagReport 按计划依次调用 send，同一次请求中的 openid 共享该请求的结果
+func batchTagReport(openIDList []string, tagID int32, send func([]string, int32) error) (*util.BatchResult, error) {
+	plan, err := PlanBatchTag(openIDList, tagID)
+	if err != nil {
+		return nil, err
+	}
+	result := new(util.BatchResult)
+	for _, request := range plan {
+		sendErr := send(request.OpenIDList, request.TagID)
+		for _, openID := range request.OpenIDList {
+			result.Add(openID, sendErr)
+		}
+	}
+	return result, nil
+}
+
 // BatchUntag 批量为用户取消标签
 func (user *User) BatchUntag(openIDList []string, tagID int32) (err error) {
 	if len(openIDList) == 0 {
@@ -195,7 +290,7 @@ func (user *User) BatchUntag(openIDList []string, tagID int32) (err error) {
 		OpenIDList: openIDList,
 		TagID:      tagID,
 	}
-	resp, err := util.PostJSON(url, &request)
+	resp, err := util.PostJSONContext(util.WithOperation(context.Background(), "user.BatchUntag"), url, &request)
 	if err != nil {
 		return
 	}
@@ -215,7 +310,7 @@ func (user *User) UserTidList(openID string) (tagIDList []int32, err error) {
 	}{
 		OpenID: openID,
 	}
-	resp, err := util.PostJSON(url, &request)
+	resp, err := util.PostJSONContext(util.WithOperation(context.Background(), "user.UserTidList"), url, &request)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,93 @@
+package user
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type mockAccessTokenHandle struct{}
+
+func (mockAccessTokenHandle) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestUser() *User {
+	return NewUser(&context.Context{
+		Config:            &config.Config{AppID: "mock-appid"},
+		AccessTokenHandle: mockAccessTokenHandle{},
+	})
+}
+
+func mockOpenIDs(n int) []string {
+	openIDs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		openIDs = append(openIDs, fmt.Sprintf("openid-%d", i))
+	}
+	return openIDs
+}
+
+func TestBatchTagAllDryRun(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchtagging").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+
+	// 包含一个重复的 openid
+	openIDs := append(mockOpenIDs(120), "openid-0")
+	plan, err := newTestUser().BatchTagAll(openIDs, 134, true)
+	assert.Nil(t, err)
+	assert.Len(t, plan, 3)
+	assert.Len(t, plan[0].OpenIDList, 50)
+	assert.Len(t, plan[1].OpenIDList, 50)
+	assert.Len(t, plan[2].OpenIDList, 20)
+	assert.Equal(t, "openid-119", plan[2].OpenIDList[19])
+	assert.Equal(t, int32(134), plan[2].TagID)
+	// dry-run 不调用接口
+	assert.Len(t, gock.Pending(), 1)
+
+	_, err = newTestUser().BatchTagAll([]string{"openid-0", ""}, 134, true)
+	assert.NotNil(t, err)
+}
+
+func TestBatchTagAll(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchtagging").Times(2).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+
+	plan, err := newTestUser().BatchTagAll(mockOpenIDs(60), 134, false)
+	assert.Nil(t, err)
+	assert.Len(t, plan, 2)
+	assert.True(t, gock.IsDone())
+}
+
+func TestBatchTagReport(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchtagging").
+		BodyString(`"openid-0"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchtagging").
+		BodyString(`"openid-50"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 45059, "errmsg": "has too many tags"})
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/tags/members/batchtagging").
+		BodyString(`"openid-100"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+
+	result, err := newTestUser().BatchTagReport(mockOpenIDs(110), 134)
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, 110, result.Total)
+	assert.Equal(t, 60, result.Succeeded)
+	if assert.Len(t, result.Failed, 50) {
+		assert.Equal(t, "openid-50", result.Failed[0].Item)
+		assert.Equal(t, "openid-99", result.Failed[49].Item)
+	}
+	assert.Contains(t, result.Err().Error(), "50 of 110 items failed")
+
+	_, err = newTestUser().BatchTagReport([]string{""}, 134)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	context2 "context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// getPaidUnionIDURL 支付后获取 UnionID
+const getPaidUnionIDURL = "https://api.weixin.qq.com/wxa/getpaidunionid"
+
+// resPaidUnionID 支付后获取 UnionID 响应
+type resPaidUnionID struct {
+	util.CommonError
+	UnionID string `json:"unionid"`
+}
+
+// GetPaidUnionID 用户在公众号内完成微信支付后，通过微信支付订单号获取该用户的 UnionID，无需用户授权
+// 仅在支付完成后五分钟内有效，公众号需绑定到微信开放平台帐号
+func (user *User) GetPaidUnionID(openID, transactionID string) (string, error) {
+	return user.GetPaidUnionIDContext(context2.Background(), openID, transactionID)
+}
+
+// GetPaidUnionIDContext 用户支付完成后，通过微信支付订单号获取该用户的 UnionID
+func (user *User) GetPaidUnionIDContext(ctx context2.Context, openID, transactionID string) (string, error) {
+	if openID == "" {
+		return "", errors.New("openid is empty")
+	}
+	if transactionID == "" {
+		return "", errors.New("transaction_id is empty")
+	}
+	accessToken, err := user.GetAccessTokenContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("access_token", accessToken)
+	q.Set("openid", openID)
+	q.Set("transaction_id", transactionID)
+	uri := fmt.Sprintf("%s?%s", getPaidUnionIDURL, q.Encode())
+
+	response, err := util.HTTPGetContext(util.WithOperation(ctx, "user.GetPaidUnionID"), uri)
+	if err != nil {
+		return "", err
+	}
+	var res resPaidUnionID
+	if err = util.DecodeWithError(response, &res, "GetPaidUnionID"); err != nil {
+		return "", err
+	}
+	return res.UnionID, nil
+}
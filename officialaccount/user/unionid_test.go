@@ -0,0 +1,44 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestGetPaidUnionID(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxa/getpaidunionid").
+		MatchParam("openid", "mock-openid").
+		MatchParam("transaction_id", "mock-transaction-id").
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok", "unionid": "mock-unionid"})
+
+	unionID, err := newTestUser().GetPaidUnionID("mock-openid", "mock-transaction-id")
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-unionid", unionID)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetPaidUnionIDNoPermission(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/wxa/getpaidunionid").
+		Reply(200).JSON(map[string]interface{}{"errcode": 89002, "errmsg": "open not exists"})
+
+	unionID, err := newTestUser().GetPaidUnionID("mock-openid", "mock-transaction-id")
+	assert.NotNil(t, err)
+	assert.Empty(t, unionID)
+	var commonErr *util.CommonError
+	if assert.ErrorAs(t, err, &commonErr) {
+		assert.Equal(t, int64(89002), commonErr.ErrCode.Int64())
+	}
+}
+
+func TestGetPaidUnionIDInvalidParams(t *testing.T) {
+	_, err := newTestUser().GetPaidUnionID("", "mock-transaction-id")
+	assert.NotNil(t, err)
+	_, err = newTestUser().GetPaidUnionID("mock-openid", "")
+	assert.NotNil(t, err)
+}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	context2 "context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,6 +30,23 @@ func NewUser(context *context.Context) *User {
 	return user
 }
 
+// 用户关注的渠道来源，对应 Info.SubscribeScene
+const (
+	SubscribeSceneSearch              = "ADD_SCENE_SEARCH"               // 公众号搜索
+	SubscribeSceneAccountMigration    = "ADD_SCENE_ACCOUNT_MIGRATION"    // 公众号迁移
+	SubscribeSceneProfileCard         = "ADD_SCENE_PROFILE_CARD"         // 名片分享
+	SubscribeSceneQRCode              = "ADD_SCENE_QR_CODE"              // 扫描二维码，可通过 QrScene、QrSceneStr 区分二维码
+	SubscribeSceneProfileLink         = "ADD_SCENE_PROFILE_LINK"         // 图文页内名称点击
+	SubscribeSceneProfileItem         = "ADD_SCENE_PROFILE_ITEM"         // 图文页右上角菜单
+	SubscribeScenePaid                = "ADD_SCENE_PAID"                 // 支付后关注
+	SubscribeSceneWechatAdvertisement = "ADD_SCENE_WECHAT_ADVERTISEMENT" // 微信广告
+	SubscribeSceneReprint             = "ADD_SCENE_REPRINT"              // 他人转载
+	SubscribeSceneLivestream          = "ADD_SCENE_LIVESTREAM"           // 视频号直播
+	SubscribeSceneChannels            = "ADD_SCENE_CHANNELS"             // 视频号
+	SubscribeSceneWxa                 = "ADD_SCENE_WXA"                  // 小程序关注
+	SubscribeSceneOthers              = "ADD_SCENE_OTHERS"               // 其他
+)
+
 // Info 用户基本信息
 type Info struct {
 	util.CommonError
@@ -37,23 +55,23 @@ type Info struct {
 
 // 用户基本信息
 type userInfo struct {
-	Subscribe      int32   `json:"subscribe"`
-	OpenID         string  `json:"openid"`
-	Nickname       string  `json:"nickname"`
-	Sex            int32   `json:"sex"`
-	City           string  `json:"city"`
-	Country        string  `json:"country"`
-	Province       string  `json:"province"`
-	Language       string  `json:"language"`
-	Headimgurl     string  `json:"headimgurl"`
-	SubscribeTime  int32   `json:"subscribe_time"`
-	UnionID        string  `json:"unionid"`
-	Remark         string  `json:"remark"`
-	GroupID        int32   `json:"groupid"`
-	TagIDList      []int32 `json:"tagid_list"`
-	SubscribeScene string  `json:"subscribe_scene"`
-	QrScene        int     `json:"qr_scene"`
-	QrSceneStr     string  `json:"qr_scene_str"`
+	Subscribe      util.FlexBool `json:"subscribe"`
+	OpenID         string        `json:"openid"`
+	Nickname       string        `json:"nickname"`
+	Sex            int32         `json:"sex"`
+	City           string        `json:"city"`
+	Country        string        `json:"country"`
+	Province       string        `json:"province"`
+	Language       string        `json:"language"`
+	Headimgurl     string        `json:"headimgurl"`
+	SubscribeTime  int32         `json:"subscribe_time"`
+	UnionID        string        `json:"unionid"`
+	Remark         string        `json:"remark"`
+	GroupID        int32         `json:"groupid"`
+	TagIDList      []int32       `json:"tagid_list"`
+	SubscribeScene string        `json:"subscribe_scene"`
+	QrScene        int           `json:"qr_scene"`
+	QrSceneStr     string        `json:"qr_scene_str"`
 }
 
 // OpenidList 用户列表
@@ -70,15 +88,20 @@ type OpenidList struct {
 
 // GetUserInfo 获取用户基本信息
 func (user *User) GetUserInfo(openID string) (userInfo *Info, err error) {
+	return user.GetUserInfoContext(context2.Background(), openID)
+}
+
+// GetUserInfoContext 获取用户基本信息
+func (user *User) GetUserInfoContext(ctx context2.Context, openID string) (userInfo *Info, err error) {
 	var accessToken string
-	accessToken, err = user.GetAccessToken()
+	accessToken, err = user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf(userInfoURL, accessToken, openID)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = util.HTTPGetContext(util.WithOperation(ctx, "user.GetUserInfo"), uri)
 	if err != nil {
 		return
 	}
@@ -123,7 +146,7 @@ func (user *User) BatchGetUserInfo(params BatchGetUserInfoParams) (*InfoList, er
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", userInfoBatchURL, ak)
-	res, err := util.PostJSON(uri, params)
+	res, err := util.PostJSONContext(util.WithOperation(context2.Background(), "user.BatchGetUserInfo"), uri, params)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +169,8 @@ func (user *User) UpdateRemark(openID, remark string) (err error) {
 
 	uri := fmt.Sprintf(updateRemarkURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, map[string]string{"openid": openID, "remark": remark})
+	response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "user.UpdateRemark"), uri,
+		map[string]string{"openid": openID, "remark": remark})
 	if err != nil {
 		return
 	}
@@ -156,7 +180,16 @@ func (user *User) UpdateRemark(openID, remark string) (err error) {
 
 // ListUserOpenIDs 返回用户列表
 func (user *User) ListUserOpenIDs(nextOpenid ...string) (*OpenidList, error) {
-	accessToken, err := user.GetAccessToken()
+	var next string
+	if len(nextOpenid) > 0 {
+		next = nextOpenid[0]
+	}
+	return user.ListUserOpenIDsContext(context2.Background(), next)
+}
+
+// ListUserOpenIDsContext 返回用户列表，nextOpenid 为空时从头开始拉取
+func (user *User) ListUserOpenIDsContext(ctx context2.Context, nextOpenid string) (*OpenidList, error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -164,12 +197,12 @@ func (user *User) ListUserOpenIDs(nextOpenid ...string) (*OpenidList, error) {
 	uri, _ := url.Parse(userListURL)
 	q := uri.Query()
 	q.Set("access_token", accessToken)
-	if len(nextOpenid) > 0 && nextOpenid[0] != "" {
-		q.Set("next_openid", nextOpenid[0])
+	if nextOpenid != "" {
+		q.Set("next_openid", nextOpenid)
 	}
 	uri.RawQuery = q.Encode()
 
-	response, err := util.HTTPGet(uri.String())
+	response, err := util.HTTPGetContext(util.WithOperation(ctx, "user.ListUserOpenIDs"), uri.String())
 	if err != nil {
 		return nil, err
 	}
@@ -186,20 +219,10 @@ func (user *User) ListUserOpenIDs(nextOpenid ...string) (*OpenidList, error) {
 
 // ListAllUserOpenIDs 返回所有用户OpenID列表
 func (user *User) ListAllUserOpenIDs() ([]string, error) {
-	nextOpenid := ""
-	openids := make([]string, 0)
-	count := 0
-	for {
-		ul, err := user.ListUserOpenIDs(nextOpenid)
-		if err != nil {
-			return nil, err
-		}
-		openids = append(openids, ul.Data.OpenIDs...)
-		count += ul.Count
-		if ul.Total > count {
-			nextOpenid = ul.NextOpenID
-		} else {
-			return openids, nil
-		}
-	}
+	return user.ListAllUserOpenIDsContext(context2.Background())
+}
+
+// ListAllUserOpenIDsContext 返回所有用户OpenID列表
+func (user *User) ListAllUserOpenIDsContext(ctx context2.Context) ([]string, error) {
+	return user.ListAllUserOpenIDsWithOptions(ctx, ListAllOptions{})
 }
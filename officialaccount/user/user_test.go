@@ -0,0 +1,62 @@
+package user
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/credential"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestGetUserInfoOperation(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").
+		MatchParam("openid", "mock-openid").
+		Reply(200).JSON(map[string]interface{}{"subscribe": 1, "openid": "mock-openid"})
+
+	var operations []string
+	util.SetRequestObserver(func(_ context2.Context, info util.RequestInfo) {
+		operations = append(operations, info.Operation)
+	})
+	defer util.SetRequestObserver(nil)
+
+	info, err := newTestUser().GetUserInfo("mock-openid")
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-openid", info.OpenID)
+	assert.Equal(t, []string{"user.GetUserInfo"}, operations)
+}
+
+// TestGetUserInfoAccessTokenOverride 通过 ctx 指定的 access_token 代替客户端默认的 access_token
+func TestGetUserInfoAccessTokenOverride(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").
+		MatchParam("access_token", "override-token").
+		MatchParam("openid", "mock-openid").
+		Reply(200).JSON(map[string]interface{}{"subscribe": 1, "openid": "mock-openid"})
+
+	ctx := credential.WithAccessToken(context2.Background(), "override-token")
+	info, err := newTestUser().GetUserInfoContext(ctx, "mock-openid")
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-openid", info.OpenID)
+	assert.True(t, gock.IsDone())
+}
+
+// TestGetUserInfoSubscribeScene 关注渠道、二维码场景值及标签
+func TestGetUserInfoSubscribeScene(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").
+		MatchParam("openid", "mock-openid").
+		Reply(200).BodyString(`{"subscribe":1,"openid":"mock-openid","subscribe_time":1382694957,"tagid_list":[128,2],` +
+		`"subscribe_scene":"ADD_SCENE_QR_CODE","qr_scene":98765,"qr_scene_str":"campaign-2024"}`)
+
+	info, err := newTestUser().GetUserInfo("mock-openid")
+	assert.NoError(t, err)
+	assert.True(t, bool(info.Subscribe))
+	assert.Equal(t, SubscribeSceneQRCode, info.SubscribeScene)
+	assert.Equal(t, 98765, info.QrScene)
+	assert.Equal(t, "campaign-2024", info.QrSceneStr)
+	assert.Equal(t, []int32{128, 2}, info.TagIDList)
+}
@@ -56,7 +56,7 @@ func (ctx *Context) SetComponentAccessTokenContext(stdCtx context.Context, verif
 		"component_appsecret":     ctx.AppSecret,
 		"component_verify_ticket": verifyTicket,
 	}
-	respBody, err := util.PostJSONContext(stdCtx, componentAccessTokenURL, body)
+	respBody, err := util.PostJSONContext(util.WithOperation(stdCtx, "context.SetComponentAccessTokenContext"), componentAccessTokenURL, body)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +93,7 @@ func (ctx *Context) GetPreCodeContext(stdCtx context.Context) (string, error) {
 		"component_appid": ctx.AppID,
 	}
 	uri := fmt.Sprintf(getPreCodeURL, cat)
-	body, err := util.PostJSONContext(stdCtx, uri, req)
+	body, err := util.PostJSONContext(util.WithOperation(stdCtx, "context.GetPreCodeContext"), uri, req)
 	if err != nil {
 		return "", err
 	}
@@ -188,7 +188,7 @@ func (ctx *Context) QueryAuthCodeContext(stdCtx context.Context, authCode string
 		"authorization_code": authCode,
 	}
 	uri := fmt.Sprintf(queryAuthURL, cat)
-	body, err := util.PostJSONContext(stdCtx, uri, req)
+	body, err := util.PostJSONContext(util.WithOperation(stdCtx, "context.QueryAuthCodeContext"), uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +226,7 @@ func (ctx *Context) RefreshAuthrTokenContext(stdCtx context.Context, appid, refr
 		"authorizer_refresh_token": refreshToken,
 	}
 	uri := fmt.Sprintf(refreshTokenURL, cat)
-	body, err := util.PostJSONContext(stdCtx, uri, req)
+	body, err := util.PostJSONContext(util.WithOperation(stdCtx, "context.RefreshAuthrTokenContext"), uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +339,7 @@ func (ctx *Context) GetAuthrInfoContext(stdCtx context.Context, appid string) (*
 	}
 
 	uri := fmt.Sprintf(getComponentInfoURL, cat)
-	body, err := util.PostJSONContext(stdCtx, uri, req)
+	body, err := util.PostJSONContext(util.WithOperation(stdCtx, "context.GetAuthrInfoContext"), uri, req)
 	if err != nil {
 		return nil, nil, err
 	}
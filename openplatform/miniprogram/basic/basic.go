@@ -1,6 +1,7 @@
 package basic
 
 import (
+	context2 "context"
 	"fmt"
 
 	openContext "github.com/silenceper/wechat/v2/openplatform/context"
@@ -42,7 +43,7 @@ func (basic *Basic) GetAccountBasicInfo() (*AccountBasicInfo, error) {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", getAccountBasicInfoURL, ak)
-	data, err := util.HTTPGet(url)
+	data, err := util.HTTPGetContext(util.WithOperation(context2.Background(), "basic.account/getaccountbasicinfo"), url)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +74,7 @@ func (basic *Basic) CheckNickName(nickname string) (*CheckNickNameResp, error) {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", checkNickNameURL, ak)
-	data, err := util.PostJSON(url, map[string]string{
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "basic.CheckNickName"), url, map[string]string{
 		"nick_name": nickname,
 	})
 	if err != nil {
@@ -118,7 +119,7 @@ func (basic *Basic) SetNickNameFull(param *SetNickNameParam) (*SetNickNameResp,
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", setNickNameURL, ak)
-	data, err := util.PostJSON(url, param)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "basic.SetNickName"), url, param)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +141,7 @@ func (basic *Basic) SetSignature(signature string) error {
 		return err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", setSignatureURL, ak)
-	data, err := util.PostJSON(url, map[string]string{
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "basic.SetSignature"), url, map[string]string{
 		"signature": signature,
 	})
 	if err != nil {
@@ -163,7 +164,7 @@ func (basic *Basic) GetSearchStatus(signature string) (*GetSearchStatusResp, err
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", getSearchStatusURL, ak)
-	data, err := util.HTTPGet(url)
+	data, err := util.HTTPGetContext(util.WithOperation(context2.Background(), "basic.GetSearchStatus"), url)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +187,7 @@ func (basic *Basic) SetSearchStatus(status int) error {
 		return err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", setSearchStatusURL, ak)
-	data, err := util.PostJSON(url, map[string]int{
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "basic.SetSearchStatus"), url, map[string]int{
 		"status": status,
 	})
 	if err != nil {
@@ -229,7 +230,7 @@ func (basic *Basic) SetHeadImageFull(param *SetHeadImageParam) error {
 		return err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", setHeadImageURL, ak)
-	data, err := util.PostJSON(url, param)
+	data, err := util.PostJSONContext(util.WithOperation(context2.Background(), "basic.account/setheadimage"), url, param)
 	if err != nil {
 		return err
 	}
@@ -1,16 +1,13 @@
 package component
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/silenceper/wechat/v2/miniprogram/fastregister"
 	openContext "github.com/silenceper/wechat/v2/openplatform/context"
 	"github.com/silenceper/wechat/v2/util"
 )
 
-const (
-	fastregisterweappURL = "https://api.weixin.qq.com/cgi-bin/component/fastregisterweapp"
-)
-
 // Component 快速创建小程序
 type Component struct {
 	*openContext.Context
@@ -33,17 +30,16 @@ type RegisterMiniProgramParam struct {
 
 // RegisterMiniProgram 快速创建小程
 // reference: https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Fast_Registration_Interface_document.html
+// Deprecated: 使用 fastregister.FastRegister.Register
 func (component *Component) RegisterMiniProgram(param *RegisterMiniProgramParam) error {
-	componentAK, err := component.GetComponentAccessToken()
-	if err != nil {
-		return err
-	}
-	url := fmt.Sprintf(fastregisterweappURL+"?action=create&component_access_token=%s", componentAK)
-	data, err := util.PostJSON(url, param)
-	if err != nil {
-		return err
-	}
-	return util.DecodeWithCommonError(data, "component/fastregisterweapp?action=create")
+	return component.RegisterMiniProgramContext(context.Background(), param)
+}
+
+// RegisterMiniProgramContext 快速创建小程序
+// Deprecated: 使用 fastregister.FastRegister.RegisterContext
+func (component *Component) RegisterMiniProgramContext(ctx context.Context, param *RegisterMiniProgramParam) error {
+	return fastregister.NewFastRegister(component.Context).RegisterContext(ctx, param.Name, param.Code, param.CodeType,
+		param.LegalPersonaWechat, param.LegalPersonaName, param.ComponentPhone)
 }
 
 // GetRegistrationStatusParam 查询任务创建状态
@@ -51,19 +47,24 @@ type GetRegistrationStatusParam struct {
 	Name               string `json:"name"`                 // 企业名
 	LegalPersonaWechat string `json:"legal_persona_wechat"` // 法人微信号
 	LegalPersonaName   string `json:"legal_persona_name"`   // 法人姓名（绑定银行卡）
-
 }
 
 // GetRegistrationStatus 查询创建任务状态.
+// 任务状态通过 errcode 返回，任务创建成功且法人已确认时返回 nil，审核结果通过 notify_third_fasteregister 事件推送
+// Deprecated: 使用 fastregister.FastRegister.SearchStatus
 func (component *Component) GetRegistrationStatus(param *GetRegistrationStatusParam) error {
-	componentAK, err := component.GetComponentAccessToken()
+	return component.GetRegistrationStatusContext(context.Background(), param)
+}
+
+// GetRegistrationStatusContext 查询创建任务状态.
+// Deprecated: 使用 fastregister.FastRegister.SearchStatusContext
+func (component *Component) GetRegistrationStatusContext(ctx context.Context, param *GetRegistrationStatusParam) error {
+	result, err := fastregister.NewFastRegister(component.Context).SearchStatusContext(ctx, param.Name, param.LegalPersonaWechat, param.LegalPersonaName)
 	if err != nil {
 		return err
 	}
-	url := fmt.Sprintf(fastregisterweappURL+"?action=search&component_access_token=%s", componentAK)
-	data, err := util.PostJSON(url, param)
-	if err != nil {
-		return err
+	if result.Status != fastregister.RegisterStatusConfirmed {
+		return util.NewCommonError("component/fastregisterweapp?action=search", result.ErrCode.Int64(), result.ErrMsg)
 	}
-	return util.DecodeWithCommonError(data, "component/fastregisterweapp?action=search")
+	return nil
 }
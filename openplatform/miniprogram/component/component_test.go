@@ -0,0 +1,57 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/openplatform/config"
+	openContext "github.com/silenceper/wechat/v2/openplatform/context"
+)
+
+func newTestComponent(t *testing.T) *Component {
+	memCache := cache.NewMemory()
+	if err := memCache.Set("component_access_token_mock-component-appid", "mock-component-token", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	return NewComponent(&openContext.Context{Config: &config.Config{AppID: "mock-component-appid", Cache: memCache}})
+}
+
+func TestRegisterMiniProgram(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/component/fastregisterweapp").
+		MatchParam("action", "create").
+		MatchParam("component_access_token", "mock-component-token").
+		BodyString(`"name":"mock company","code":"91440300MA5EXAMPLE","code_type":"1"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	err := newTestComponent(t).RegisterMiniProgram(&RegisterMiniProgramParam{
+		Name:               "mock company",
+		Code:               "91440300MA5EXAMPLE",
+		CodeType:           "1",
+		LegalPersonaWechat: "legal_wechat",
+		LegalPersonaName:   "张三",
+		ComponentPhone:     "13800000000",
+	})
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetRegistrationStatus(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/component/fastregisterweapp").
+		MatchParam("action", "search").
+		BodyString(`"legal_persona_wechat":"legal_wechat"`).
+		Reply(200).JSON(map[string]interface{}{"errcode": 89251, "errmsg": "legal person checking"})
+
+	err := newTestComponent(t).GetRegistrationStatus(&GetRegistrationStatusParam{
+		Name:               "mock company",
+		LegalPersonaWechat: "legal_wechat",
+		LegalPersonaName:   "张三",
+	})
+	assert.EqualError(t, err, "component/fastregisterweapp?action=search Error , errcode=89251 , errmsg=legal person checking")
+	assert.True(t, gock.IsDone())
+}
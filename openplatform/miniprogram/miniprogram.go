@@ -8,6 +8,7 @@ import (
 	"github.com/silenceper/wechat/v2/miniprogram"
 	miniConfig "github.com/silenceper/wechat/v2/miniprogram/config"
 	miniContext "github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/miniprogram/fastregister"
 	"github.com/silenceper/wechat/v2/miniprogram/urllink"
 	openContext "github.com/silenceper/wechat/v2/openplatform/context"
 	"github.com/silenceper/wechat/v2/openplatform/miniprogram/basic"
@@ -77,6 +78,11 @@ func (miniProgram *MiniProgram) GetComponent() *component.Component {
 	return component.NewComponent(miniProgram.openContext)
 }
 
+// GetFastRegister 快速注册企业小程序
+func (miniProgram *MiniProgram) GetFastRegister() *fastregister.FastRegister {
+	return fastregister.NewFastRegister(miniProgram.openContext)
+}
+
 // GetBasic 基础信息设置
 func (miniProgram *MiniProgram) GetBasic() *basic.Basic {
 	return basic.NewBasic(miniProgram.openContext, miniProgram.AppID)
@@ -1,10 +1,10 @@
 package oauth
 
 import (
+	ctx2 "context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
 	officialOauth "github.com/silenceper/wechat/v2/officialaccount/oauth"
@@ -14,6 +14,7 @@ import (
 const (
 	platformRedirectOauthURL = "https://open.weixin.qq.com/connect/oauth2/authorize?appid=%s&redirect_uri=%s&response_type=code&scope=%s&state=%s&component_appid=%s#wechat_redirect"
 	platformAccessTokenURL   = "https://api.weixin.qq.com/sns/oauth2/component/access_token?appid=%s&code=%s&grant_type=authorization_code&component_appid=%s&component_access_token=%s"
+	platformRefreshTokenURL  = "https://api.weixin.qq.com/sns/oauth2/component/refresh_token?appid=%s&grant_type=refresh_token&component_appid=%s&component_access_token=%s&refresh_token=%s"
 )
 
 // Oauth 平台代发起oauth2网页授权
@@ -28,10 +29,13 @@ func NewOauth(context *context.Context) *Oauth {
 	return auth
 }
 
-// GetRedirectURL 第三方平台 - 获取跳转的url地址
+// GetRedirectURL 第三方平台 - 获取跳转的url地址，appID 为授权方公众号 appid
+// redirectURI 须为 http 或 https 的绝对地址，已经 url encode 过的地址不会被重复编码
 func (oauth *Oauth) GetRedirectURL(redirectURI, scope, state, appID string) (string, error) {
-	// url encode
-	urlStr := url.QueryEscape(redirectURI)
+	urlStr, err := officialOauth.NormalizeRedirectURI(redirectURI)
+	if err != nil {
+		return "", err
+	}
 	return fmt.Sprintf(platformRedirectOauthURL, appID, urlStr, scope, state, oauth.AppID), nil
 }
 
@@ -47,19 +51,38 @@ func (oauth *Oauth) Redirect(writer http.ResponseWriter, req *http.Request, redi
 
 // GetUserAccessToken 第三方平台 - 通过网页授权的code 换取access_token(区别于context中的access_token)
 func (oauth *Oauth) GetUserAccessToken(code, appID, componentAccessToken string) (result officialOauth.ResAccessToken, err error) {
+	return oauth.GetUserAccessTokenContext(ctx2.Background(), code, appID, componentAccessToken)
+}
+
+// GetUserAccessTokenContext 第三方平台 - 通过网页授权的code 换取access_token(区别于context中的access_token) with context
+func (oauth *Oauth) GetUserAccessTokenContext(ctx ctx2.Context, code, appID, componentAccessToken string) (result officialOauth.ResAccessToken, err error) {
 	urlStr := fmt.Sprintf(platformAccessTokenURL, appID, code, oauth.AppID, componentAccessToken)
-	var response []byte
-	response, err = util.HTTPGet(urlStr)
+	err = oauth.getAccessToken(ctx, urlStr, "GetUserAccessToken", &result)
+	return
+}
+
+// RefreshAccessToken 第三方平台 - 刷新网页授权的access_token
+func (oauth *Oauth) RefreshAccessToken(refreshToken, appID, componentAccessToken string) (result officialOauth.ResAccessToken, err error) {
+	return oauth.RefreshAccessTokenContext(ctx2.Background(), refreshToken, appID, componentAccessToken)
+}
+
+// RefreshAccessTokenContext 第三方平台 - 刷新网页授权的access_token with context
+func (oauth *Oauth) RefreshAccessTokenContext(ctx ctx2.Context, refreshToken, appID, componentAccessToken string) (result officialOauth.ResAccessToken, err error) {
+	urlStr := fmt.Sprintf(platformRefreshTokenURL, appID, oauth.AppID, componentAccessToken, refreshToken)
+	err = oauth.getAccessToken(ctx, urlStr, "RefreshAccessToken", &result)
+	return
+}
+
+func (oauth *Oauth) getAccessToken(ctx ctx2.Context, urlStr, apiName string, result *officialOauth.ResAccessToken) error {
+	response, err := util.HTTPGetContext(util.WithOperation(ctx, "oauth."+apiName), urlStr)
 	if err != nil {
-		return
+		return err
 	}
-	err = json.Unmarshal(response, &result)
-	if err != nil {
-		return
+	if err = json.Unmarshal(response, result); err != nil {
+		return err
 	}
 	if result.ErrCode != 0 {
-		err = fmt.Errorf("GetUserAccessToken error : errcode=%v , errmsg=%v", result.ErrCode, result.ErrMsg)
-		return
+		return fmt.Errorf("%s error : errcode=%v , errmsg=%v", apiName, result.ErrCode, result.ErrMsg)
 	}
-	return
+	return nil
 }
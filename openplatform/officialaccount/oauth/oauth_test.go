@@ -0,0 +1,58 @@
+package oauth
+
+import (
+	ctx2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+func newTestOauth() *Oauth {
+	return NewOauth(&context.Context{Config: &config.Config{AppID: "mock-component-appid"}})
+}
+
+func TestGetRedirectURL(t *testing.T) {
+	location, err := newTestOauth().GetRedirectURL("https://example.com/callback?from=menu", "snsapi_base", "mock-state", "mock-appid")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://open.weixin.qq.com/connect/oauth2/authorize?appid=mock-appid"+
+		"&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback%3Ffrom%3Dmenu&response_type=code&scope=snsapi_base"+
+		"&state=mock-state&component_appid=mock-component-appid#wechat_redirect", location)
+
+	_, err = newTestOauth().GetRedirectURL("/callback", "snsapi_base", "mock-state", "mock-appid")
+	assert.Error(t, err)
+}
+
+func TestGetUserAccessTokenContext(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/sns/oauth2/component/access_token").
+		MatchParam("appid", "mock-appid").
+		MatchParam("code", "mock-code").
+		MatchParam("grant_type", "authorization_code").
+		MatchParam("component_appid", "mock-component-appid").
+		MatchParam("component_access_token", "mock-component-access-token").
+		Reply(200).JSON(map[string]interface{}{
+		"access_token":  "mock-access-token",
+		"expires_in":    7200,
+		"refresh_token": "mock-refresh-token",
+		"openid":        "mock-openid",
+		"scope":         "snsapi_base",
+	})
+	gock.New("https://api.weixin.qq.com").Get("/sns/oauth2/component/refresh_token").
+		MatchParam("refresh_token", "mock-refresh-token").
+		MatchParam("component_appid", "mock-component-appid").
+		Reply(200).JSON(map[string]interface{}{"errcode": 40030, "errmsg": "invalid refresh_token"})
+
+	oauth := newTestOauth()
+	result, err := oauth.GetUserAccessTokenContext(ctx2.Background(), "mock-code", "mock-appid", "mock-component-access-token")
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-access-token", result.AccessToken)
+	assert.Equal(t, "mock-openid", result.OpenID)
+
+	_, err = oauth.RefreshAccessTokenContext(ctx2.Background(), result.RefreshToken, "mock-appid", "mock-component-access-token")
+	assert.EqualError(t, err, "RefreshAccessToken error : errcode=40030 , errmsg=invalid refresh_token")
+	assert.True(t, gock.IsDone())
+}
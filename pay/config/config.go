@@ -1,9 +1,37 @@
 package config
 
+import (
+	"crypto/tls"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
 // Config .config for pay
 type Config struct {
 	AppID     string `json:"app_id"`
 	MchID     string `json:"mch_id"`
 	Key       string `json:"key"`
 	NotifyURL string `json:"notify_url"`
+
+	// APIv3 相关配置
+	APIv3Key   string `json:"api_v3_key"`  // APIv3 密钥
+	SerialNo   string `json:"serial_no"`   // 商户 API 证书序列号
+	PrivateKey string `json:"private_key"` // 商户 API 证书私钥（PEM 格式）
+
+	// 微信支付公钥，配置后无需下载平台证书即可验证应答及回调签名
+	PublicKeyID string `json:"public_key_id"` // 微信支付公钥 ID，如 PUB_KEY_ID_0114232134912410000000000000
+	PublicKey   string `json:"public_key"`    // 微信支付公钥（PEM 格式）
+
+	// ClientCertificate 商户 API 证书，用于退款、付款、红包等需要双向 TLS 认证的接口，
+	// 可通过 tls.LoadX509KeyPair("apiclient_cert.pem", "apiclient_key.pem") 加载，配置后优先于调用参数中的 RootCa
+	ClientCertificate *tls.Certificate `json:"-"`
+}
+
+// PostXMLWithTLS 使用商户 API 证书发起双向 TLS 认证的 XML POST 请求，
+// 未配置 ClientCertificate 时使用 p12 格式的 rootCa 证书文件，证书密码为商户号
+func (cfg *Config) PostXMLWithTLS(uri string, obj interface{}, rootCa string) ([]byte, error) {
+	if cfg.ClientCertificate != nil {
+		return util.PostXMLWithCertificate(uri, obj, *cfg.ClientCertificate)
+	}
+	return util.PostXMLWithTLS(uri, obj, rootCa, cfg.MchID)
 }
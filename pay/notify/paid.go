@@ -1,12 +1,9 @@
 package notify
 
 import (
-	"fmt"
 	"reflect"
-	"sort"
 	"strings"
 
-	"github.com/fatih/structs"
 	"github.com/spf13/cast"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -66,45 +63,38 @@ type PaidResp struct {
 
 // PaidVerifySign 支付成功结果验签
 func (notify *Notify) PaidVerifySign(notifyRes PaidResult) bool {
-	// STEP1, 转换 struct 为 map，并对 map keys 做排序
-	resMap := structs.Map(notifyRes)
-
-	sortedKeys := make([]string, 0, len(resMap))
-	for k := range resMap {
-		sortedKeys = append(sortedKeys, k)
-	}
-	sort.Strings(sortedKeys)
-
-	// STEP2, 对key=value的键值对用&连接起来，略过空值 & sign
-	var signStrings string
-	for _, k := range sortedKeys {
-		value := fmt.Sprintf("%v", cast.ToString(resMap[k]))
-		if value != "" && strings.ToLower(k) != "sign" {
-			signStrings = signStrings + getTagKeyName(k, &notifyRes) + "=" + value + "&"
-		}
+	if notifyRes.Sign == nil {
+		return false
 	}
 
-	// STEP3, 在键值对的最后加上key=API_KEY
-	signStrings = signStrings + "key=" + notify.Key
-
-	// STEP4, 根据SignType计算出签名
+	// 按 xml 字段名生成待签名参数，签名串由 util.OrderParam 按 key 排序生成，与结构体字段顺序无关
 	var signType string
 	if notifyRes.SignType != nil {
 		signType = *notifyRes.SignType
 	}
-	sign, err := util.CalculateSign(signStrings, signType, notify.Key)
+	signStr := util.OrderParam(paidSignParams(&notifyRes), "&key="+notify.Key)
+	sign, err := util.CalculateSign(signStr, signType, notify.Key)
 	if err != nil {
 		return false
 	}
-	if sign != *notifyRes.Sign {
-		return false
-	}
-	return true
+	return sign == *notifyRes.Sign
 }
 
-func getTagKeyName(key string, notifyRes *PaidResult) string {
-	s := reflect.TypeOf(notifyRes).Elem()
-	f, _ := s.FieldByName(key)
-	name := f.Tag.Get("xml")
-	return name
+// paidSignParams 将回调结果转换为以 xml 字段名为 key 的参数，忽略未设置的字段
+func paidSignParams(notifyRes *PaidResult) map[string]string {
+	v := reflect.ValueOf(notifyRes).Elem()
+	t := v.Type()
+	params := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Ptr || field.IsNil() {
+			continue
+		}
+		name := strings.Split(t.Field(i).Tag.Get("xml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		params[name] = cast.ToString(field.Elem().Interface())
+	}
+	return params
 }
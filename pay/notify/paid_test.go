@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/pay/config"
+)
+
+func TestPaidVerifySign(t *testing.T) {
+	notify := &Notify{Config: &config.Config{Key: "192006250b4c09247ec02edce69f6a2d"}}
+	str := func(s string) *string { return &s }
+
+	// 结构体字段顺序与签名顺序不同，签名须按 xml 字段名排序计算
+	res := PaidResult{
+		NonceStr:   str("ibuaiVcKdpRxkhJA"),
+		MchID:      str("10000100"),
+		DeviceInfo: str("1000"),
+		AppID:      str("wxd930ea5d5a258f4f"),
+		Attach:     str("test"),
+		Sign:       str("A3A3F5C2B5E4E1A9B5E0EC68D0B1E7B6"),
+	}
+	assert.Equal(t, map[string]string{
+		"appid":       "wxd930ea5d5a258f4f",
+		"attach":      "test",
+		"device_info": "1000",
+		"mch_id":      "10000100",
+		"nonce_str":   "ibuaiVcKdpRxkhJA",
+		"sign":        "A3A3F5C2B5E4E1A9B5E0EC68D0B1E7B6",
+	}, paidSignParams(&res))
+	assert.False(t, notify.PaidVerifySign(res))
+
+	// appid=wxd930ea5d5a258f4f&attach=test&device_info=1000&mch_id=10000100&nonce_str=ibuaiVcKdpRxkhJA&key=...
+	res.Sign = str("CCB547DCD0A616D7213F37422293A5BF")
+	assert.True(t, notify.PaidVerifySign(res))
+
+	res.Sign = nil
+	assert.False(t, notify.PaidVerifySign(res))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/silenceper/wechat/v2/pay/redpacket"
 	"github.com/silenceper/wechat/v2/pay/refund"
 	"github.com/silenceper/wechat/v2/pay/transfer"
+	v3 "github.com/silenceper/wechat/v2/pay/v3"
 )
 
 // Pay 微信支付相关API
@@ -43,3 +44,8 @@ func (pay *Pay) GetTransfer() *transfer.Transfer {
 func (pay *Pay) GetRedpacket() *redpacket.Redpacket {
 	return redpacket.NewRedpacket(pay.cfg)
 }
+
+// GetV3 APIv3 客户端
+func (pay *Pay) GetV3() (*v3.Client, error) {
+	return v3.NewClient(pay.cfg)
+}
@@ -110,7 +110,7 @@ func (redpacket *Redpacket) SendRedpacket(p *Params) (rsp *Response, err error)
 		Remark:      p.Remark,
 	}
 
-	rawRet, err := util.PostXMLWithTLS(redpacketGateway, req, p.RootCa, redpacket.MchID)
+	rawRet, err := redpacket.PostXMLWithTLS(redpacketGateway, req, p.RootCa)
 	if err != nil {
 		return
 	}
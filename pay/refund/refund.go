@@ -102,7 +102,7 @@ func (refund *Refund) Refund(p *Params) (rsp Response, err error) {
 		req.TransactionID = p.TransactionID
 	}
 
-	rawRet, err := util.PostXMLWithTLS(refundGateway, req, p.RootCa, refund.MchID)
+	rawRet, err := refund.PostXMLWithTLS(refundGateway, req, p.RootCa)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,67 @@
+package refund
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/pay/config"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// newClientCertificate 生成自签名的商户 API 证书
+func newClientCertificate(t *testing.T, commonName string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestRefundClientCertificate(t *testing.T) {
+	var peerCommonName string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) > 0 {
+			peerCommonName = r.TLS.PeerCertificates[0].Subject.CommonName
+		}
+		_, _ = w.Write([]byte(`<xml><return_code>SUCCESS</return_code><result_code>SUCCESS</result_code>` +
+			`<refund_id>mock-refund-id</refund_id></xml>`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	// 信任测试服务的证书
+	util.SetHTTPClient(server.Client())
+	defer util.SetHTTPClient(nil)
+	defer func(gateway string) { refundGateway = gateway }(refundGateway)
+	refundGateway = server.URL
+
+	cert := newClientCertificate(t, "mock-mch-id")
+	refund := NewRefund(&config.Config{AppID: "mock-appid", MchID: "mock-mch-id", Key: "mock-key", ClientCertificate: &cert})
+	rsp, err := refund.Refund(&Params{OutTradeNo: "mock-trade-no", OutRefundNo: "mock-refund-no", TotalFee: "100", RefundFee: "100"})
+	assert.NoError(t, err)
+	assert.Equal(t, "mock-refund-id", rsp.RefundID)
+	assert.Equal(t, "mock-mch-id", peerCommonName)
+
+	// 未配置客户端证书时无法通过双向认证
+	_, err = util.PostXMLWithCertificate(server.URL, struct{}{}, tls.Certificate{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,40 @@
+package v3
+
+import (
+	"fmt"
+)
+
+// CurrencyCNY 人民币，境内商户号仅支持人民币
+const CurrencyCNY = "CNY"
+
+// AllowedCurrencies 允许的货币类型，境外商户可按需添加
+var AllowedCurrencies = map[string]bool{
+	CurrencyCNY: true,
+	"HKD":       true,
+	"USD":       true,
+	"EUR":       true,
+	"GBP":       true,
+	"JPY":       true,
+}
+
+// Amount 订单、退款、转账等接口中的金额
+type Amount struct {
+	Total    int64  `json:"total"`              // 金额，单位为分
+	Currency string `json:"currency,omitempty"` // 货币类型，为空时默认为 CNY
+}
+
+// NewAmount 以人民币创建金额，total 单位为分
+func NewAmount(total int64) Amount {
+	return Amount{Total: total, Currency: CurrencyCNY}
+}
+
+// Validate 校验金额不为负数且货币类型在允许范围内
+func (amount Amount) Validate() error {
+	if amount.Total < 0 {
+		return fmt.Errorf("invalid amount: total must be >= 0, got %d", amount.Total)
+	}
+	if amount.Currency != "" && !AllowedCurrencies[amount.Currency] {
+		return fmt.Errorf("invalid amount: unsupported currency %q", amount.Currency)
+	}
+	return nil
+}
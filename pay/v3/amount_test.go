@@ -0,0 +1,38 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmountValidate(t *testing.T) {
+	amount := NewAmount(100)
+	assert.Nil(t, amount.Validate())
+	data, err := json.Marshal(amount)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"total":100,"currency":"CNY"}`, string(data))
+
+	assert.Nil(t, Amount{Total: 0}.Validate())
+	assert.NotNil(t, Amount{Total: -1, Currency: CurrencyCNY}.Validate())
+	assert.NotNil(t, Amount{Total: 100, Currency: "XYZ"}.Validate())
+}
+
+type mockRefundRequest struct {
+	OutRefundNo string `json:"out_refund_no"`
+	Amount      Amount `json:"amount"`
+}
+
+func (req *mockRefundRequest) Validate() error {
+	return req.Amount.Validate()
+}
+
+func TestDoValidatesBeforeSigning(t *testing.T) {
+	// 校验失败时不发起请求
+	err := newTestClient(t).Do(context.Background(), http.MethodPost, "/v3/refund/domestic/refunds",
+		&mockRefundRequest{OutRefundNo: "1217752501201407033233368018", Amount: NewAmount(-100)}, nil)
+	assert.EqualError(t, err, "invalid amount: total must be >= 0, got -100")
+}
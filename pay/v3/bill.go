@@ -0,0 +1,143 @@
+package v3
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TradeBillType 交易账单类型
+type TradeBillType string
+
+const (
+	// TradeBillTypeAll 返回当日所有订单信息（不含充值退款订单）
+	TradeBillTypeAll TradeBillType = "ALL"
+	// TradeBillTypeSuccess 返回当日成功支付的订单（不含充值退款订单）
+	TradeBillTypeSuccess TradeBillType = "SUCCESS"
+	// TradeBillTypeRefund 返回当日退款订单（不含充值退款订单）
+	TradeBillTypeRefund TradeBillType = "REFUND"
+)
+
+// ErrBillHashMismatch 下载的账单文件摘要与申请账单时返回的 hash_value 不一致
+var ErrBillHashMismatch = errors.New("bill hash mismatch")
+
+// BillDownload 申请账单的应答，download_url 有效期为 30s
+type BillDownload struct {
+	HashType    string `json:"hash_type"`    // 原始账单（gzip 需要解压缩）的摘要算法，目前仅支持 SHA1
+	HashValue   string `json:"hash_value"`   // 原始账单（gzip 需要解压缩）的摘要值
+	DownloadURL string `json:"download_url"` // 账单下载地址
+}
+
+// GetTradeBill 申请交易账单，billDate 格式为 yyyy-MM-dd，账单以 gzip 压缩格式下载
+func (client *Client) GetTradeBill(ctx context.Context, billDate string, billType TradeBillType) (*BillDownload, error) {
+	query := url.Values{}
+	query.Set("bill_date", billDate)
+	if billType != "" {
+		query.Set("bill_type", string(billType))
+	}
+	query.Set("tar_type", "GZIP")
+	var res BillDownload
+	if err := client.Do(ctx, http.MethodGet, "/v3/bill/tradebill?"+query.Encode(), nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DownloadTradeBill 申请并下载交易账单，返回解压后的完整账单内容
+// 账单较大时使用 StreamTradeBill 避免将整个文件读入内存
+func (client *Client) DownloadTradeBill(ctx context.Context, billDate string, billType TradeBillType) ([]byte, error) {
+	reader, err := client.StreamTradeBill(ctx, billDate, billType)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// StreamTradeBill 申请并下载交易账单，返回解压后的账单内容流，不会将整个文件读入内存
+// 读取时同步计算摘要，读到 EOF 时与 hash_value 比较，不一致时返回 ErrBillHashMismatch
+// 调用方需读取至 EOF 才能确认账单完整，并在使用后调用 Close
+func (client *Client) StreamTradeBill(ctx context.Context, billDate string, billType TradeBillType) (io.ReadCloser, error) {
+	bill, err := client.GetTradeBill(ctx, billDate, billType)
+	if err != nil {
+		return nil, err
+	}
+	return client.StreamBill(ctx, bill)
+}
+
+// StreamBill 下载 GetTradeBill 等申请账单接口返回的账单，返回解压后的账单内容流，校验方式同 StreamTradeBill
+func (client *Client) StreamBill(ctx context.Context, bill *BillDownload) (io.ReadCloser, error) {
+	var h hash.Hash
+	switch strings.ToUpper(bill.HashType) {
+	case "SHA1":
+		h = sha1.New()
+	default:
+		return nil, fmt.Errorf("unsupported bill hash_type %q", bill.HashType)
+	}
+	downloadURL, err := url.Parse(bill.DownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	request, err := client.newRequest(ctx, http.MethodGet, bill.DownloadURL, downloadURL.RequestURI(), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.getHTTPClient().Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		defer response.Body.Close()
+		data, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, newPayError(response.StatusCode, response.Header.Get("Request-ID"), data)
+	}
+	gzipReader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+	return &billReader{
+		gzip:     gzipReader,
+		body:     response.Body,
+		hash:     h,
+		expected: strings.ToLower(bill.HashValue),
+	}, nil
+}
+
+// billReader 解压账单并在读取时计算摘要，读到 EOF 时校验
+type billReader struct {
+	gzip     *gzip.Reader
+	body     io.ReadCloser
+	hash     hash.Hash
+	expected string
+}
+
+func (r *billReader) Read(p []byte) (int, error) {
+	n, err := r.gzip.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); actual != r.expected {
+			return n, fmt.Errorf("%w: expected %s, got %s", ErrBillHashMismatch, r.expected, actual)
+		}
+	}
+	return n, err
+}
+
+func (r *billReader) Close() error {
+	gzipErr := r.gzip.Close()
+	if err := r.body.Close(); err != nil {
+		return err
+	}
+	return gzipErr
+}
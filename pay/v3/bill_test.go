@@ -0,0 +1,106 @@
+package v3
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// newTestBill 生成约 8MB 的账单，返回原始内容及 gzip 压缩后的内容
+func newTestBill(t *testing.T) (raw, compressed []byte) {
+	var buf bytes.Buffer
+	buf.WriteString("交易时间,公众账号ID,商户号,微信订单号,商户订单号,订单金额\n")
+	for i := 0; buf.Len() < 8<<20; i++ {
+		fmt.Fprintf(&buf, "`2024-01-01 10:00:00,`wx8888888888888888,`1900009191,`42000000012024010100%08d,`order%08d,`%d.%02d\n",
+			i, i, i%1000, i%100)
+	}
+	raw = buf.Bytes()
+	var gz bytes.Buffer
+	writer := gzip.NewWriter(&gz)
+	if _, err := writer.Write(raw); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return raw, gz.Bytes()
+}
+
+func mockTradeBill(hashValue string, compressed []byte) {
+	gock.New(baseURL).Get("/v3/bill/tradebill").
+		MatchParam("bill_date", "2024-01-01").
+		MatchParam("bill_type", "ALL").
+		MatchParam("tar_type", "GZIP").
+		Reply(http.StatusOK).JSON(map[string]string{
+		"hash_type":    "SHA1",
+		"hash_value":   hashValue,
+		"download_url": baseURL + "/v3/billdownload/file?token=mock-token",
+	})
+	gock.New(baseURL).Get("/v3/billdownload/file").
+		MatchParam("token", "mock-token").
+		MatchHeader("Authorization", `^WECHATPAY2-SHA256-RSA2048 mchid="1900009191"`).
+		Reply(http.StatusOK).Body(bytes.NewReader(compressed))
+}
+
+// TestStreamTradeBill 流式读取大账单，读到 EOF 时校验摘要
+func TestStreamTradeBill(t *testing.T) {
+	defer gock.Off()
+	raw, compressed := newTestBill(t)
+	sum := sha1.Sum(raw)
+	mockTradeBill(hex.EncodeToString(sum[:]), compressed)
+
+	reader, err := newTestClient(t).StreamTradeBill(context.Background(), "2024-01-01", TradeBillTypeAll)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer reader.Close()
+
+	// 以小块读取，同步计算摘要
+	h := sha1.New()
+	n, err := io.CopyBuffer(h, reader, make([]byte, 32<<10))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(raw)), n)
+	assert.Equal(t, sum[:], h.Sum(nil))
+	assert.True(t, gock.IsDone())
+}
+
+// TestStreamTradeBillHashMismatch 摘要不一致时在 EOF 返回 ErrBillHashMismatch，此前的内容正常返回
+func TestStreamTradeBillHashMismatch(t *testing.T) {
+	defer gock.Off()
+	raw, compressed := newTestBill(t)
+	mockTradeBill("0000000000000000000000000000000000000000", compressed)
+
+	reader, err := newTestClient(t).StreamTradeBill(context.Background(), "2024-01-01", TradeBillTypeAll)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer reader.Close()
+
+	n, err := io.Copy(io.Discard, reader)
+	assert.True(t, errors.Is(err, ErrBillHashMismatch))
+	assert.Equal(t, int64(len(raw)), n)
+}
+
+// TestDownloadTradeBillPayError 下载失败时返回 *PayError
+func TestDownloadTradeBillPayError(t *testing.T) {
+	defer gock.Off()
+	gock.New(baseURL).Get("/v3/bill/tradebill").
+		Reply(http.StatusBadRequest).
+		JSON(map[string]string{"code": "NO_STATEMENT_EXIST", "message": "账单文件不存在"})
+
+	_, err := newTestClient(t).DownloadTradeBill(context.Background(), "2024-01-01", TradeBillTypeAll)
+	var payErr *PayError
+	if assert.True(t, errors.As(err, &payErr)) {
+		assert.Equal(t, "NO_STATEMENT_EXIST", payErr.Code)
+	}
+}
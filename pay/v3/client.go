@@ -0,0 +1,56 @@
+// Package v3 微信支付 APIv3
+package v3
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/silenceper/wechat/v2/pay/config"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// Client 微信支付 APIv3 客户端
+type Client struct {
+	*config.Config
+	privateKey *rsa.PrivateKey
+
+	platformKeys     map[string]*rsa.PublicKey
+	platformKeysLock sync.RWMutex
+
+	notifyIDs     *replayGuard
+	timestampSkew time.Duration
+
+	httpClient     *http.Client
+	acceptLanguage string
+}
+
+// NewClient 实例化 APIv3 客户端
+func NewClient(cfg *config.Config) (*Client, error) {
+	if cfg.MchID == "" || cfg.SerialNo == "" {
+		return nil, errors.New("mch_id and serial_no are required")
+	}
+	privateKey, err := util.ParseRSAPrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{
+		Config:     cfg,
+		privateKey: privateKey,
+		notifyIDs:  newReplayGuard(notifyReplayWindow),
+	}
+	if cfg.PublicKey != "" {
+		if err = client.AddWechatPayPublicKey(cfg.PublicKeyID, cfg.PublicKey); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// String 输出客户端信息，不包含任何密钥
+func (client *Client) String() string {
+	return fmt.Sprintf("v3.Client{MchID: %s, SerialNo: %s, PrivateKey: [REDACTED]}", client.MchID, client.SerialNo)
+}
@@ -0,0 +1,46 @@
+package v3
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PayError 微信支付 APIv3 返回的错误
+// see https://pay.weixin.qq.com/wiki/doc/apiv3/wechatpay/wechatpay2_0.shtml
+type PayError struct {
+	StatusCode int             `json:"-"`                // HTTP 状态码
+	RequestID  string          `json:"-"`                // 应答头 Request-ID，联系微信支付排查问题时需提供
+	Code       string          `json:"code"`             // 错误码，如 PARAM_ERROR
+	Message    string          `json:"message"`          // 错误描述
+	Detail     *PayErrorDetail `json:"detail,omitempty"` // 错误详情，参数校验失败时返回
+}
+
+// PayErrorDetail 错误详情，指出校验失败的字段
+type PayErrorDetail struct {
+	Field    string      `json:"field"`    // 指示错误参数的位置，如 /amount/currency
+	Value    interface{} `json:"value"`    // 错误的值
+	Issue    string      `json:"issue"`    // 具体错误原因
+	Location string      `json:"location"` // 错误参数所在位置，如 body、query、path
+}
+
+func (e *PayError) Error() string {
+	msg := fmt.Sprintf("pay v3 error : status=%d , code=%s , message=%s", e.StatusCode, e.Code, e.Message)
+	if e.Detail != nil {
+		msg += fmt.Sprintf(" , field=%s , issue=%s", e.Detail.Field, e.Detail.Issue)
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" , request_id=%s", e.RequestID)
+	}
+	return msg
+}
+
+// newPayError 解析非 2xx 应答，应答内容不是错误 json 时保留原始内容作为 Message
+func newPayError(statusCode int, requestID string, body []byte) *PayError {
+	payErr := &PayError{}
+	if err := json.Unmarshal(body, payErr); err != nil || payErr.Code == "" {
+		payErr = &PayError{Message: string(body)}
+	}
+	payErr.StatusCode = statusCode
+	payErr.RequestID = requestID
+	return payErr
+}
@@ -0,0 +1,113 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestDoPayError(t *testing.T) {
+	defer gock.Off()
+	gock.New(baseURL).Post("/v3/pay/transactions/jsapi").
+		MatchHeader("Authorization", `^WECHATPAY2-SHA256-RSA2048 mchid="`).
+		Reply(http.StatusBadRequest).
+		SetHeader("Request-ID", "08F78BB5AF0610D302A1D5A8A70A").
+		BodyString(`{
+			"code": "PARAM_ERROR",
+			"message": "参数错误",
+			"detail": {
+				"field": "/amount/currency",
+				"value": "XYZ",
+				"issue": "Currency code is invalid",
+				"location": "body"
+			}
+		}`)
+
+	client := newTestClient(t)
+	err := client.Do(context.Background(), http.MethodPost, "/v3/pay/transactions/jsapi", map[string]string{"appid": "mock-appid"}, nil)
+	var payErr *PayError
+	if assert.True(t, errors.As(err, &payErr)) {
+		assert.Equal(t, http.StatusBadRequest, payErr.StatusCode)
+		assert.Equal(t, "08F78BB5AF0610D302A1D5A8A70A", payErr.RequestID)
+		assert.Equal(t, "PARAM_ERROR", payErr.Code)
+		assert.Equal(t, "参数错误", payErr.Message)
+		if assert.NotNil(t, payErr.Detail) {
+			assert.Equal(t, "/amount/currency", payErr.Detail.Field)
+			assert.Equal(t, "XYZ", payErr.Detail.Value)
+			assert.Equal(t, "Currency code is invalid", payErr.Detail.Issue)
+			assert.Equal(t, "body", payErr.Detail.Location)
+		}
+		assert.True(t, strings.Contains(payErr.Error(), "field=/amount/currency"))
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestDoSuccess(t *testing.T) {
+	defer gock.Off()
+	gock.New(baseURL).Get("/v3/pay/transactions/out-trade-no/1217752501201407033233368018").
+		MatchParam("mchid", "1230000109").
+		Reply(http.StatusOK).JSON(map[string]interface{}{"out_trade_no": "1217752501201407033233368018", "trade_state": "SUCCESS"})
+
+	var transaction Transaction
+	err := newTestClient(t).Do(context.Background(), http.MethodGet,
+		"/v3/pay/transactions/out-trade-no/1217752501201407033233368018?mchid=1230000109", nil, &transaction)
+	assert.Nil(t, err)
+	assert.Equal(t, "SUCCESS", transaction.TradeState)
+}
+
+func TestNewPayErrorWithoutJSON(t *testing.T) {
+	payErr := newPayError(http.StatusBadGateway, "", []byte("bad gateway"))
+	assert.Equal(t, http.StatusBadGateway, payErr.StatusCode)
+	assert.Equal(t, "bad gateway", payErr.Message)
+	assert.Nil(t, payErr.Detail)
+}
+
+func TestDoHeaders(t *testing.T) {
+	defer gock.Off()
+	gock.New(baseURL).Get("/v3/certificates").
+		MatchHeader("User-Agent", `^wechat-go/v2 `).
+		MatchHeader("Accept-Language", "^zh-CN$").
+		Reply(http.StatusOK).JSON(map[string]interface{}{"data": []interface{}{}})
+	gock.New(baseURL).Get("/v3/certificates").
+		MatchHeader("Accept-Language", "^en$").
+		Reply(http.StatusOK).JSON(map[string]interface{}{"data": []interface{}{}})
+
+	client := newTestClient(t)
+	assert.Nil(t, client.Do(context.Background(), http.MethodGet, "/v3/certificates", nil, nil))
+	client.SetAcceptLanguage("en")
+	assert.Nil(t, client.Do(context.Background(), http.MethodGet, "/v3/certificates", nil, nil))
+	assert.True(t, gock.IsDone())
+}
+
+// roundTripFunc 记录请求的 http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return fn(request)
+}
+
+// TestDoUsesGlobalHTTPClient 未单独设置 httpClient 时使用 util.SetHTTPClient 设置的全局 httpClient
+func TestDoUsesGlobalHTTPClient(t *testing.T) {
+	calls := 0
+	util.SetHTTPClient(&http.Client{Transport: roundTripFunc(func(request *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":[]}`)),
+			Request:    request,
+		}, nil
+	})})
+	defer util.SetHTTPClient(nil)
+
+	assert.Nil(t, newTestClient(t).Do(context.Background(), http.MethodGet, "/v3/certificates", nil, nil))
+	assert.Equal(t, 1, calls)
+}
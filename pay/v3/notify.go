@@ -0,0 +1,230 @@
+package v3
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// see https://pay.weixin.qq.com/wiki/doc/apiv3/apis/chapter3_1_5.shtml
+
+const (
+	// DefaultTimestampSkew 回调通知时间戳与当前时间默认允许的最大偏差
+	DefaultTimestampSkew = 5 * time.Minute
+	// notifyReplayWindow 回调通知去重的时间窗口
+	notifyReplayWindow = 24 * time.Hour
+)
+
+// Notification 回调通知
+type Notification struct {
+	ID           string   `json:"id"`            // 通知ID
+	CreateTime   string   `json:"create_time"`   // 通知创建时间
+	EventType    string   `json:"event_type"`    // 通知类型，支付成功通知的类型为 TRANSACTION.SUCCESS
+	ResourceType string   `json:"resource_type"` // 通知数据类型，支付成功通知为 encrypt-resource
+	Summary      string   `json:"summary"`       // 回调摘要
+	Resource     Resource `json:"resource"`      // 通知数据
+}
+
+// Resource 回调通知加密数据
+type Resource struct {
+	Algorithm      string `json:"algorithm"`       // 加密算法类型，目前只支持 AEAD_AES_256_GCM
+	Ciphertext     string `json:"ciphertext"`      // Base64 编码后的数据密文
+	AssociatedData string `json:"associated_data"` // 附加数据
+	OriginalType   string `json:"original_type"`   // 原始回调类型，为 transaction
+	Nonce          string `json:"nonce"`           // 加密使用的随机串
+}
+
+// Transaction 支付成功通知的订单信息
+type Transaction struct {
+	AppID          string `json:"appid"`
+	MchID          string `json:"mchid"`
+	OutTradeNo     string `json:"out_trade_no"`
+	TransactionID  string `json:"transaction_id"`
+	TradeType      string `json:"trade_type"`
+	TradeState     string `json:"trade_state"`
+	TradeStateDesc string `json:"trade_state_desc"`
+	BankType       string `json:"bank_type"`
+	Attach         string `json:"attach"`
+	SuccessTime    string `json:"success_time"`
+	Payer          struct {
+		OpenID string `json:"openid"`
+	} `json:"payer"`
+	Amount struct {
+		Total         int64  `json:"total"`
+		PayerTotal    int64  `json:"payer_total"`
+		Currency      string `json:"currency"`
+		PayerCurrency string `json:"payer_currency"`
+	} `json:"amount"`
+}
+
+// GetCreateTime 返回通知创建时间
+func (notification *Notification) GetCreateTime() (time.Time, error) {
+	return util.ParseWeChatTime(notification.CreateTime)
+}
+
+// GetSuccessTime 返回支付完成时间
+func (transaction *Transaction) GetSuccessTime() (time.Time, error) {
+	return util.ParseWeChatTime(transaction.SuccessTime)
+}
+
+// notifyResponse 回调通知应答
+type notifyResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// SetTimestampSkew 设置回调通知时间戳与当前时间允许的最大偏差，小于等于 0 时使用 DefaultTimestampSkew
+// 服务器时钟不准确时可适当放宽，但过大的偏差会降低防重放的效果
+func (client *Client) SetTimestampSkew(skew time.Duration) {
+	client.timestampSkew = skew
+}
+
+func (client *Client) getTimestampSkew() time.Duration {
+	if client.timestampSkew > 0 {
+		return client.timestampSkew
+	}
+	return DefaultTimestampSkew
+}
+
+// ParseNotify 验证回调通知签名，并将解密后的通知数据解析至 content
+func (client *Client) ParseNotify(req *http.Request, content interface{}) (*Notification, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := req.Header.Get("Wechatpay-Timestamp")
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Wechatpay-Timestamp: %v", err)
+	}
+	maxSkew := client.getTimestampSkew()
+	if skew := time.Since(time.Unix(ts, 0)); skew > maxSkew || skew < -maxSkew {
+		return nil, fmt.Errorf("Wechatpay-Timestamp expired, timestamp=%d", ts)
+	}
+	message := fmt.Sprintf("%s\n%s\n%s\n", timestamp, req.Header.Get("Wechatpay-Nonce"), body)
+	if err = client.verify(req.Header.Get("Wechatpay-Serial"), message, req.Header.Get("Wechatpay-Signature")); err != nil {
+		return nil, fmt.Errorf("verify notify signature error: %v", err)
+	}
+
+	notification := new(Notification)
+	if err = json.Unmarshal(body, notification); err != nil {
+		return nil, err
+	}
+	plaintext, err := client.decryptResource(&notification.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		if err = json.Unmarshal(plaintext, content); err != nil {
+			return nil, err
+		}
+	}
+	return notification, nil
+}
+
+// decryptResource 使用 APIv3 密钥解密回调通知数据（AEAD_AES_256_GCM）
+func (client *Client) decryptResource(resource *Resource) ([]byte, error) {
+	if resource.Algorithm != "AEAD_AES_256_GCM" {
+		return nil, fmt.Errorf("unsupported algorithm: %s", resource.Algorithm)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(resource.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher([]byte(client.APIv3Key))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, []byte(resource.Nonce), ciphertext, []byte(resource.AssociatedData))
+}
+
+// NotifyHandler 返回处理支付成功回调通知的 http.Handler
+// 验证签名并解密后调用 onEvent，onEvent 返回错误时应答 500 使微信支付重试；
+// 已处理或正在处理的通知（相同通知ID）不会重复调用 onEvent，onEvent 的错误信息不会返回给微信支付
+func (client *Client) NotifyHandler(onEvent func(ctx context.Context, transaction *Transaction) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		transaction := new(Transaction)
+		notification, err := client.ParseNotify(req, transaction)
+		if err != nil {
+			writeNotifyResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		// 先记录通知ID，并发投递的相同通知只处理一次
+		if !client.notifyIDs.checkAndAdd(notification.ID) {
+			writeNotifyResponse(w, http.StatusOK, nil)
+			return
+		}
+		if err = onEvent(req.Context(), transaction); err != nil {
+			// 处理失败时移除记录，使微信支付重试的通知可再次处理；错误信息不返回给微信支付
+			client.notifyIDs.remove(notification.ID)
+			writeNotifyResponse(w, http.StatusInternalServerError, errNotifyHandleFailed)
+			return
+		}
+		writeNotifyResponse(w, http.StatusOK, nil)
+	})
+}
+
+// errNotifyHandleFailed 业务处理回调通知失败时应答的错误信息
+var errNotifyHandleFailed = errors.New("处理失败")
+
+// writeNotifyResponse 写入回调通知应答
+func writeNotifyResponse(w http.ResponseWriter, statusCode int, err error) {
+	resp := notifyResponse{Code: "SUCCESS", Message: "成功"}
+	if err != nil {
+		resp = notifyResponse{Code: "FAIL", Message: err.Error()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// replayGuard 记录一段时间内已处理的通知ID，用于回调通知去重
+type replayGuard struct {
+	mu     sync.Mutex
+	window time.Duration
+	ids    map[string]time.Time
+}
+
+func newReplayGuard(window time.Duration) *replayGuard {
+	return &replayGuard{window: window, ids: make(map[string]time.Time)}
+}
+
+// checkAndAdd 通知ID未处理时记录并返回 true，已处理或正在处理时返回 false，同时清理过期记录
+func (g *replayGuard) checkAndAdd(id string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	for k, at := range g.ids {
+		if now.Sub(at) >= g.window {
+			delete(g.ids, k)
+		}
+	}
+	if _, ok := g.ids[id]; ok {
+		return false
+	}
+	g.ids[id] = now
+	return true
+}
+
+// remove 移除通知ID的记录
+func (g *replayGuard) remove(id string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.ids, id)
+}
@@ -0,0 +1,224 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/pay/config"
+)
+
+const testPlatformSerialNo = "5157F09EFDC096DE15EBE81A47057A7232F1B8E1"
+
+// newNotifyRequest 构造一个使用测试私钥签名的支付成功回调通知
+func newNotifyRequest(t *testing.T, client *Client, id string, transaction *Transaction) *http.Request {
+	return newNotifyRequestAt(t, client, id, transaction, time.Now())
+}
+
+// newNotifyRequestAt 构造一个指定时间戳的支付成功回调通知
+func newNotifyRequestAt(t *testing.T, client *Client, id string, transaction *Transaction, at time.Time) *http.Request {
+	plaintext, err := json.Marshal(transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher([]byte(client.APIv3Key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, associatedData := "fdasflkja484", "transaction"
+	body, err := json.Marshal(&Notification{
+		ID:           id,
+		EventType:    "TRANSACTION.SUCCESS",
+		ResourceType: "encrypt-resource",
+		Resource: Resource{
+			Algorithm:      "AEAD_AES_256_GCM",
+			Ciphertext:     base64.StdEncoding.EncodeToString(gcm.Seal(nil, []byte(nonce), plaintext, []byte(associatedData))),
+			AssociatedData: associatedData,
+			OriginalType:   "transaction",
+			Nonce:          nonce,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	signature, err := client.sign(fmt.Sprintf("%s\n%s\n%s\n", timestamp, "notify-nonce", body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/notify", bytes.NewReader(body))
+	req.Header.Set("Wechatpay-Timestamp", timestamp)
+	req.Header.Set("Wechatpay-Nonce", "notify-nonce")
+	req.Header.Set("Wechatpay-Signature", signature)
+	req.Header.Set("Wechatpay-Serial", testPlatformSerialNo)
+	return req
+}
+
+func TestNotifyHandler(t *testing.T) {
+	client := newTestClient(t)
+	client.AddPlatformPublicKey(testPlatformSerialNo, &client.privateKey.PublicKey)
+
+	var received []*Transaction
+	handler := client.NotifyHandler(func(_ context.Context, transaction *Transaction) error {
+		received = append(received, transaction)
+		return nil
+	})
+
+	transaction := &Transaction{OutTradeNo: "1217752501201407033233368018", TransactionID: "4200000000000000000000000000", TradeState: "SUCCESS"}
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newNotifyRequest(t, client, "notify-id-1", transaction))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"code":"SUCCESS","message":"成功"}`, rec.Body.String())
+	}
+	// 重复的通知不会再次回调
+	assert.Len(t, received, 1)
+	assert.Equal(t, transaction.OutTradeNo, received[0].OutTradeNo)
+	assert.Equal(t, transaction.TransactionID, received[0].TransactionID)
+}
+
+func TestNotifyHandlerError(t *testing.T) {
+	client := newTestClient(t)
+	client.AddPlatformPublicKey(testPlatformSerialNo, &client.privateKey.PublicKey)
+
+	calls := 0
+	handler := client.NotifyHandler(func(_ context.Context, _ *Transaction) error {
+		calls++
+		return errors.New("db unavailable")
+	})
+	// 处理失败时不向微信支付返回业务错误信息，重试的通知会再次回调
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newNotifyRequest(t, client, "notify-id-2", &Transaction{OutTradeNo: "order"}))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.JSONEq(t, `{"code":"FAIL","message":"处理失败"}`, rec.Body.String())
+	}
+	assert.Equal(t, 2, calls)
+
+	// 签名错误
+	req := newNotifyRequest(t, client, "notify-id-3", &Transaction{OutTradeNo: "order"})
+	req.Header.Set("Wechatpay-Nonce", "tampered")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// TestNotifyHandlerConcurrent 并发投递的相同通知只回调一次
+func TestNotifyHandlerConcurrent(t *testing.T) {
+	client := newTestClient(t)
+	client.AddPlatformPublicKey(testPlatformSerialNo, &client.privateKey.PublicKey)
+
+	var calls int32
+	handler := client.NotifyHandler(func(_ context.Context, _ *Transaction) error {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	requests := make([]*http.Request, 8)
+	for i := range requests {
+		requests[i] = newNotifyRequest(t, client, "notify-id-4", &Transaction{OutTradeNo: "order"})
+	}
+	var wg sync.WaitGroup
+	for _, req := range requests {
+		wg.Add(1)
+		go func(req *http.Request) {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}(req)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestParseNotifyTimestampSkew(t *testing.T) {
+	client := newTestClient(t)
+	client.AddPlatformPublicKey(testPlatformSerialNo, &client.privateKey.PublicKey)
+	transaction := &Transaction{OutTradeNo: "order"}
+
+	// 默认允许 5 分钟的偏差
+	_, err := client.ParseNotify(newNotifyRequestAt(t, client, "skew-1", transaction, time.Now().Add(-4*time.Minute)), new(Transaction))
+	assert.NoError(t, err)
+	_, err = client.ParseNotify(newNotifyRequestAt(t, client, "skew-2", transaction, time.Now().Add(-6*time.Minute)), new(Transaction))
+	assert.Error(t, err)
+
+	client.SetTimestampSkew(10 * time.Second)
+	_, err = client.ParseNotify(newNotifyRequestAt(t, client, "skew-3", transaction, time.Now().Add(-8*time.Second)), new(Transaction))
+	assert.NoError(t, err)
+	_, err = client.ParseNotify(newNotifyRequestAt(t, client, "skew-4", transaction, time.Now().Add(8*time.Second)), new(Transaction))
+	assert.NoError(t, err)
+	_, err = client.ParseNotify(newNotifyRequestAt(t, client, "skew-5", transaction, time.Now().Add(-12*time.Second)), new(Transaction))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Wechatpay-Timestamp expired")
+	}
+	_, err = client.ParseNotify(newNotifyRequestAt(t, client, "skew-6", transaction, time.Now().Add(12*time.Second)), new(Transaction))
+	assert.Error(t, err)
+
+	client.SetTimestampSkew(10 * time.Minute)
+	_, err = client.ParseNotify(newNotifyRequestAt(t, client, "skew-7", transaction, time.Now().Add(-6*time.Minute)), new(Transaction))
+	assert.NoError(t, err)
+}
+
+func TestParseNotifyWechatPayPublicKey(t *testing.T) {
+	const publicKeyID = "PUB_KEY_ID_0114232134912410000000000000"
+	signer := newTestClient(t)
+	der, err := x509.MarshalPKIXPublicKey(&signer.privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewClient(&config.Config{
+		MchID:       signer.MchID,
+		SerialNo:    signer.SerialNo,
+		PrivateKey:  signer.PrivateKey,
+		APIv3Key:    signer.APIv3Key,
+		PublicKeyID: publicKeyID,
+		PublicKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := newNotifyRequest(t, client, "public-key-1", &Transaction{OutTradeNo: "1217752501201407033233368018"})
+	req.Header.Set("Wechatpay-Serial", publicKeyID)
+	transaction := new(Transaction)
+	_, err = client.ParseNotify(req, transaction)
+	assert.NoError(t, err)
+	assert.Equal(t, "1217752501201407033233368018", transaction.OutTradeNo)
+
+	// 篡改签名
+	req = newNotifyRequest(t, client, "public-key-2", &Transaction{OutTradeNo: "1217752501201407033233368018"})
+	req.Header.Set("Wechatpay-Serial", publicKeyID)
+	req.Header.Set("Wechatpay-Signature", base64.StdEncoding.EncodeToString([]byte("bad-signature")))
+	_, err = client.ParseNotify(req, new(Transaction))
+	assert.Error(t, err)
+
+	// 未配置的公钥 ID
+	req = newNotifyRequest(t, client, "public-key-3", &Transaction{OutTradeNo: "1217752501201407033233368018"})
+	_, err = client.ParseNotify(req, new(Transaction))
+	assert.Error(t, err)
+
+	_, err = NewClient(&config.Config{MchID: signer.MchID, SerialNo: signer.SerialNo, PrivateKey: signer.PrivateKey, PublicKey: client.PublicKey})
+	assert.EqualError(t, err, "public_key_id is required")
+}
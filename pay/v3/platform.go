@@ -0,0 +1,73 @@
+package v3
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// AddPlatformCertificate 添加微信支付平台证书（PEM 格式），用于验证微信支付的应答及回调签名
+func (client *Client) AddPlatformCertificate(certPEM string) error {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return errors.New("platform certificate format error")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("platform certificate public key should be *rsa.PublicKey, got %T", cert.PublicKey)
+	}
+	client.AddPlatformPublicKey(strings.ToUpper(cert.SerialNumber.Text(16)), publicKey)
+	return nil
+}
+
+// AddPlatformPublicKey 添加微信支付平台公钥，serialNo 为平台证书序列号
+func (client *Client) AddPlatformPublicKey(serialNo string, publicKey *rsa.PublicKey) {
+	client.platformKeysLock.Lock()
+	defer client.platformKeysLock.Unlock()
+	if client.platformKeys == nil {
+		client.platformKeys = make(map[string]*rsa.PublicKey)
+	}
+	client.platformKeys[serialNo] = publicKey
+}
+
+// AddWechatPayPublicKey 添加微信支付公钥（PEM 格式），用于代替平台证书验证签名
+// 使用微信支付公钥时，应答及回调的 Wechatpay-Serial 请求头为公钥 ID
+func (client *Client) AddWechatPayPublicKey(publicKeyID, publicKeyPEM string) error {
+	if publicKeyID == "" {
+		return errors.New("public_key_id is required")
+	}
+	publicKey, err := util.ParseRSAPublicKey(publicKeyPEM)
+	if err != nil {
+		return err
+	}
+	client.AddPlatformPublicKey(publicKeyID, publicKey)
+	return nil
+}
+
+// verify 使用序列号对应的平台公钥验证签名
+func (client *Client) verify(serialNo, message, signature string) error {
+	client.platformKeysLock.RLock()
+	publicKey, ok := client.platformKeys[serialNo]
+	client.platformKeysLock.RUnlock()
+	if !ok {
+		return fmt.Errorf("platform certificate not found, serial=%s", serialNo)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return err
+	}
+	hashed := sha256.Sum256([]byte(message))
+	return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hashed[:], sig)
+}
@@ -0,0 +1,124 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// baseURL 微信支付 APIv3 域名
+const baseURL = "https://api.mch.weixin.qq.com"
+
+// authorizationSchema 签名认证类型
+const authorizationSchema = "WECHATPAY2-SHA256-RSA2048"
+
+// defaultAcceptLanguage 默认应答语言
+const defaultAcceptLanguage = "zh-CN"
+
+// userAgent 请求头 User-Agent，微信支付建议携带以便排查问题
+var userAgent = fmt.Sprintf("wechat-go/v2 (%s/%s) %s", runtime.GOOS, runtime.GOARCH, runtime.Version())
+
+// SetHTTPClient 设置发起请求使用的 httpClient，未设置时使用 util.SetHTTPClient 设置的全局 httpClient
+func (client *Client) SetHTTPClient(httpClient *http.Client) {
+	client.httpClient = httpClient
+}
+
+// SetAcceptLanguage 设置请求头 Accept-Language，控制错误信息等应答内容的语言，如 en、zh-CN，默认为 zh-CN
+func (client *Client) SetAcceptLanguage(lang string) {
+	client.acceptLanguage = lang
+}
+
+func (client *Client) getHTTPClient() *http.Client {
+	if client.httpClient != nil {
+		return client.httpClient
+	}
+	return util.GetHTTPClient()
+}
+
+// authorization 生成请求头 Authorization
+func (client *Client) authorization(method, url string, body []byte) (string, error) {
+	nonce := util.RandomStr(32)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature, err := client.sign(buildMessage(method, url, timestamp, nonce, string(body)))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`%s mchid="%s",nonce_str="%s",signature="%s",timestamp="%s",serial_no="%s"`,
+		authorizationSchema, client.MchID, nonce, signature, timestamp, client.SerialNo), nil
+}
+
+// validator 请求参数校验，Do 在签名前调用
+type validator interface {
+	Validate() error
+}
+
+// Do 发起签名后的 APIv3 请求，url 为不包含域名的绝对路径（含查询参数），如 /v3/certificates
+// req 不为 nil 时以 json 格式发送，返回 2xx 时将应答解析到 res 中，否则返回 *PayError
+// req 实现了 Validate() error 时，在签名前进行校验
+func (client *Client) Do(ctx context.Context, method, url string, req, res interface{}) error {
+	if v, ok := req.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	var body []byte
+	if req != nil {
+		var err error
+		if body, err = json.Marshal(req); err != nil {
+			return err
+		}
+	}
+	request, err := client.newRequest(ctx, method, baseURL+url, url, body)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if req != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := client.getHTTPClient().Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return newPayError(response.StatusCode, response.Header.Get("Request-ID"), data)
+	}
+	if res == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, res)
+}
+
+// newRequest 创建携带签名及通用请求头的请求，signURL 为参与签名的绝对路径（含查询参数）
+func (client *Client) newRequest(ctx context.Context, method, rawURL, signURL string, body []byte) (*http.Request, error) {
+	authorization, err := client.authorization(method, signURL, body)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, method, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", authorization)
+	request.Header.Set("User-Agent", userAgent)
+	if client.acceptLanguage != "" {
+		request.Header.Set("Accept-Language", client.acceptLanguage)
+	} else {
+		request.Header.Set("Accept-Language", defaultAcceptLanguage)
+	}
+	return request, nil
+}
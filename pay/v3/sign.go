@@ -0,0 +1,39 @@
+package v3
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// buildMessage 构造签名串，格式为：
+// HTTP请求方法\nURL\n请求时间戳\n请求随机串\n请求报文主体\n
+// see https://pay.weixin.qq.com/wiki/doc/apiv3/wechatpay/wechatpay4_0.shtml
+func buildMessage(method, url, timestamp, nonce, body string) string {
+	return fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n", method, url, timestamp, nonce, body)
+}
+
+// sign 使用商户私钥对签名串进行 SHA256 with RSA 签名，并进行 Base64 编码
+func (client *Client) sign(message string) (string, error) {
+	hashed := sha256.Sum256([]byte(message))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, client.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// DebugSign 返回签名串及其签名，用于排查 "签名错误" 问题时与微信支付的预期进行比对
+// url 为不包含域名的绝对路径（含查询参数），如 /v3/certificates
+// 返回值中不包含商户私钥等敏感信息
+func (client *Client) DebugSign(method, url, timestamp, nonce, body string) (message, signature string) {
+	message = buildMessage(method, url, timestamp, nonce, body)
+	signature, err := client.sign(message)
+	if err != nil {
+		signature = fmt.Sprintf("sign error: %v", err)
+	}
+	return
+}
@@ -0,0 +1,92 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ItemError 批量操作中单个条目的失败信息
+type ItemError struct {
+	Item string // 条目标识，如 openid
+	Err  error
+}
+
+func (e ItemError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Item, e.Err)
+}
+
+// Unwrap 返回条目失败的原始错误
+func (e ItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchResult 批量操作的汇总结果
+type BatchResult struct {
+	Total     int         // 条目总数
+	Succeeded int         // 成功的条目数
+	Failed    []ItemError // 失败的条目及原因，按处理顺序排列
+}
+
+// Add 记录一个条目的处理结果，err 为 nil 时视为成功
+func (r *BatchResult) Add(item string, err error) {
+	r.Total++
+	if err == nil {
+		r.Succeeded++
+		return
+	}
+	r.Failed = append(r.Failed, ItemError{Item: item, Err: err})
+}
+
+// Err 汇总所有失败条目的错误，全部成功时返回 nil
+// 返回的错误可通过 errors.Is 及 errors.As 匹配任一失败条目的错误
+func (r *BatchResult) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	return &BatchError{Failed: r.Failed, total: r.Total}
+}
+
+// BatchError 批量操作中部分条目失败
+type BatchError struct {
+	Failed []ItemError
+	total  int
+}
+
+// Error 错误信息相同的条目合并展示，如同一次请求中的多个 openid 共享该请求的错误
+func (e *BatchError) Error() string {
+	var msgs []string
+	items := make(map[string][]string)
+	for _, failed := range e.Failed {
+		msg := failed.Err.Error()
+		if _, ok := items[msg]; !ok {
+			msgs = append(msgs, msg)
+		}
+		items[msg] = append(items[msg], failed.Item)
+	}
+	groups := make([]string, len(msgs))
+	for i, msg := range msgs {
+		groups[i] = strings.Join(items[msg], ", ") + ": " + msg
+	}
+	return fmt.Sprintf("%d of %d items failed: %s", len(e.Failed), e.total, strings.Join(groups, "; "))
+}
+
+// Is 任一失败条目的错误匹配 target 时返回 true
+func (e *BatchError) Is(target error) bool {
+	for _, failed := range e.Failed {
+		if errors.Is(failed.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As 将第一个可以匹配 target 的失败条目错误赋值给 target
+func (e *BatchError) As(target interface{}) bool {
+	for _, failed := range e.Failed {
+		if errors.As(failed.Err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchResult(t *testing.T) {
+	var result BatchResult
+	assert.Nil(t, result.Err())
+
+	errInvalidTag := NewCommonError("BatchTag", 45157, "invalid tag")
+	errNetwork := errors.New("network error")
+	result.Add("openid-a", nil)
+	result.Add("openid-b", errInvalidTag)
+	result.Add("openid-c", nil)
+	result.Add("openid-d", errNetwork)
+
+	assert.Equal(t, 4, result.Total)
+	assert.Equal(t, 2, result.Succeeded)
+	assert.Equal(t, []ItemError{{Item: "openid-b", Err: errInvalidTag}, {Item: "openid-d", Err: errNetwork}}, result.Failed)
+
+	err := result.Err()
+	assert.EqualError(t, err, "2 of 4 items failed: openid-b: BatchTag Error , errcode=45157 , errmsg=invalid tag; openid-d: network error")
+	assert.True(t, errors.Is(err, errNetwork))
+	var commonErr *CommonError
+	if assert.True(t, errors.As(err, &commonErr)) {
+		assert.Equal(t, int64(45157), commonErr.ErrCode.Int64())
+	}
+}
+
+// TestBatchErrorGroupsSameError 同一错误的条目合并展示
+func TestBatchErrorGroupsSameError(t *testing.T) {
+	var result BatchResult
+	errChunk := NewCommonError("BatchTag", 45157, "invalid tag")
+	errNetwork := errors.New("network error")
+	result.Add("openid-a", errChunk)
+	result.Add("openid-b", errChunk)
+	result.Add("openid-c", errNetwork)
+	result.Add("openid-d", NewCommonError("BatchTag", 45157, "invalid tag"))
+	result.Add("openid-e", nil)
+
+	assert.EqualError(t, result.Err(), "4 of 5 items failed: openid-a, openid-b, openid-d: BatchTag Error , errcode=45157 , errmsg=invalid tag; openid-c: network error")
+}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// FlexBool 兼容微信接口中以 true/false、0/1 或 "0"/"1" 表示的布尔字段
+type FlexBool bool
+
+// UnmarshalJSON 实现 json.Unmarshaler
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	switch string(bytes.TrimSpace(data)) {
+	case "true", "1", `"1"`, `"true"`:
+		*b = true
+	case "false", "0", `"0"`, `"false"`, `""`, "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid FlexBool value: %s", data)
+	}
+	return nil
+}
+
+// Bool 返回对应的 bool 值
+func (b FlexBool) Bool() bool {
+	return bool(b)
+}
@@ -0,0 +1,31 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexBoolUnmarshalJSON(t *testing.T) {
+	type info struct {
+		Subscribe FlexBool `json:"subscribe"`
+	}
+	cases := map[string]bool{
+		`{"subscribe":true}`:  true,
+		`{"subscribe":false}`: false,
+		`{"subscribe":1}`:     true,
+		`{"subscribe":0}`:     false,
+		`{"subscribe":"1"}`:   true,
+		`{"subscribe":"0"}`:   false,
+		`{}`:                  false,
+	}
+	for data, expected := range cases {
+		var v info
+		assert.Nil(t, json.Unmarshal([]byte(data), &v), data)
+		assert.Equal(t, expected, v.Subscribe.Bool(), data)
+	}
+
+	var v info
+	assert.NotNil(t, json.Unmarshal([]byte(`{"subscribe":2}`), &v))
+}
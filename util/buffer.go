@@ -0,0 +1,44 @@
+package util
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// maxPooledBufferSize 超过该大小的缓冲区不放回池中，避免大文件下载长期占用内存
+const maxPooledBufferSize = 1 << 20
+
+// maxDrainBodySize 关闭响应前最多丢弃的未读内容大小，超过时直接关闭连接
+const maxDrainBodySize = 1 << 20
+
+// bufferPool 读取响应内容使用的缓冲区
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// readAll 使用缓冲池读取全部内容，返回的数据为独立拷贝，不与缓冲池共享内存
+func readAll(r io.Reader) ([]byte, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			buf.Reset()
+			bufferPool.Put(buf)
+		}
+	}()
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	data := make([]byte, buf.Len())
+	copy(data, buf.Bytes())
+	return data, nil
+}
+
+// drainAndClose 读完并丢弃未读的响应内容后关闭，使连接可被复用，用于非 200 状态码等提前返回的情况
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, maxDrainBodySize))
+	_ = body.Close()
+}
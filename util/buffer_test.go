@@ -0,0 +1,76 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAllNoAliasing(t *testing.T) {
+	first, err := readAll(strings.NewReader(`{"errcode":0,"errmsg":"first"}`))
+	assert.Nil(t, err)
+	second, err := readAll(strings.NewReader(`{"errcode":1,"errmsg":"second"}`))
+	assert.Nil(t, err)
+	// 缓冲区被复用后，之前返回的数据不受影响
+	assert.Equal(t, `{"errcode":0,"errmsg":"first"}`, string(first))
+	assert.Equal(t, `{"errcode":1,"errmsg":"second"}`, string(second))
+
+	empty, err := readAll(strings.NewReader(""))
+	assert.Nil(t, err)
+	assert.Len(t, empty, 0)
+
+	large := bytes.Repeat([]byte("x"), maxPooledBufferSize+1)
+	data, err := readAll(bytes.NewReader(large))
+	assert.Nil(t, err)
+	assert.Equal(t, large, data)
+}
+
+func BenchmarkReadAll(b *testing.B) {
+	body := bytes.Repeat([]byte(`{"errcode":0,"errmsg":"ok","data":"mock"}`), 200)
+	b.Run("io.ReadAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = io.ReadAll(bytes.NewReader(body))
+		}
+	})
+	b.Run("readAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = readAll(bytes.NewReader(body))
+		}
+	})
+}
+
+// TestDrainOnErrorResponse 返回非 200 状态码时读完响应内容，连接可被复用
+func TestDrainOnErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write(bytes.Repeat([]byte("x"), 512<<10))
+	}))
+	defer server.Close()
+
+	var dialed int32
+	SetHTTPClient(NewHTTPClient(HTTPClientOptions{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			atomic.AddInt32(&dialed, 1)
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}))
+	defer SetHTTPClient(nil)
+
+	for i := 0; i < 3; i++ {
+		_, err := HTTPGet(server.URL)
+		assert.Error(t, err)
+		_, err = PostJSON(server.URL, map[string]string{})
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dialed))
+}
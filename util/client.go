@@ -0,0 +1,53 @@
+package util
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+// DialContextFunc 建立连接的方法，可用于自定义 DNS 解析、固定 IP 或指定出口地址
+type DialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// HTTPClientOptions 创建 httpClient 的配置
+type HTTPClientOptions struct {
+	// Timeout 单次请求超时时间，为 0 时不超时
+	Timeout time.Duration
+	// DialContext 自定义建立连接的方法，为空时使用 Dialer
+	DialContext DialContextFunc
+	// Dialer 自定义 net.Dialer，可设置 LocalAddr、Resolver 等，为空时使用默认配置
+	Dialer *net.Dialer
+	// MaxIdleConnsPerHost 每个 host 保持的最大空闲连接数，为 0 时使用默认值
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout 空闲连接超时时间，为 0 时使用默认值
+	IdleConnTimeout time.Duration
+	// CheckRedirect 重定向策略，同 http.Client.CheckRedirect，为空时不跟随重定向（NoFollowRedirect）
+	CheckRedirect func(req *http.Request, via []*http.Request) error
+}
+
+// NewHTTPClient 根据配置创建 httpClient，可配合 SetHTTPClient 使用
+func NewHTTPClient(opts HTTPClientOptions) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	switch {
+	case opts.DialContext != nil:
+		transport.DialContext = opts.DialContext
+	case opts.Dialer != nil:
+		transport.DialContext = opts.Dialer.DialContext
+	}
+	if opts.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	}
+	if opts.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = opts.IdleConnTimeout
+	}
+	checkRedirect := opts.CheckRedirect
+	if checkRedirect == nil {
+		checkRedirect = NoFollowRedirect
+	}
+	return &http.Client{
+		Transport:     transport,
+		Timeout:       opts.Timeout,
+		CheckRedirect: checkRedirect,
+	}
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPClientDialContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	var (
+		dialed   int32
+		dialAddr atomic.Value
+	)
+	client := NewHTTPClient(HTTPClientOptions{
+		// 将域名固定解析到测试服务地址
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			atomic.AddInt32(&dialed, 1)
+			dialAddr.Store(addr)
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	})
+
+	resp, err := client.Get("http://api.weixin.qq.com/cgi-bin/token")
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	body, err := readAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"errcode":0,"errmsg":"ok"}`, string(body))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dialed))
+	assert.Equal(t, "api.weixin.qq.com:80", dialAddr.Load())
+}
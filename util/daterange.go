@@ -0,0 +1,97 @@
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DateLayout 数据统计接口使用的日期格式，如 20170313
+	DateLayout = "20060102"
+	// DashDateLayout 公众号数据统计接口使用的日期格式，如 2017-03-13
+	DashDateLayout = "2006-01-02"
+)
+
+// DateRange 日期范围，包含起止日期，取 Begin、End 在其自身时区中的日历日期，视为北京时间的自然日
+type DateRange struct {
+	Begin time.Time
+	End   time.Time
+}
+
+// ParseDateRange 解析 YYYYMMDD 或 YYYY-MM-DD 格式的起止日期
+func ParseDateRange(begin, end string) (DateRange, error) {
+	b, err := parseDate(begin)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("invalid begin date %q", begin)
+	}
+	e, err := parseDate(end)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("invalid end date %q", end)
+	}
+	return DateRange{Begin: b, End: e}, nil
+}
+
+func parseDate(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation(DateLayout, value, wechatLocation); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation(DashDateLayout, value, wechatLocation)
+}
+
+// truncateDate 返回 t 在其自身时区中的日期对应的北京时间零点
+// 不先转换为北京时间，避免调用方本地零点在东八区以东的时区被算作前一天
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, wechatLocation)
+}
+
+// Days 返回范围内的天数，包含起止日期，结束日期早于开始日期时返回 0
+func (r DateRange) Days() int {
+	begin, end := truncateDate(r.Begin), truncateDate(r.End)
+	if end.Before(begin) {
+		return 0
+	}
+	return int(end.Sub(begin)/(24*time.Hour)) + 1
+}
+
+// Validate 校验日期范围，结束日期不能早于开始日期，maxDays 大于 0 时天数不能超过 maxDays
+func (r DateRange) Validate(maxDays int) error {
+	if r.Begin.IsZero() || r.End.IsZero() {
+		return fmt.Errorf("begin and end date are required")
+	}
+	if truncateDate(r.End).Before(truncateDate(r.Begin)) {
+		return fmt.Errorf("end date %s is before begin date %s", truncateDate(r.End).Format(DateLayout), truncateDate(r.Begin).Format(DateLayout))
+	}
+	if maxDays > 0 && r.Days() > maxDays {
+		return fmt.Errorf("date range of %d days exceeds the maximum of %d days", r.Days(), maxDays)
+	}
+	return nil
+}
+
+// Format 返回 YYYYMMDD 格式的起止日期
+func (r DateRange) Format() (begin, end string) {
+	return r.FormatLayout(DateLayout)
+}
+
+// FormatLayout 返回指定格式的起止日期
+func (r DateRange) FormatLayout(layout string) (begin, end string) {
+	return truncateDate(r.Begin).Format(layout), truncateDate(r.End).Format(layout)
+}
+
+// Split 将日期范围按顺序拆分为不超过 days 天的多个范围
+func (r DateRange) Split(days int) []DateRange {
+	if days <= 0 || r.Days() == 0 {
+		return nil
+	}
+	var ranges []DateRange
+	begin, end := truncateDate(r.Begin), truncateDate(r.End)
+	for !begin.After(end) {
+		chunkEnd := begin.AddDate(0, 0, days-1)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+		ranges = append(ranges, DateRange{Begin: begin, End: chunkEnd})
+		begin = chunkEnd.AddDate(0, 0, 1)
+	}
+	return ranges
+}
@@ -0,0 +1,66 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRange(t *testing.T) {
+	r, err := ParseDateRange("20240226", "2024-03-03")
+	assert.Nil(t, err)
+	// 闰年二月
+	assert.Equal(t, 7, r.Days())
+	begin, end := r.Format()
+	assert.Equal(t, "20240226", begin)
+	assert.Equal(t, "20240303", end)
+	begin, end = r.FormatLayout(DashDateLayout)
+	assert.Equal(t, "2024-02-26", begin)
+	assert.Equal(t, "2024-03-03", end)
+
+	assert.Nil(t, r.Validate(7))
+	assert.EqualError(t, r.Validate(6), "date range of 7 days exceeds the maximum of 6 days")
+	assert.Nil(t, r.Validate(0))
+
+	// 同一天
+	day := DateRange{Begin: time.Date(2024, 3, 1, 23, 0, 0, 0, wechatLocation), End: time.Date(2024, 3, 1, 1, 0, 0, 0, wechatLocation)}
+	assert.Equal(t, 1, day.Days())
+	assert.Nil(t, day.Validate(1))
+
+	// 其他时区的日期按其自身时区的日历日期计算，不因时差偏移一天
+	for _, loc := range []*time.Location{time.UTC, time.FixedZone("NZDT", 13*3600), time.FixedZone("PST", -8*3600)} {
+		local := DateRange{Begin: time.Date(2024, 3, 1, 0, 0, 0, 0, loc), End: time.Date(2024, 3, 3, 23, 0, 0, 0, loc)}
+		begin, end = local.Format()
+		assert.Equal(t, "20240301", begin, loc.String())
+		assert.Equal(t, "20240303", end, loc.String())
+		assert.Equal(t, 3, local.Days(), loc.String())
+		if ranges := local.Split(2); assert.Len(t, ranges, 2, loc.String()) {
+			assert.Equal(t, time.Date(2024, 3, 3, 0, 0, 0, 0, wechatLocation), ranges[1].Begin)
+		}
+	}
+}
+
+func TestDateRangeInvalid(t *testing.T) {
+	_, err := ParseDateRange("2024/03/01", "20240301")
+	assert.NotNil(t, err)
+
+	r, err := ParseDateRange("20240302", "20240301")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, r.Days())
+	assert.NotNil(t, r.Validate(7))
+	assert.NotNil(t, DateRange{}.Validate(7))
+}
+
+func TestDateRangeSplit(t *testing.T) {
+	r, err := ParseDateRange("20240101", "20240120")
+	assert.Nil(t, err)
+	ranges := r.Split(7)
+	if assert.Len(t, ranges, 3) {
+		for i, expect := range [][2]string{{"20240101", "20240107"}, {"20240108", "20240114"}, {"20240115", "20240120"}} {
+			begin, end := ranges[i].Format()
+			assert.Equal(t, expect[0], begin)
+			assert.Equal(t, expect[1], end)
+		}
+	}
+}
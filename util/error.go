@@ -2,15 +2,73 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
+	"regexp"
+	"sync"
 )
 
 // CommonError 微信返回的通用错误 json
 type CommonError struct {
 	apiName string
-	ErrCode int64  `json:"errcode"`
-	ErrMsg  string `json:"errmsg"`
+	ErrCode FlexInt64 `json:"errcode"`
+	ErrMsg  string    `json:"errmsg"`
+	// RID 请求 ID，微信在 errmsg 中以 "rid: xxx" 返回，联系微信排查问题时需提供
+	RID string `json:"-"`
+	// HTTPStatus 返回错误时的 HTTP 状态码，微信业务错误为 200，非 200 的响应返回 *HTTPError，状态码见 HTTPError.StatusCode
+	HTTPStatus int `json:"-"`
+}
+
+// ridRegexp 从 errmsg 中提取 rid
+var ridRegexp = regexp.MustCompile(`rid:\s*([0-9a-zA-Z-]+)`)
+
+// parseRID 从 errmsg 中提取 rid
+func parseRID(errMsg string) string {
+	if match := ridRegexp.FindStringSubmatch(errMsg); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// ErrUnexpectedRedirect 请求返回了重定向，微信接口不会重定向，通常为出口代理重定向到了登录页等
+// 可通过 errors.Is(err, ErrUnexpectedRedirect) 判断
+var ErrUnexpectedRedirect = errors.New("unexpected redirect")
+
+// HTTPError 请求返回非 200 状态码
+type HTTPError struct {
+	op         string
+	URI        string
+	StatusCode int
+	// Location 重定向地址，仅返回 3xx 状态码时有值
+	Location string
+	// Header 响应头，可用于排查问题（如 Date 及代理、限流相关的响应头）
+	Header http.Header
+}
+
+func newHTTPError(op, uri string, response *http.Response) *HTTPError {
+	e := &HTTPError{op: op, URI: uri, StatusCode: response.StatusCode, Header: response.Header}
+	if e.isRedirect() {
+		e.Location = response.Header.Get("Location")
+	}
+	return e
+}
+
+func (e *HTTPError) Error() string {
+	if e.isRedirect() {
+		return fmt.Sprintf("http %s error : %v, uri=%v , statusCode=%v , location=%v", e.op, ErrUnexpectedRedirect, e.URI, e.StatusCode, e.Location)
+	}
+	return fmt.Sprintf("http %s error : uri=%v , statusCode=%v", e.op, e.URI, e.StatusCode)
+}
+
+// Is 返回 3xx 状态码时匹配 ErrUnexpectedRedirect
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrUnexpectedRedirect && e.isRedirect()
+}
+
+func (e *HTTPError) isRedirect() bool {
+	return e.StatusCode >= http.StatusMultipleChoices && e.StatusCode < http.StatusBadRequest
 }
 
 func (c *CommonError) Error() string {
@@ -21,11 +79,66 @@ func (c *CommonError) Error() string {
 func NewCommonError(apiName string, code int64, msg string) *CommonError {
 	return &CommonError{
 		apiName: apiName,
-		ErrCode: code,
+		ErrCode: FlexInt64(code),
 		ErrMsg:  msg,
 	}
 }
 
+// ErrorMapper 将微信返回的错误码映射为自定义错误，返回 nil 时使用默认的 CommonError
+type ErrorMapper func(code int64, msg string) error
+
+var (
+	errorMapperLock sync.RWMutex
+	errorMapper     ErrorMapper
+)
+
+// SetErrorMapper 设置错误码映射，DecodeWithCommonError 及 DecodeWithError 返回错误前调用，可在运行期间安全调用，传 nil 取消
+func SetErrorMapper(fn ErrorMapper) {
+	errorMapperLock.Lock()
+	defer errorMapperLock.Unlock()
+	errorMapper = fn
+}
+
+// MappedError 经 ErrorMapper 映射后的错误
+// Error 及 errors.Is 的结果与映射后的错误一致，同时仍可通过 errors.As 取得原始的 *CommonError，
+// 以保证配额处理、重试等依赖错误码的逻辑不受影响
+type MappedError struct {
+	Err   error
+	Cause *CommonError
+}
+
+func (e *MappedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap 返回映射后的错误
+func (e *MappedError) Unwrap() error {
+	return e.Err
+}
+
+// As 支持通过 errors.As 取得原始的 *CommonError
+func (e *MappedError) As(target interface{}) bool {
+	if commonErr, ok := target.(**CommonError); ok {
+		*commonErr = e.Cause
+		return true
+	}
+	return false
+}
+
+// mapError 记录错误日志并使用 ErrorMapper 映射错误，未设置或返回 nil 时返回原始错误
+func mapError(commonErr *CommonError) error {
+	logAPIError(commonErr)
+	errorMapperLock.RLock()
+	fn := errorMapper
+	errorMapperLock.RUnlock()
+	if fn != nil {
+		if mapped := fn(commonErr.ErrCode.Int64(), commonErr.ErrMsg); mapped != nil {
+			return &MappedError{Err: mapped, Cause: commonErr}
+		}
+	}
+	return commonErr
+}
+
 // DecodeWithCommonError 将返回值按照 CommonError 解析
 func DecodeWithCommonError(response []byte, apiName string) (err error) {
 	var commError CommonError
@@ -35,7 +148,9 @@ func DecodeWithCommonError(response []byte, apiName string) (err error) {
 	}
 	commError.apiName = apiName
 	if commError.ErrCode != 0 {
-		return &commError
+		commError.RID = parseRID(commError.ErrMsg)
+		commError.HTTPStatus = http.StatusOK
+		return mapError(&commError)
 	}
 	return nil
 }
@@ -60,11 +175,13 @@ func DecodeWithError(response []byte, obj interface{}, apiName string) error {
 		return fmt.Errorf("errcode or errmsg is invalid")
 	}
 	if errCode.Int() != 0 {
-		return &CommonError{
-			apiName: apiName,
-			ErrCode: errCode.Int(),
-			ErrMsg:  errMsg.String(),
-		}
+		return mapError(&CommonError{
+			apiName:    apiName,
+			ErrCode:    FlexInt64(errCode.Int()),
+			ErrMsg:     errMsg.String(),
+			RID:        parseRID(errMsg.String()),
+			HTTPStatus: http.StatusOK,
+		})
 	}
 	return nil
 }
@@ -1,6 +1,14 @@
 package util
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 var okErrData string = `{"errcode": 0}`
 var errData string = `{"errcode": 43101, "errmsg": "user refuse to accept the msg"}`
@@ -53,3 +61,138 @@ func TestDecodeWithError(t *testing.T) {
 		return
 	}
 }
+
+func TestCommonErrorRID(t *testing.T) {
+	response := []byte(`{"errcode":40001,"errmsg":"invalid credential, access_token is invalid or not latest rid: 6621e3c4-1b2a3c4d-5e6f7a8b"}`)
+	for _, err := range []error{
+		DecodeWithCommonError(response, "GetUserInfo"),
+		DecodeWithError(response, &struct{ CommonError }{}, "GetUserInfo"),
+	} {
+		var cErr *CommonError
+		if !errors.As(err, &cErr) {
+			t.Errorf("should return *CommonError but %T", err)
+			return
+		}
+		if cErr.RID != "6621e3c4-1b2a3c4d-5e6f7a8b" || cErr.HTTPStatus != http.StatusOK {
+			t.Errorf("bad rid or http status: %q %d", cErr.RID, cErr.HTTPStatus)
+		}
+	}
+}
+
+func TestHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	_, err := HTTPGet(server.URL)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Errorf("should return *HTTPError but %T", err)
+		return
+	}
+	if httpErr.StatusCode != http.StatusBadGateway || err.Error() != fmt.Sprintf("http get error : uri=%s , statusCode=502", server.URL) {
+		t.Errorf("bad http error: %v", err)
+	}
+
+	_, err = PostJSON(server.URL, map[string]string{})
+	if err == nil || err.Error() != fmt.Sprintf("http post error : uri=%s , statusCode=502", server.URL) {
+		t.Errorf("bad http error: %v", err)
+	}
+	_, _, err = PostJSONWithRespContentType(server.URL, map[string]string{})
+	if err == nil || err.Error() != fmt.Sprintf("http post error : uri=%s , statusCode=502", server.URL) {
+		t.Errorf("bad http error: %v", err)
+	}
+}
+
+// TestHTTPErrorRedirect 不跟随重定向，返回重定向错误而非解析登录页 HTML 失败
+func TestHTTPErrorRedirect(t *testing.T) {
+	var loginHits int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cgi-bin/user/info", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/login", http.StatusFound)
+	})
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		loginHits++
+		_, _ = w.Write([]byte("<html>login</html>"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	for _, call := range []func(uri string) error{
+		func(uri string) error { _, err := HTTPGet(uri); return err },
+		func(uri string) error { _, err := PostJSON(uri, map[string]string{}); return err },
+	} {
+		err := call(server.URL + "/cgi-bin/user/info")
+		if !errors.Is(err, ErrUnexpectedRedirect) {
+			t.Errorf("should return ErrUnexpectedRedirect but %v", err)
+			continue
+		}
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusFound || httpErr.Location != "/login" {
+			t.Errorf("bad redirect error: %v", err)
+		}
+	}
+	if loginHits != 0 {
+		t.Errorf("redirect should not be followed, login hits %d", loginHits)
+	}
+}
+
+func TestDecodeWithStringErrCode(t *testing.T) {
+	response := []byte(`{"errcode":"40001","errmsg":"invalid credential"}`)
+	for _, err := range []error{
+		DecodeWithCommonError(response, "GetUserInfo"),
+		DecodeWithError(response, &struct{ CommonError }{}, "GetUserInfo"),
+	} {
+		var cErr *CommonError
+		if !errors.As(err, &cErr) {
+			t.Errorf("should return *CommonError but %T", err)
+			return
+		}
+		if cErr.ErrCode != 40001 || cErr.ErrMsg != "invalid credential" {
+			t.Errorf("bad errcode or errmsg: %d %s", cErr.ErrCode, cErr.ErrMsg)
+		}
+	}
+
+	if err := DecodeWithCommonError([]byte(`{"errcode":"0","errmsg":"ok"}`), "GetUserInfo"); err != nil {
+		t.Errorf("DecodeWithCommonError should not return error: %v", err)
+	}
+}
+
+// errTokenExpired 测试用的自定义错误
+var errTokenExpired = errors.New("token expired")
+
+func TestSetErrorMapper(t *testing.T) {
+	SetErrorMapper(func(code int64, _ string) error {
+		if code == 40001 {
+			return errTokenExpired
+		}
+		return nil
+	})
+	defer SetErrorMapper(nil)
+
+	response := []byte(`{"errcode":40001,"errmsg":"invalid credential, access_token is invalid or not latest rid: 5f8e2c1a-1b2c3d4e-5f6a7b8c"}`)
+	err := DecodeWithCommonError(response, "Test")
+	assert.Equal(t, "token expired", err.Error())
+	assert.True(t, errors.Is(err, errTokenExpired))
+	// 仍可取得原始的 CommonError
+	var commonErr *CommonError
+	if assert.True(t, errors.As(err, &commonErr)) {
+		assert.Equal(t, int64(40001), commonErr.ErrCode.Int64())
+		assert.Equal(t, "5f8e2c1a-1b2c3d4e-5f6a7b8c", commonErr.RID)
+	}
+
+	var res struct {
+		CommonError
+	}
+	err = DecodeWithError(response, &res, "Test")
+	assert.True(t, errors.Is(err, errTokenExpired))
+
+	// 未映射的错误码返回默认的 CommonError
+	err = DecodeWithCommonError([]byte(`{"errcode":45009,"errmsg":"reach max api daily quota limit"}`), "Test")
+	_, ok := err.(*CommonError)
+	assert.True(t, ok)
+	assert.True(t, IsQuotaExceeded(err))
+
+	assert.NoError(t, DecodeWithCommonError([]byte(`{"errcode":0,"errmsg":"ok"}`), "Test"))
+}
@@ -13,6 +13,8 @@ import (
 	"mime/multipart"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
 
 	"golang.org/x/crypto/pkcs12"
 )
@@ -20,16 +22,57 @@ import (
 // URIModifier URI修改器
 type URIModifier func(uri string) string
 
-var uriModifier URIModifier
+// DefaultHTTPClient 默认httpClient，不跟随重定向，返回 3xx 状态码时请求返回 ErrUnexpectedRedirect 错误
+// 仅可在发起请求前设置，运行期间修改请使用 SetHTTPClient
+var DefaultHTTPClient = &http.Client{CheckRedirect: NoFollowRedirect}
 
-// DefaultHTTPClient 默认httpClient
-var DefaultHTTPClient = http.DefaultClient
+// NoFollowRedirect 不跟随重定向，直接返回 3xx 响应，可用作 http.Client.CheckRedirect
+func NoFollowRedirect(*http.Request, []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+var (
+	// httpSettingsLock 保护运行期间可修改的 uriModifier 及 httpClient
+	httpSettingsLock sync.RWMutex
+	uriModifier      URIModifier
+	httpClient       *http.Client
+)
 
-// SetURIModifier 设置URI修改器
+// SetURIModifier 设置URI修改器，可在请求进行中安全调用
 func SetURIModifier(fn URIModifier) {
+	httpSettingsLock.Lock()
+	defer httpSettingsLock.Unlock()
 	uriModifier = fn
 }
 
+// SetHTTPClient 设置请求使用的 httpClient，可在请求进行中安全调用，设置后优先于 DefaultHTTPClient
+func SetHTTPClient(client *http.Client) {
+	httpSettingsLock.Lock()
+	defer httpSettingsLock.Unlock()
+	httpClient = client
+}
+
+// GetHTTPClient 返回当前使用的 httpClient，即 SetHTTPClient 设置的 httpClient，未设置时为 DefaultHTTPClient
+func GetHTTPClient() *http.Client {
+	httpSettingsLock.RLock()
+	defer httpSettingsLock.RUnlock()
+	if httpClient != nil {
+		return httpClient
+	}
+	return DefaultHTTPClient
+}
+
+// modifyURI 使用 URI 修改器处理 uri
+func modifyURI(uri string) string {
+	httpSettingsLock.RLock()
+	fn := uriModifier
+	httpSettingsLock.RUnlock()
+	if fn != nil {
+		return fn(uri)
+	}
+	return uri
+}
+
 // HTTPGet get 请求
 func HTTPGet(uri string) ([]byte, error) {
 	return HTTPGetContext(context.Background(), uri)
@@ -37,23 +80,64 @@ func HTTPGet(uri string) ([]byte, error) {
 
 // HTTPGetContext get 请求
 func HTTPGetContext(ctx context.Context, uri string) ([]byte, error) {
-	if uriModifier != nil {
-		uri = uriModifier(uri)
-	}
+	uri = modifyURI(uri)
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return nil, err
 	}
-	response, err := DefaultHTTPClient.Do(request)
+	response, err := doRequest(request)
 	if err != nil {
 		return nil, err
 	}
 
-	defer response.Body.Close()
+	defer drainAndClose(response.Body)
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http get error : uri=%v , statusCode=%v", uri, response.StatusCode)
+		return nil, newHTTPError("get", uri, response)
 	}
-	return io.ReadAll(response.Body)
+	return readAll(response.Body)
+}
+
+// HTTPGetBytes get 请求，返回原始响应内容及 Content-Type，适用于图片、媒体文件等二进制接口
+func HTTPGetBytes(uri string) ([]byte, string, error) {
+	return HTTPGetBytesContext(context.Background(), uri)
+}
+
+// HTTPGetBytesContext get 请求，返回原始响应内容及 Content-Type，适用于图片、媒体文件等二进制接口
+func HTTPGetBytesContext(ctx context.Context, uri string) ([]byte, string, error) {
+	uri = modifyURI(uri)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	response, err := doRequest(request)
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer drainAndClose(response.Body)
+	if response.StatusCode != http.StatusOK {
+		return nil, "", newHTTPError("get", uri, response)
+	}
+	responseData, err := readAll(response.Body)
+	return responseData, response.Header.Get("Content-Type"), err
+}
+
+// IsJSONContentType 判断 Content-Type 是否为 json
+func IsJSONContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/json")
+}
+
+// IsJSONResponse 判断二进制接口的响应是否为 json 格式的错误信息
+// 微信出错时返回 application/json 或 text/plain 格式的 json，text/plain 时需根据内容判断，以免将正常的文本文件当作错误解析
+func IsJSONResponse(contentType string, body []byte) bool {
+	if IsJSONContentType(contentType) {
+		return true
+	}
+	if !strings.HasPrefix(contentType, "text/plain") {
+		return false
+	}
+	body = bytes.TrimSpace(body)
+	return len(body) > 0 && body[0] == '{' && json.Valid(body)
 }
 
 // HTTPPost post 请求
@@ -63,9 +147,7 @@ func HTTPPost(uri string, data string) ([]byte, error) {
 
 // HTTPPostContext post 请求
 func HTTPPostContext(ctx context.Context, uri string, data []byte, header map[string]string) ([]byte, error) {
-	if uriModifier != nil {
-		uri = uriModifier(uri)
-	}
+	uri = modifyURI(uri)
 	body := bytes.NewBuffer(data)
 	request, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, body)
 	if err != nil {
@@ -76,23 +158,21 @@ func HTTPPostContext(ctx context.Context, uri string, data []byte, header map[st
 		request.Header.Set(key, value)
 	}
 
-	response, err := DefaultHTTPClient.Do(request)
+	response, err := doRequest(request)
 	if err != nil {
 		return nil, err
 	}
 
-	defer response.Body.Close()
+	defer drainAndClose(response.Body)
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http post error : uri=%v , statusCode=%v", uri, response.StatusCode)
+		return nil, newHTTPError("post", uri, response)
 	}
-	return io.ReadAll(response.Body)
+	return readAll(response.Body)
 }
 
 // PostJSONContext post json 数据请求
 func PostJSONContext(ctx context.Context, uri string, obj interface{}) ([]byte, error) {
-	if uriModifier != nil {
-		uri = uriModifier(uri)
-	}
+	uri = modifyURI(uri)
 	jsonBuf := new(bytes.Buffer)
 	enc := json.NewEncoder(jsonBuf)
 	enc.SetEscapeHTML(false)
@@ -105,16 +185,16 @@ func PostJSONContext(ctx context.Context, uri string, obj interface{}) ([]byte,
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json;charset=utf-8")
-	response, err := DefaultHTTPClient.Do(req)
+	response, err := doRequest(req)
 	if err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
+	defer drainAndClose(response.Body)
 
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http get error : uri=%v , statusCode=%v", uri, response.StatusCode)
+		return nil, newHTTPError("post", uri, response)
 	}
-	return io.ReadAll(response.Body)
+	return readAll(response.Body)
 }
 
 // PostJSON post json 数据请求
@@ -132,16 +212,22 @@ func PostJSONWithRespContentType(uri string, obj interface{}) ([]byte, string, e
 		return nil, "", err
 	}
 
-	response, err := DefaultHTTPClient.Post(uri, "application/json;charset=utf-8", jsonBuf)
+	uri = modifyURI(uri)
+	request, err := http.NewRequest(http.MethodPost, uri, jsonBuf)
 	if err != nil {
 		return nil, "", err
 	}
-	defer response.Body.Close()
+	request.Header.Set("Content-Type", "application/json;charset=utf-8")
+	response, err := doRequest(request)
+	if err != nil {
+		return nil, "", err
+	}
+	defer drainAndClose(response.Body)
 
 	if response.StatusCode != http.StatusOK {
-		return nil, "", fmt.Errorf("http get error : uri=%v , statusCode=%v", uri, response.StatusCode)
+		return nil, "", newHTTPError("post", uri, response)
 	}
-	responseData, err := io.ReadAll(response.Body)
+	responseData, err := readAll(response.Body)
 	contentType := response.Header.Get("Content-Type")
 	return responseData, contentType, err
 }
@@ -198,9 +284,7 @@ type MultipartFormField struct {
 
 // PostMultipartForm 上传文件或其他多个字段
 func PostMultipartForm(fields []MultipartFormField, uri string) (respBody []byte, err error) {
-	if uriModifier != nil {
-		uri = uriModifier(uri)
-	}
+	uri = modifyURI(uri)
 	bodyBuf := &bytes.Buffer{}
 	bodyWriter := multipart.NewWriter(bodyBuf)
 
@@ -244,58 +328,56 @@ func PostMultipartForm(fields []MultipartFormField, uri string) (respBody []byte
 	contentType := bodyWriter.FormDataContentType()
 	bodyWriter.Close()
 
-	resp, e := DefaultHTTPClient.Post(uri, contentType, bodyBuf)
-	if e != nil {
-		err = e
+	request, err := http.NewRequest(http.MethodPost, uri, bodyBuf)
+	if err != nil {
+		return
+	}
+	request.Header.Set("Content-Type", contentType)
+	resp, err := doRequest(request)
+	if err != nil {
 		return
 	}
-	defer resp.Body.Close()
+	defer drainAndClose(resp.Body)
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http code error : uri=%v , statusCode=%v", uri, resp.StatusCode)
+		return nil, newHTTPError("code", uri, resp)
 	}
-	respBody, err = io.ReadAll(resp.Body)
+	respBody, err = readAll(resp.Body)
 	return
 }
 
 // PostXML perform a HTTP/POST request with XML body
 func PostXML(uri string, obj interface{}) ([]byte, error) {
-	if uriModifier != nil {
-		uri = uriModifier(uri)
-	}
-	xmlData, err := xml.Marshal(obj)
-	if err != nil {
-		return nil, err
-	}
-
-	body := bytes.NewBuffer(xmlData)
-	response, err := DefaultHTTPClient.Post(uri, "application/xml;charset=utf-8", body)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http code error : uri=%v , statusCode=%v", uri, response.StatusCode)
-	}
-	return io.ReadAll(response.Body)
+	return postXMLWithClient(GetHTTPClient(), uri, obj)
 }
 
 // httpWithTLS CA 证书
 func httpWithTLS(rootCa, key string) (*http.Client, error) {
-	var client *http.Client
 	certData, err := os.ReadFile(rootCa)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find cert path=%s, error=%v", rootCa, err)
 	}
-	cert := pkcs12ToPem(certData, key)
-	config := &tls.Config{
-		Certificates: []tls.Certificate{cert},
+	return httpWithCertificate(pkcs12ToPem(certData, key)), nil
+}
+
+// httpWithCertificate 返回使用客户端证书进行双向 TLS 认证的 httpClient，保留当前 httpClient 的其他 TLS 配置（如 RootCAs）
+func httpWithCertificate(cert tls.Certificate) *http.Client {
+	trans, ok := GetHTTPClient().Transport.(*http.Transport)
+	if !ok {
+		trans, ok = http.DefaultTransport.(*http.Transport)
+	}
+	if ok {
+		trans = trans.Clone()
+	} else {
+		trans = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	config := &tls.Config{}
+	if trans.TLSClientConfig != nil {
+		config = trans.TLSClientConfig.Clone()
 	}
-	trans := (DefaultHTTPClient.Transport.(*http.Transport)).Clone()
+	config.Certificates = []tls.Certificate{cert}
 	trans.TLSClientConfig = config
 	trans.DisableCompression = true
-	client = &http.Client{Transport: trans}
-	return client, nil
+	return &http.Client{Transport: trans, CheckRedirect: NoFollowRedirect}
 }
 
 // pkcs12ToPem 将 Pkcs12 转成 Pem
@@ -322,27 +404,38 @@ func pkcs12ToPem(p12 []byte, password string) tls.Certificate {
 
 // PostXMLWithTLS perform a HTTP/POST request with XML body and TLS
 func PostXMLWithTLS(uri string, obj interface{}, ca, key string) ([]byte, error) {
-	if uriModifier != nil {
-		uri = uriModifier(uri)
+	client, err := httpWithTLS(ca, key)
+	if err != nil {
+		return nil, err
 	}
+	return postXMLWithClient(client, uri, obj)
+}
+
+// PostXMLWithCertificate 使用客户端证书（如商户 API 证书 apiclient_cert.pem/apiclient_key.pem）进行双向 TLS 认证的 XML POST 请求
+func PostXMLWithCertificate(uri string, obj interface{}, cert tls.Certificate) ([]byte, error) {
+	return postXMLWithClient(httpWithCertificate(cert), uri, obj)
+}
+
+func postXMLWithClient(client *http.Client, uri string, obj interface{}) ([]byte, error) {
+	uri = modifyURI(uri)
 	xmlData, err := xml.Marshal(obj)
 	if err != nil {
 		return nil, err
 	}
 
-	body := bytes.NewBuffer(xmlData)
-	client, err := httpWithTLS(ca, key)
+	request, err := http.NewRequest(http.MethodPost, uri, bytes.NewBuffer(xmlData))
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Post(uri, "application/xml;charset=utf-8", body)
+	request.Header.Set("Content-Type", "application/xml;charset=utf-8")
+	response, err := doRequestWithClient(client, request)
 	if err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
+	defer drainAndClose(response.Body)
 
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http code error : uri=%v , statusCode=%v", uri, response.StatusCode)
+		return nil, newHTTPError("code", uri, response)
 	}
-	return io.ReadAll(response.Body)
+	return readAll(response.Body)
 }
@@ -0,0 +1,71 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestHTTPGetBytesContext(t *testing.T) {
+	defer gock.Off()
+	jpeg := "\xff\xd8\xff\xe0mock"
+	gock.New("https://api.weixin.qq.com").Get("/mock/image").
+		Reply(200).SetHeader("Content-Type", "image/jpeg").BodyString(jpeg)
+	gock.New("https://api.weixin.qq.com").Get("/mock/error").
+		Reply(200).JSON(map[string]interface{}{"errcode": 40007, "errmsg": "invalid media_id"})
+
+	data, contentType, err := HTTPGetBytesContext(context.Background(), "https://api.weixin.qq.com/mock/image")
+	assert.Nil(t, err)
+	assert.Equal(t, "image/jpeg", contentType)
+	assert.Equal(t, []byte(jpeg), data)
+	assert.False(t, IsJSONContentType(contentType))
+
+	data, contentType, err = HTTPGetBytesContext(context.Background(), "https://api.weixin.qq.com/mock/error")
+	assert.Nil(t, err)
+	assert.True(t, IsJSONContentType(contentType))
+	assert.EqualError(t, DecodeWithCommonError(data, "GetMedia"), "GetMedia Error , errcode=40007 , errmsg=invalid media_id")
+}
+
+// TestSetHTTPClientConcurrent 请求进行中修改 httpClient 及 URI 修改器，需配合 -race 运行
+func TestSetHTTPClientConcurrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode":0}`)
+	}))
+	defer server.Close()
+	defer SetHTTPClient(nil)
+	defer SetURIModifier(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				_, err := HTTPGet(server.URL)
+				assert.Nil(t, err)
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		SetHTTPClient(&http.Client{Timeout: time.Second})
+		SetURIModifier(func(uri string) string { return uri })
+	}
+	wg.Wait()
+}
+
+func TestIsJSONResponse(t *testing.T) {
+	assert.True(t, IsJSONResponse("application/json; charset=utf-8", []byte(`{"errcode":40007}`)))
+	assert.True(t, IsJSONResponse("text/plain", []byte(` {"errcode":40007,"errmsg":"invalid media_id"}`)))
+	// 正常的文本文件
+	assert.False(t, IsJSONResponse("text/plain", []byte("hello, world")))
+	assert.False(t, IsJSONResponse("text/plain", []byte("{not json")))
+	assert.False(t, IsJSONContentType("text/plain"))
+	assert.False(t, IsJSONResponse("image/jpeg", []byte(`{"errcode":40007}`)))
+}
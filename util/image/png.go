@@ -0,0 +1,69 @@
+// Package image 图片相关的辅助方法
+package image
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// pngSignature PNG 文件头
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// PNG 颜色类型，见 PNG 规范 IHDR 部分
+const (
+	pngColorTypeGrayAlpha = 4
+	pngColorTypeRGBA      = 6
+)
+
+// pngChunk PNG 数据块
+type pngChunk struct {
+	typ  string
+	data []byte
+}
+
+// readPNGChunks 按顺序读取 PNG 数据块，格式不合法时返回 false
+func readPNGChunks(data []byte) ([]pngChunk, bool) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, false
+	}
+	var chunks []pngChunk
+	data = data[len(pngSignature):]
+	for len(data) >= 12 {
+		length := binary.BigEndian.Uint32(data[:4])
+		if uint64(len(data)) < 12+uint64(length) {
+			return nil, false
+		}
+		chunk := pngChunk{typ: string(data[4:8]), data: data[8 : 8+length]}
+		chunks = append(chunks, chunk)
+		data = data[12+length:]
+		if chunk.typ == "IEND" {
+			return chunks, true
+		}
+	}
+	return nil, false
+}
+
+// IsPNG 判断数据是否为完整的 PNG 图片（文件头、IHDR 及 IEND 数据块），不解码像素数据
+func IsPNG(data []byte) bool {
+	chunks, ok := readPNGChunks(data)
+	return ok && len(chunks) > 0 && chunks[0].typ == "IHDR" && len(chunks[0].data) == 13
+}
+
+// IsTransparentPNG 判断数据是否为带透明通道的 PNG 图片，
+// 即颜色类型包含 alpha 通道或存在 tRNS 透明数据块，如 is_hyaline 为 true 时生成的小程序码
+func IsTransparentPNG(data []byte) bool {
+	if !IsPNG(data) {
+		return false
+	}
+	chunks, _ := readPNGChunks(data)
+	switch chunks[0].data[9] {
+	case pngColorTypeGrayAlpha, pngColorTypeRGBA:
+		return true
+	}
+	for _, chunk := range chunks {
+		if chunk.typ == "tRNS" {
+			return true
+		}
+	}
+	return false
+}
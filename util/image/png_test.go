@@ -0,0 +1,48 @@
+package image
+
+import (
+	"bytes"
+	stdimage "image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodePNG(t *testing.T, img stdimage.Image) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestIsTransparentPNG(t *testing.T) {
+	// 透明底色的小程序码
+	hyaline := stdimage.NewNRGBA(stdimage.Rect(0, 0, 4, 4))
+	hyaline.Set(1, 1, color.NRGBA{A: 255})
+	hyalineData := encodePNG(t, hyaline)
+	assert.True(t, IsPNG(hyalineData))
+	assert.True(t, IsTransparentPNG(hyalineData))
+
+	// 不透明的小程序码
+	opaque := stdimage.NewGray(stdimage.Rect(0, 0, 4, 4))
+	opaque.Set(1, 1, color.Gray{Y: 255})
+	opaqueData := encodePNG(t, opaque)
+	assert.True(t, IsPNG(opaqueData))
+	assert.False(t, IsTransparentPNG(opaqueData))
+
+	// 带 tRNS 数据块的调色板图片
+	paletted := stdimage.NewPaletted(stdimage.Rect(0, 0, 4, 4), color.Palette{color.NRGBA{}, color.NRGBA{A: 255}})
+	assert.True(t, IsTransparentPNG(encodePNG(t, paletted)))
+}
+
+func TestIsPNGInvalid(t *testing.T) {
+	assert.False(t, IsPNG([]byte(`{"errcode":40001,"errmsg":"invalid credential"}`)))
+	assert.False(t, IsTransparentPNG(nil))
+
+	data := encodePNG(t, stdimage.NewNRGBA(stdimage.Rect(0, 0, 4, 4)))
+	// 截断的图片
+	assert.False(t, IsPNG(data[:len(data)-6]))
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// FlexInt64 兼容以数字或字符串表示的整数字段，如网关异常时以字符串返回的 errcode
+type FlexInt64 int64
+
+// UnmarshalJSON 实现 json.Unmarshaler
+func (i *FlexInt64) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*i = 0
+			return nil
+		}
+		data = []byte(s)
+	}
+	v, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid FlexInt64 value: %s", data)
+	}
+	*i = FlexInt64(v)
+	return nil
+}
+
+// Int64 返回对应的 int64 值
+func (i FlexInt64) Int64() int64 {
+	return int64(i)
+}
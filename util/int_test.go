@@ -0,0 +1,27 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexInt64(t *testing.T) {
+	for data, expect := range map[string]int64{
+		`40001`:   40001,
+		`"40001"`: 40001,
+		`-1`:      -1,
+		`"-1"`:    -1,
+		`""`:      0,
+		`null`:    0,
+	} {
+		var v FlexInt64
+		assert.Nil(t, json.Unmarshal([]byte(data), &v), data)
+		assert.Equal(t, expect, v.Int64(), data)
+	}
+
+	var v FlexInt64
+	assert.NotNil(t, json.Unmarshal([]byte(`"abc"`), &v))
+	assert.NotNil(t, json.Unmarshal([]byte(`1.5`), &v))
+}
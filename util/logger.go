@@ -0,0 +1,110 @@
+package util
+
+import (
+	"errors"
+	"net/url"
+	"sync"
+)
+
+// LogLevel 日志级别
+type LogLevel string
+
+const (
+	// LogLevelDebug 调试信息，如每次请求的耗时
+	LogLevelDebug LogLevel = "debug"
+	// LogLevelInfo 一般信息
+	LogLevelInfo LogLevel = "info"
+	// LogLevelWarn 微信接口返回错误码
+	LogLevelWarn LogLevel = "warn"
+	// LogLevelError 请求失败或返回非 2xx 状态码
+	LogLevelError LogLevel = "error"
+)
+
+// StructuredLogger 结构化日志接口，fields 为本条日志的键值对，可直接对接 zap、logrus 等日志库
+//
+// 请求日志包含以下字段：
+//   - operation 操作名，见 WithOperation
+//   - endpoint 请求地址的 host+path，不含 query 以免泄露 access_token
+//   - method HTTP 方法
+//   - status HTTP 状态码，请求失败时为 0
+//   - duration 请求耗时
+//   - appid 请求地址中带有 appid 参数时记录
+//   - error 请求失败时的错误
+//
+// 接口错误日志包含 api、errcode、errmsg 及 rid 字段
+type StructuredLogger interface {
+	Log(level LogLevel, msg string, fields map[string]interface{})
+}
+
+var (
+	loggerLock       sync.RWMutex
+	structuredLogger StructuredLogger
+)
+
+// SetStructuredLogger 设置结构化日志，可在请求进行中安全调用，传 nil 取消
+func SetStructuredLogger(logger StructuredLogger) {
+	loggerLock.Lock()
+	defer loggerLock.Unlock()
+	structuredLogger = logger
+}
+
+func getStructuredLogger() StructuredLogger {
+	loggerLock.RLock()
+	defer loggerLock.RUnlock()
+	return structuredLogger
+}
+
+// logRequest 记录一次请求
+func logRequest(logger StructuredLogger, info RequestInfo, endpoint, appID string) {
+	fields := map[string]interface{}{
+		"operation": info.Operation,
+		"endpoint":  endpoint,
+		"method":    info.Method,
+		"status":    info.StatusCode,
+		"duration":  info.Duration,
+	}
+	if appID != "" {
+		fields["appid"] = appID
+	}
+	level := LogLevelDebug
+	switch {
+	case info.Err != nil:
+		level = LogLevelError
+		fields["error"] = logError(info.Err, endpoint)
+	case info.StatusCode < 200 || info.StatusCode >= 300:
+		level = LogLevelError
+	}
+	logger.Log(level, "wechat request", fields)
+}
+
+// logError 返回不含 query 的错误信息，net/http 返回的 *url.Error 中带有完整的请求地址，需替换为 endpoint
+func logError(err error, endpoint string) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Op + " " + endpoint + ": " + urlErr.Err.Error()
+	}
+	return err.Error()
+}
+
+// redactError 将 net/http 返回的 *url.Error 中的请求地址替换为 endpoint，避免 access_token 等 query 参数泄露给请求观测回调
+func redactError(err error, endpoint string) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return &url.Error{Op: urlErr.Op, URL: endpoint, Err: urlErr.Err}
+	}
+	return err
+}
+
+// logAPIError 记录微信接口返回的错误码
+func logAPIError(commonErr *CommonError) {
+	logger := getStructuredLogger()
+	if logger == nil {
+		return
+	}
+	logger.Log(LogLevelWarn, "wechat api error", map[string]interface{}{
+		"api":     commonErr.apiName,
+		"errcode": commonErr.ErrCode.Int64(),
+		"errmsg":  commonErr.ErrMsg,
+		"rid":     commonErr.RID,
+	})
+}
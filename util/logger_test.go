@@ -0,0 +1,86 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+type logEntry struct {
+	level  LogLevel
+	msg    string
+	fields map[string]interface{}
+}
+
+// capturingLogger 记录所有日志
+type capturingLogger struct {
+	entries []logEntry
+}
+
+func (l *capturingLogger) Log(level LogLevel, msg string, fields map[string]interface{}) {
+	l.entries = append(l.entries, logEntry{level, msg, fields})
+}
+
+func TestStructuredLogger(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/sns/jscode2session").
+		Reply(200).JSON(map[string]interface{}{"errcode": 40029, "errmsg": "invalid code, rid: 6405a3c8-1d2e"})
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").
+		Reply(500)
+
+	logger := &capturingLogger{}
+	SetStructuredLogger(logger)
+	defer SetStructuredLogger(nil)
+
+	response, err := HTTPGetContext(WithOperation(context.Background(), "auth.Code2Session"), "https://api.weixin.qq.com/sns/jscode2session?appid=mock-appid&secret=mock-secret&js_code=mock-code")
+	assert.NoError(t, err)
+	assert.Error(t, DecodeWithCommonError(response, "Code2Session"))
+	_, err = HTTPGet("https://api.weixin.qq.com/cgi-bin/user/info?access_token=mock-access-token")
+	assert.Error(t, err)
+
+	// 连接失败时 net/http 返回的错误中带有完整的请求地址
+	gock.New("http://127.0.0.1:1").Get("/cgi-bin/user/info").
+		ReplyError(errors.New("dial tcp 127.0.0.1:1: connect: connection refused"))
+	_, err = HTTPGet("http://127.0.0.1:1/cgi-bin/user/info?access_token=mock-access-token")
+	assert.Error(t, err)
+
+	if assert.Len(t, logger.entries, 4) {
+		request := logger.entries[0]
+		assert.Equal(t, LogLevelDebug, request.level)
+		assert.Equal(t, "auth.Code2Session", request.fields["operation"])
+		assert.Equal(t, "api.weixin.qq.com/sns/jscode2session", request.fields["endpoint"])
+		assert.Equal(t, "GET", request.fields["method"])
+		assert.Equal(t, 200, request.fields["status"])
+		assert.Equal(t, "mock-appid", request.fields["appid"])
+		assert.IsType(t, time.Duration(0), request.fields["duration"])
+
+		apiErr := logger.entries[1]
+		assert.Equal(t, LogLevelWarn, apiErr.level)
+		assert.Equal(t, map[string]interface{}{
+			"api":     "Code2Session",
+			"errcode": int64(40029),
+			"errmsg":  "invalid code, rid: 6405a3c8-1d2e",
+			"rid":     "6405a3c8-1d2e",
+		}, apiErr.fields)
+
+		failed := logger.entries[2]
+		assert.Equal(t, LogLevelError, failed.level)
+		assert.Equal(t, 500, failed.fields["status"])
+		assert.NotContains(t, failed.fields, "appid")
+
+		transportErr := logger.entries[3]
+		assert.Equal(t, LogLevelError, transportErr.level)
+		assert.Equal(t, 0, transportErr.fields["status"])
+		assert.Equal(t, "Get 127.0.0.1:1/cgi-bin/user/info: dial tcp 127.0.0.1:1: connect: connection refused", transportErr.fields["error"])
+		for _, entry := range logger.entries {
+			for _, value := range entry.fields {
+				assert.NotContains(t, fmt.Sprint(value), "mock-access-token")
+			}
+		}
+	}
+}
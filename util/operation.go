@@ -0,0 +1,118 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// operationKey context 中保存操作名的 key
+type operationKey struct{}
+
+// WithOperation 在 ctx 中设置本次请求的操作名（如 "user.GetUserInfo"），用作监控指标标签及链路追踪的 span 名
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFromContext 返回 ctx 中的操作名，未设置时返回空字符串
+func OperationFromContext(ctx context.Context) string {
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
+
+// responseRecorderKey context 中保存 ResponseRecorder 的 key
+type responseRecorderKey struct{}
+
+// ResponseRecorder 记录请求的响应状态码及响应头
+// 微信业务错误（errcode 不为 0）的 HTTP 状态码为 200，解析错误时已无法取得响应头，需要排查时可通过 WithResponseRecorder 记录
+type ResponseRecorder struct {
+	StatusCode int
+	Header     http.Header
+}
+
+// WithResponseRecorder 在 ctx 中设置 ResponseRecorder，使用该 ctx 发起的请求完成后将响应状态码及响应头写入 recorder
+// 同一 ctx 发起多次请求时记录最后一次的响应
+func WithResponseRecorder(ctx context.Context, recorder *ResponseRecorder) context.Context {
+	return context.WithValue(ctx, responseRecorderKey{}, recorder)
+}
+
+// recordResponse 将响应写入 ctx 中的 ResponseRecorder
+func recordResponse(ctx context.Context, response *http.Response) {
+	if response == nil {
+		return
+	}
+	if recorder, ok := ctx.Value(responseRecorderKey{}).(*ResponseRecorder); ok && recorder != nil {
+		recorder.StatusCode = response.StatusCode
+		recorder.Header = response.Header
+	}
+}
+
+// RequestInfo 一次请求的观测信息
+type RequestInfo struct {
+	// Operation 操作名，未通过 WithOperation 设置时为请求地址的 host+path（不含 query）
+	Operation  string
+	Method     string
+	StatusCode int
+	Duration   time.Duration
+	// Err 请求错误，其中的请求地址同样为 host+path（不含 query）
+	Err error
+}
+
+// RequestObserver 请求观测回调，可用于上报监控指标或链路追踪
+type RequestObserver func(ctx context.Context, info RequestInfo)
+
+var (
+	observerLock    sync.RWMutex
+	requestObserver RequestObserver
+)
+
+// SetRequestObserver 设置请求观测回调，可在请求进行中安全调用，传 nil 取消
+func SetRequestObserver(fn RequestObserver) {
+	observerLock.Lock()
+	defer observerLock.Unlock()
+	requestObserver = fn
+}
+
+// doRequest 使用当前 httpClient 发起请求，并将结果通知请求观测回调及结构化日志
+func doRequest(request *http.Request) (*http.Response, error) {
+	return doRequestWithClient(GetHTTPClient(), request)
+}
+
+// doRequestWithClient 使用指定的 httpClient（如双向 TLS 认证的 httpClient）发起请求，其余同 doRequest
+func doRequestWithClient(client *http.Client, request *http.Request) (*http.Response, error) {
+	observerLock.RLock()
+	fn := requestObserver
+	observerLock.RUnlock()
+	logger := getStructuredLogger()
+	ctx := request.Context()
+	if fn == nil && logger == nil {
+		response, err := client.Do(request)
+		recordResponse(ctx, response)
+		return response, err
+	}
+
+	start := time.Now()
+	response, err := client.Do(request)
+	recordResponse(ctx, response)
+	endpoint := request.URL.Host + request.URL.Path
+	info := RequestInfo{
+		Operation: OperationFromContext(ctx),
+		Method:    request.Method,
+		Duration:  time.Since(start),
+		Err:       redactError(err, endpoint),
+	}
+	if info.Operation == "" {
+		info.Operation = endpoint
+	}
+	if response != nil {
+		info.StatusCode = response.StatusCode
+	}
+	if fn != nil {
+		fn(ctx, info)
+	}
+	if logger != nil {
+		logRequest(logger, info, endpoint, request.URL.Query().Get("appid"))
+	}
+	return response, err
+}
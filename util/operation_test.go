@@ -0,0 +1,142 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRequestObserverOperation(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").Times(2).
+		Reply(200).JSON(map[string]interface{}{"errcode": 0})
+
+	var infos []RequestInfo
+	SetRequestObserver(func(_ context.Context, info RequestInfo) {
+		infos = append(infos, info)
+	})
+	defer SetRequestObserver(nil)
+
+	uri := "https://api.weixin.qq.com/cgi-bin/user/info?access_token=mock-access-token&openid=mock-openid"
+	_, err := HTTPGetContext(WithOperation(context.Background(), "user.GetUserInfo"), uri)
+	assert.NoError(t, err)
+	_, err = HTTPGet(uri)
+	assert.NoError(t, err)
+
+	if assert.Len(t, infos, 2) {
+		assert.Equal(t, "user.GetUserInfo", infos[0].Operation)
+		assert.Equal(t, "GET", infos[0].Method)
+		assert.Equal(t, 200, infos[0].StatusCode)
+		assert.NoError(t, infos[0].Err)
+		// 未设置操作名时使用不含 query 的请求地址
+		assert.Equal(t, "api.weixin.qq.com/cgi-bin/user/info", infos[1].Operation)
+	}
+}
+
+// TestRequestObserverRedactError 传给请求观测回调的错误中不含 access_token
+func TestRequestObserverRedactError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").
+		ReplyError(errors.New("connection reset"))
+
+	var infos []RequestInfo
+	SetRequestObserver(func(_ context.Context, info RequestInfo) {
+		infos = append(infos, info)
+	})
+	defer SetRequestObserver(nil)
+
+	_, err := HTTPGet("https://api.weixin.qq.com/cgi-bin/user/info?access_token=mock-access-token&openid=mock-openid")
+	assert.Error(t, err)
+	if assert.Len(t, infos, 1) && assert.Error(t, infos[0].Err) {
+		assert.NotContains(t, infos[0].Err.Error(), "access_token")
+		assert.Contains(t, infos[0].Err.Error(), "api.weixin.qq.com/cgi-bin/user/info")
+		assert.Contains(t, infos[0].Err.Error(), "connection reset")
+	}
+}
+
+func TestOperationFromContext(t *testing.T) {
+	assert.Equal(t, "", OperationFromContext(context.Background()))
+	assert.Equal(t, "user.GetUserInfo", OperationFromContext(WithOperation(context.Background(), "user.GetUserInfo")))
+}
+
+// TestResponseHeaderOnError 非 200 状态码的 HTTPError 及业务错误均可取得响应头
+func TestResponseHeaderOnError(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/user/info").
+		Reply(503).SetHeader("Date", "Mon, 02 Jan 2006 15:04:05 GMT").SetHeader("Retry-After", "3")
+	gock.New("https://api.weixin.qq.com").Post("/cgi-bin/user/info/updateremark").
+		Reply(200).SetHeader("X-Request-Id", "mock-request-id").
+		JSON(map[string]interface{}{"errcode": 45009, "errmsg": "reach max api daily quota limit"})
+
+	_, err := HTTPGet("https://api.weixin.qq.com/cgi-bin/user/info")
+	var httpErr *HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, "Mon, 02 Jan 2006 15:04:05 GMT", httpErr.Header.Get("Date"))
+		assert.Equal(t, "3", httpErr.Header.Get("Retry-After"))
+	}
+
+	var recorder ResponseRecorder
+	response, err := PostJSONContext(WithResponseRecorder(context.Background(), &recorder),
+		"https://api.weixin.qq.com/cgi-bin/user/info/updateremark", map[string]string{})
+	assert.NoError(t, err)
+	assert.True(t, IsQuotaExceeded(DecodeWithCommonError(response, "UpdateRemark")))
+	assert.Equal(t, 200, recorder.StatusCode)
+	assert.Equal(t, "mock-request-id", recorder.Header.Get("X-Request-Id"))
+}
+
+// TestRequestObserverAllHelpers 所有请求方法均通知请求观测回调
+func TestRequestObserverAllHelpers(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Post("/mock/json").Reply(200).JSON(map[string]interface{}{"errcode": 0})
+	gock.New("https://api.weixin.qq.com").Post("/mock/media").Reply(200).JSON(map[string]interface{}{"errcode": 0})
+	gock.New("https://api.mch.weixin.qq.com").Post("/mock/xml").Reply(200).BodyString("<xml></xml>")
+
+	var infos []RequestInfo
+	SetRequestObserver(func(_ context.Context, info RequestInfo) {
+		infos = append(infos, info)
+	})
+	defer SetRequestObserver(nil)
+
+	_, _, err := PostJSONWithRespContentType("https://api.weixin.qq.com/mock/json", map[string]string{})
+	assert.NoError(t, err)
+	_, err = PostFileByStream("media", "mock.txt", "https://api.weixin.qq.com/mock/media", []byte("mock"))
+	assert.NoError(t, err)
+	type xmlRequest struct {
+		XMLName struct{} `xml:"xml"`
+		AppID   string   `xml:"appid"`
+	}
+	_, err = PostXML("https://api.mch.weixin.qq.com/mock/xml", xmlRequest{AppID: "mock-appid"})
+	assert.NoError(t, err)
+	// 双向 TLS 认证等使用单独 httpClient 的请求
+	client := &http.Client{Transport: roundTripFunc(func(request *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader("<xml></xml>")), Request: request}, nil
+	})}
+	_, err = postXMLWithClient(client, "https://api.mch.weixin.qq.com/mock/tls", xmlRequest{AppID: "mock-appid"})
+	assert.NoError(t, err)
+
+	var operations []string
+	for _, info := range infos {
+		assert.Equal(t, "POST", info.Method)
+		assert.Equal(t, 200, info.StatusCode)
+		operations = append(operations, info.Operation)
+	}
+	assert.Equal(t, []string{
+		"api.weixin.qq.com/mock/json",
+		"api.weixin.qq.com/mock/media",
+		"api.mch.weixin.qq.com/mock/xml",
+		"api.mch.weixin.qq.com/mock/tls",
+	}, operations)
+}
+
+// roundTripFunc 以函数实现 http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return fn(request)
+}
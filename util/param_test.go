@@ -0,0 +1,55 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 微信支付签名文档示例，https://pay.weixin.qq.com/wiki/doc/api/jsapi.php?chapter=4_3
+const testPayKey = "192006250b4c09247ec02edce69f6a2d"
+
+func TestOrderParam(t *testing.T) {
+	p := map[string]string{
+		"nonce_str":   "ibuaiVcKdpRxkhJA",
+		"mch_id":      "10000100",
+		"sign":        "ignored",
+		"body":        "test",
+		"attach":      "",
+		"device_info": "1000",
+		"appid":       "wxd930ea5d5a258f4f",
+	}
+	assert.Equal(t,
+		"appid=wxd930ea5d5a258f4f&body=test&device_info=1000&mch_id=10000100&nonce_str=ibuaiVcKdpRxkhJA&key="+testPayKey,
+		OrderParam(p, "&key="+testPayKey))
+}
+
+func TestParamSignStable(t *testing.T) {
+	keys := []string{"appid", "mch_id", "device_info", "body", "nonce_str"}
+	values := map[string]string{
+		"appid":       "wxd930ea5d5a258f4f",
+		"mch_id":      "10000100",
+		"device_info": "1000",
+		"body":        "test",
+		"nonce_str":   "ibuaiVcKdpRxkhJA",
+	}
+	// 以不同的顺序构建参数，签名结果应保持一致
+	for i := range keys {
+		p := make(map[string]string, len(keys))
+		for j := range keys {
+			k := keys[(i+j)%len(keys)]
+			p[k] = values[k]
+		}
+		sign, err := ParamSign(p, testPayKey)
+		assert.NoError(t, err)
+		assert.Equal(t, "9A0A8659F005D6984697E2CA0A9CF3B7", sign)
+
+		p["sign_type"] = SignTypeHMACSHA256
+		sign, err = ParamSign(p, testPayKey)
+		assert.NoError(t, err)
+		assert.Equal(t, "2C9DF1156522C0B2B03B4DBF3BCA5CACB602CBD5CA0F9E112458CF3E9855303B", sign)
+	}
+
+	_, err := ParamSign(map[string]string{"sign_type": "SHA1"}, testPayKey)
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrCodeQuotaExceeded 接口调用超过限制（45009 reach max api daily quota limit）
+const ErrCodeQuotaExceeded = 45009
+
+// QuotaExceededPolicy 接口调用次数超过限制时的处理策略
+// 仅对通过 DoWithQuotaPolicy 调用的接口生效，并非所有接口都会应用该策略，见各配置中的说明
+type QuotaExceededPolicy int
+
+const (
+	// QuotaExceededFailFast 直接返回错误（默认）
+	QuotaExceededFailFast QuotaExceededPolicy = iota
+	// QuotaExceededBlock 阻塞等待至下一个额度周期（每日 0 点重置）后重试，等待期间响应 context 取消
+	QuotaExceededBlock
+	// QuotaExceededClearAndRetry 重置 API 调用次数后重试一次
+	QuotaExceededClearAndRetry
+)
+
+// untilNextQuotaWindow 返回距离下一个额度周期的时长
+var untilNextQuotaWindow = func() time.Duration {
+	// 微信接口额度按北京时间每日 0 点重置
+	now := time.Now().In(wechatLocation)
+	next := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, wechatLocation)
+	return next.Sub(now)
+}
+
+// IsQuotaExceeded 判断错误是否为接口调用超过限制
+func IsQuotaExceeded(err error) bool {
+	var commonErr *CommonError
+	return errors.As(err, &commonErr) && commonErr.ErrCode == ErrCodeQuotaExceeded
+}
+
+// DoWithQuotaPolicy 执行 call，当返回接口调用超过限制的错误时，按照 policy 进行处理
+// clearQuota 用于重置 API 调用次数，仅 QuotaExceededClearAndRetry 策略使用
+func DoWithQuotaPolicy(ctx context.Context, policy QuotaExceededPolicy, clearQuota func() error, call func() error) error {
+	err := call()
+	if err == nil || !IsQuotaExceeded(err) {
+		return err
+	}
+	switch policy {
+	case QuotaExceededBlock:
+		timer := time.NewTimer(untilNextQuotaWindow())
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+		return call()
+	case QuotaExceededClearAndRetry:
+		if clearQuota == nil {
+			return err
+		}
+		if clearErr := clearQuota(); clearErr != nil {
+			return clearErr
+		}
+		return call()
+	default:
+		return err
+	}
+}
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// quotaCall 第一次调用返回超过限制错误，之后调用成功
+func quotaCall(calls *int) func() error {
+	return func() error {
+		*calls++
+		if *calls == 1 {
+			return NewCommonError("Send", ErrCodeQuotaExceeded, "reach max api daily quota limit")
+		}
+		return nil
+	}
+}
+
+func TestDoWithQuotaPolicyFailFast(t *testing.T) {
+	var calls int
+	err := DoWithQuotaPolicy(context.Background(), QuotaExceededFailFast, nil, quotaCall(&calls))
+	assert.True(t, IsQuotaExceeded(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestDoWithQuotaPolicyBlock(t *testing.T) {
+	defer func(fn func() time.Duration) { untilNextQuotaWindow = fn }(untilNextQuotaWindow)
+	untilNextQuotaWindow = func() time.Duration { return time.Millisecond }
+
+	var calls int
+	err := DoWithQuotaPolicy(context.Background(), QuotaExceededBlock, nil, quotaCall(&calls))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+
+	// 等待期间 context 取消
+	untilNextQuotaWindow = func() time.Duration { return time.Hour }
+	calls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = DoWithQuotaPolicy(ctx, QuotaExceededBlock, nil, quotaCall(&calls))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDoWithQuotaPolicyClearAndRetry(t *testing.T) {
+	var calls, clears int
+	clearQuota := func() error {
+		clears++
+		return nil
+	}
+	err := DoWithQuotaPolicy(context.Background(), QuotaExceededClearAndRetry, clearQuota, quotaCall(&calls))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, clears)
+
+	clearErr := errors.New("clear quota error")
+	calls = 0
+	err = DoWithQuotaPolicy(context.Background(), QuotaExceededClearAndRetry, func() error { return clearErr }, quotaCall(&calls))
+	assert.ErrorIs(t, err, clearErr)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,100 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RetryPredicate 判断请求是否需要重试，在响应内容读取完成后调用，可根据 body 中的 errcode 判断
+// 请求失败时 resp 与 body 为 nil
+type RetryPredicate func(resp *http.Response, body []byte, err error) bool
+
+// RetryConfig 请求重试配置
+type RetryConfig struct {
+	MaxAttempts int           // 最大尝试次数（含首次请求），小于等于 1 时不重试
+	Backoff     time.Duration // 首次重试前的等待时长，之后每次翻倍
+	// RetryPredicate 自定义是否重试，设置后替代 DefaultRetryPredicate
+	RetryPredicate RetryPredicate
+}
+
+// DefaultRetryPredicate 默认的重试判断：网络错误、429 及 5xx 状态码时重试
+func DefaultRetryPredicate(resp *http.Response, _ []byte, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// RetryTransport 按照 RetryConfig 重试请求的 http.RoundTripper
+// 可通过 SetHTTPClient(&http.Client{Transport: NewRetryTransport(cfg, nil)}) 启用
+type RetryTransport struct {
+	Transport http.RoundTripper
+	Config    RetryConfig
+}
+
+// NewRetryTransport 实例化，transport 为空时使用 http.DefaultTransport
+func NewRetryTransport(cfg RetryConfig, transport http.RoundTripper) *RetryTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &RetryTransport{Transport: transport, Config: cfg}
+}
+
+// RoundTrip 实现 http.RoundTripper
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	predicate := t.Config.RetryPredicate
+	if predicate == nil {
+		predicate = DefaultRetryPredicate
+	}
+	backoff := t.Config.Backoff
+	for attempt := 1; ; attempt++ {
+		resp, body, err := t.roundTrip(req, attempt)
+		if attempt >= t.Config.MaxAttempts || !predicate(resp, body, err) {
+			return resp, err
+		}
+		if resp != nil {
+			// 丢弃本次响应，重试
+			resp.Body.Close()
+		}
+		if backoff > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-req.Context().Done():
+				timer.Stop()
+				return nil, req.Context().Err()
+			case <-timer.C:
+			}
+			backoff *= 2
+		}
+	}
+}
+
+// roundTrip 发送一次请求并读取响应内容，读取后的内容重新放回 resp.Body
+func (t *RetryTransport) roundTrip(req *http.Request, attempt int) (*http.Response, []byte, error) {
+	if attempt > 1 && req.Body != nil {
+		if req.GetBody == nil {
+			return nil, nil, errors.New("retry: request body can not be replayed")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := readAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, body, nil
+}
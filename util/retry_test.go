@@ -0,0 +1,72 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// retryOnSystemBusy 在 errcode 为 -1（系统繁忙）时重试
+func retryOnSystemBusy(resp *http.Response, body []byte, err error) bool {
+	if DefaultRetryPredicate(resp, body, err) {
+		return true
+	}
+	var commonErr CommonError
+	return json.Unmarshal(body, &commonErr) == nil && commonErr.ErrCode == -1
+}
+
+func newSystemBusyServer(busyTimes int32) (*httptest.Server, *int32) {
+	var calls int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= busyTimes {
+			fmt.Fprint(w, `{"errcode":-1,"errmsg":"system error"}`)
+			return
+		}
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok"}`)
+	})), &calls
+}
+
+func TestRetryPredicate(t *testing.T) {
+	server, calls := newSystemBusyServer(2)
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{MaxAttempts: 3, RetryPredicate: retryOnSystemBusy}, nil)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"touser":"openid"}`))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	var res CommonError
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&res))
+	assert.Equal(t, int64(0), res.ErrCode.Int64())
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestRetryPredicateMaxAttempts(t *testing.T) {
+	server, calls := newSystemBusyServer(5)
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{MaxAttempts: 2, RetryPredicate: retryOnSystemBusy}, nil)}
+	resp, err := client.Get(server.URL)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	var res CommonError
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&res))
+	assert.Equal(t, int64(-1), res.ErrCode.Int64())
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestDefaultRetryPredicateIgnoresErrCode(t *testing.T) {
+	server, calls := newSystemBusyServer(1)
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{MaxAttempts: 3}, nil)}
+	resp, err := client.Get(server.URL)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
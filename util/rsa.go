@@ -12,6 +12,15 @@ import (
 
 // RSADecrypt 数据解密
 func RSADecrypt(privateKey string, ciphertext []byte) ([]byte, error) {
+	priv, err := ParseRSAPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return rsa.DecryptPKCS1v15(rand.Reader, priv, ciphertext)
+}
+
+// ParseRSAPrivateKey 解析 PEM 格式的 RSA 私钥，支持 PKCS1 和 PKCS8
+func ParseRSAPrivateKey(privateKey string) (*rsa.PrivateKey, error) {
 	block, _ := pem.Decode([]byte(privateKey))
 	if block == nil {
 		return nil, errors.New("PrivateKey format error")
@@ -30,7 +39,28 @@ func RSADecrypt(privateKey string, ciphertext []byte) ([]byte, error) {
 			return nil, fmt.Errorf("ParsePKCS1PrivateKey error: %s, ParsePKCS8PrivateKey error: Not supported privatekey format, should be *rsa.PrivateKey, got %T", oldErr.Error(), t)
 		}
 	}
-	return rsa.DecryptPKCS1v15(rand.Reader, priv, ciphertext)
+	return priv, nil
+}
+
+// ParseRSAPublicKey 解析 PEM 格式的 RSA 公钥，支持 PKIX 和 PKCS1
+func ParseRSAPublicKey(publicKey string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, errors.New("PublicKey format error")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		pub, pkcs1Err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if pkcs1Err != nil {
+			return nil, fmt.Errorf("ParsePKIXPublicKey error: %s, ParsePKCS1PublicKey error: %s", err.Error(), pkcs1Err.Error())
+		}
+		return pub, nil
+	}
+	pub, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("Not supported publickey format, should be *rsa.PublicKey, got %T", key)
+	}
+	return pub, nil
 }
 
 // RSADecryptBase64 Base64解码后再次进行RSA解密
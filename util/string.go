@@ -1,18 +1,93 @@
 package util
 
 import (
-	"math/rand"
+	"crypto/rand"
+	"fmt"
+	"io"
+	mathrand "math/rand"
+	"sync"
 	"time"
 )
 
-// RandomStr 随机生成字符串
+const randomStrLetters = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// randomStrMaxByte 不超过该值的随机字节才会被使用，保证每个字符出现的概率相同
+const randomStrMaxByte = 256 - 256%len(randomStrLetters)
+
+var (
+	// randLock 保护 randReader，同时保证自定义 reader 不会被并发读取
+	randLock sync.Mutex
+	// randReader 自定义随机源，为 nil 时使用 defaultRandReader
+	randReader io.Reader
+	// defaultRandReader 默认随机源 crypto/rand.Reader，可并发读取，无需加锁
+	defaultRandReader = rand.Reader
+
+	// fallbackRandLock 保护 fallbackRand
+	fallbackRandLock sync.Mutex
+	// fallbackRand 随机源读取失败时使用的伪随机数生成器
+	fallbackRand = mathrand.New(mathrand.NewSource(time.Now().UnixNano()))
+)
+
+// SetRandReader 设置生成随机字符串（如 nonce）使用的随机源，默认为 crypto/rand.Reader，传 nil 恢复默认
+// 主要用于测试中注入固定的随机源以得到确定的结果，可在运行期间安全调用
+func SetRandReader(r io.Reader) {
+	randLock.Lock()
+	defer randLock.Unlock()
+	randReader = r
+}
+
+// readRandom 从随机源读取随机字节，custom 表示是否读取的是 SetRandReader 设置的随机源
+func readRandom(buf []byte) (custom bool, err error) {
+	randLock.Lock()
+	r := randReader
+	if r == nil {
+		randLock.Unlock()
+		_, err = io.ReadFull(defaultRandReader, buf)
+		return false, err
+	}
+	defer randLock.Unlock()
+	_, err = io.ReadFull(r, buf)
+	return true, err
+}
+
+// RandomString 随机生成字符串，随机源读取失败时返回错误
+func RandomString(length int) (string, error) {
+	s, _, err := randomString(length)
+	return s, err
+}
+
+// randomString 随机生成字符串，custom 表示是否使用了 SetRandReader 设置的随机源
+func randomString(length int) (s string, custom bool, err error) {
+	result := make([]byte, 0, length)
+	buf := make([]byte, length)
+	for len(result) < length {
+		if custom, err = readRandom(buf[:length-len(result)]); err != nil {
+			return "", custom, err
+		}
+		for _, b := range buf[:length-len(result)] {
+			if int(b) < randomStrMaxByte {
+				result = append(result, randomStrLetters[int(b)%len(randomStrLetters)])
+			}
+		}
+	}
+	return string(result), custom, nil
+}
+
+// RandomStr 随机生成字符串，crypto/rand 读取失败时回退为 math/rand 生成
+// SetRandReader 设置的随机源读取失败（如测试中注入的固定字节已读完）时 panic，以免测试得到不确定的结果而不自知
 func RandomStr(length int) string {
-	str := "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	bytes := []byte(str)
-	result := []byte{}
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	for i := 0; i < length; i++ {
-		result = append(result, bytes[r.Intn(len(bytes))])
+	s, custom, err := randomString(length)
+	if err == nil {
+		return s
+	}
+	if custom {
+		panic(fmt.Sprintf("util: read random source set by SetRandReader failed: %v", err))
+	}
+	fallbackRandLock.Lock()
+	defer fallbackRandLock.Unlock()
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = randomStrLetters[fallbackRand.Intn(len(randomStrLetters))]
 	}
 	return string(result)
 }
@@ -0,0 +1,66 @@
+package util
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomStrWithFixedReader(t *testing.T) {
+	defer SetRandReader(nil)
+
+	// 248 及以上的字节会被丢弃，62 对应 '0'
+	SetRandReader(bytes.NewReader([]byte{0, 1, 10, 36, 61, 255, 248, 62}))
+	assert.Equal(t, "01aAZ0", RandomStr(6))
+
+	seed := bytes.Repeat([]byte{7, 42, 100}, 32)
+	SetRandReader(bytes.NewReader(seed))
+	first := RandomStr(32)
+	SetRandReader(bytes.NewReader(seed))
+	assert.Equal(t, first, RandomStr(32))
+	assert.Equal(t, "7GC7GC7GC7GC7GC7GC7GC7GC7GC7GC7G", first)
+}
+
+func TestRandomStrDefaultReader(t *testing.T) {
+	var wg sync.WaitGroup
+	results := make([]string, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = RandomStr(32)
+		}(i)
+	}
+	wg.Wait()
+	for _, s := range results {
+		assert.Len(t, s, 32)
+	}
+	assert.NotEqual(t, results[0], results[1])
+}
+
+// TestRandomStrReaderError 随机源读取失败时 RandomString 返回错误，RandomStr 仅在 crypto/rand 失败时回退
+func TestRandomStrReaderError(t *testing.T) {
+	defer SetRandReader(nil)
+	SetRandReader(bytes.NewReader(nil))
+
+	_, err := RandomString(16)
+	assert.Error(t, err)
+	// 注入的随机源读取失败时不回退为 math/rand
+	assert.PanicsWithValue(t, "util: read random source set by SetRandReader failed: EOF", func() {
+		RandomStr(16)
+	})
+
+	SetRandReader(nil)
+	defer func(r io.Reader) { defaultRandReader = r }(defaultRandReader)
+	defaultRandReader = iotest.ErrReader(errors.New("mock error"))
+	_, err = RandomString(16)
+	assert.EqualError(t, err, "mock error")
+	assert.NotPanics(t, func() {
+		assert.Len(t, RandomStr(16), 16)
+	})
+}
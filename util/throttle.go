@@ -0,0 +1,127 @@
+package util
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultQuotaRefreshInterval 默认的额度读数刷新间隔
+const defaultQuotaRefreshInterval = time.Minute
+
+// QuotaReading 接口额度读数
+type QuotaReading struct {
+	DailyLimit int64 // 当天可调用次数
+	Remain     int64 // 当天剩余调用次数
+}
+
+// QuotaFetcher 获取接口当前额度，如调用 GetAPIQuota
+type QuotaFetcher func(ctx context.Context) (QuotaReading, error)
+
+// QuotaThrottleOpts 额度自适应限速配置
+type QuotaThrottleOpts struct {
+	Interval        time.Duration // 两次调用之间的最小间隔，为 0 时额度充足时不限速
+	Threshold       int64         // 剩余额度低于该值时降速，将剩余额度均摊到下一个额度周期（每日 0 点重置）前
+	RefreshInterval time.Duration // 额度读数的刷新间隔，默认 1 分钟
+}
+
+// QuotaThrottle 根据接口剩余额度自动调整调用速率的限速器，可并发使用
+type QuotaThrottle struct {
+	fetch QuotaFetcher
+	opts  QuotaThrottleOpts
+
+	mu        sync.Mutex
+	reading   *QuotaReading
+	fetchedAt time.Time
+	fetching  bool
+	last      time.Time // 最近一次预留的调用时间
+}
+
+// NewQuotaThrottle 创建额度自适应限速器
+func NewQuotaThrottle(fetch QuotaFetcher, opts QuotaThrottleOpts) *QuotaThrottle {
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = defaultQuotaRefreshInterval
+	}
+	return &QuotaThrottle{fetch: fetch, opts: opts}
+}
+
+// Wait 在调用接口前调用，按当前额度读数等待至允许下一次调用，等待期间响应 ctx 取消
+// 额度读数过期时先刷新，刷新失败时沿用上一次的读数
+// 刷新额度及等待期间不持有锁，并发调用时按调用顺序依次预留调用时间
+func (t *QuotaThrottle) Wait(ctx context.Context) error {
+	t.refresh(ctx)
+
+	t.mu.Lock()
+	now := time.Now()
+	next := now
+	if !t.last.IsZero() {
+		if at := t.last.Add(t.interval()); at.After(now) {
+			next = at
+		}
+	}
+	t.last = next
+	if t.reading != nil && t.reading.Remain > 0 {
+		// 两次刷新之间按本次调用扣减剩余额度
+		t.reading.Remain--
+	}
+	t.mu.Unlock()
+
+	if delay := next.Sub(now); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return nil
+}
+
+// refresh 额度读数过期时刷新，同一时间只有一个调用方请求额度接口，其他调用方沿用当前读数
+func (t *QuotaThrottle) refresh(ctx context.Context) {
+	t.mu.Lock()
+	if t.fetching || (!t.fetchedAt.IsZero() && time.Since(t.fetchedAt) < t.opts.RefreshInterval) {
+		t.mu.Unlock()
+		return
+	}
+	t.fetching = true
+	t.mu.Unlock()
+
+	reading, err := t.fetch(ctx)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err == nil {
+		t.reading = &reading
+	}
+	// 刷新失败时同样更新时间，避免每次调用都请求额度接口
+	t.fetchedAt = time.Now()
+	t.fetching = false
+}
+
+// Reading 返回当前缓存的额度读数，尚未成功获取时 ok 为 false
+func (t *QuotaThrottle) Reading() (reading QuotaReading, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.reading == nil {
+		return QuotaReading{}, false
+	}
+	return *t.reading, true
+}
+
+// interval 返回当前额度读数下两次调用之间的间隔
+func (t *QuotaThrottle) interval() time.Duration {
+	interval := t.opts.Interval
+	if t.reading == nil || t.reading.Remain >= t.opts.Threshold {
+		return interval
+	}
+	spread := untilNextQuotaWindow()
+	if t.reading.Remain > 0 {
+		spread /= time.Duration(t.reading.Remain)
+	}
+	if spread > interval {
+		interval = spread
+	}
+	return interval
+}
@@ -0,0 +1,130 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestQuotaThrottleLowRemain 剩余额度低于阈值时将剩余额度均摊到下一个额度周期前
+func TestQuotaThrottleLowRemain(t *testing.T) {
+	defer func(fn func() time.Duration) { untilNextQuotaWindow = fn }(untilNextQuotaWindow)
+	untilNextQuotaWindow = func() time.Duration { return 200 * time.Millisecond }
+
+	remain := int64(1000)
+	fetches := 0
+	throttle := NewQuotaThrottle(func(context.Context) (QuotaReading, error) {
+		fetches++
+		return QuotaReading{DailyLimit: 1000, Remain: remain}, nil
+	}, QuotaThrottleOpts{Threshold: 10, RefreshInterval: time.Hour})
+
+	// 额度充足时不限速
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, throttle.Wait(context.Background()))
+	}
+	assert.Less(t, int64(time.Since(start)), int64(50*time.Millisecond))
+	assert.Equal(t, 1, fetches)
+	reading, ok := throttle.Reading()
+	assert.True(t, ok)
+	assert.Equal(t, int64(997), reading.Remain)
+
+	// 刷新后剩余 5 次，本次调用后剩余 4 次，每次间隔 200ms / 4
+	// 调用时间从上一次预留的时间起算，因此从上一次调用前开始计时
+	remain = 5
+	throttle.fetchedAt = time.Time{}
+	start = time.Now()
+	assert.NoError(t, throttle.Wait(context.Background()))
+	assert.Equal(t, 2, fetches)
+	assert.Equal(t, 50*time.Millisecond, throttle.interval())
+	assert.NoError(t, throttle.Wait(context.Background()))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+	// 剩余额度减少后进一步降速
+	assert.Equal(t, 200*time.Millisecond/3, throttle.interval())
+}
+
+// TestQuotaThrottleInterval 额度充足时使用最小间隔，额度耗尽时等待至下一个额度周期
+func TestQuotaThrottleInterval(t *testing.T) {
+	defer func(fn func() time.Duration) { untilNextQuotaWindow = fn }(untilNextQuotaWindow)
+	untilNextQuotaWindow = func() time.Duration { return time.Hour }
+
+	throttle := NewQuotaThrottle(nil, QuotaThrottleOpts{Interval: time.Second, Threshold: 10})
+	assert.Equal(t, time.Second, throttle.interval())
+
+	throttle.reading = &QuotaReading{Remain: 100}
+	assert.Equal(t, time.Second, throttle.interval())
+
+	throttle.reading = &QuotaReading{Remain: 0}
+	assert.Equal(t, time.Hour, throttle.interval())
+}
+
+// TestQuotaThrottleFetchError 刷新失败时沿用上一次的读数，等待期间响应 ctx 取消
+func TestQuotaThrottleFetchError(t *testing.T) {
+	defer func(fn func() time.Duration) { untilNextQuotaWindow = fn }(untilNextQuotaWindow)
+	untilNextQuotaWindow = func() time.Duration { return time.Hour }
+
+	calls := 0
+	throttle := NewQuotaThrottle(func(context.Context) (QuotaReading, error) {
+		calls++
+		if calls == 1 {
+			return QuotaReading{DailyLimit: 100, Remain: 1}, nil
+		}
+		return QuotaReading{}, errors.New("mock error")
+	}, QuotaThrottleOpts{Threshold: 10, RefreshInterval: time.Nanosecond})
+
+	assert.NoError(t, throttle.Wait(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, throttle.Wait(ctx))
+	assert.Equal(t, 2, calls)
+	reading, ok := throttle.Reading()
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), reading.Remain)
+}
+
+// TestQuotaThrottleInitialFetchError 首次刷新失败时同样按刷新间隔重试，不在每次调用时请求额度接口
+func TestQuotaThrottleInitialFetchError(t *testing.T) {
+	calls := 0
+	throttle := NewQuotaThrottle(func(context.Context) (QuotaReading, error) {
+		calls++
+		return QuotaReading{}, errors.New("mock error")
+	}, QuotaThrottleOpts{RefreshInterval: time.Hour})
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, throttle.Wait(context.Background()))
+	}
+	assert.Equal(t, 1, calls)
+	_, ok := throttle.Reading()
+	assert.False(t, ok)
+}
+
+// TestQuotaThrottleWaitNotHoldingLock 等待期间不持有锁，Reading 不被阻塞，并发调用依次间隔
+func TestQuotaThrottleWaitNotHoldingLock(t *testing.T) {
+	throttle := NewQuotaThrottle(func(context.Context) (QuotaReading, error) {
+		return QuotaReading{DailyLimit: 1000, Remain: 1000}, nil
+	}, QuotaThrottleOpts{Interval: 100 * time.Millisecond})
+	assert.NoError(t, throttle.Wait(context.Background()))
+
+	start := time.Now()
+	done := make(chan struct{}, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			assert.NoError(t, throttle.Wait(context.Background()))
+			done <- struct{}{}
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	readStart := time.Now()
+	_, ok := throttle.Reading()
+	assert.True(t, ok)
+	assert.Less(t, int64(time.Since(readStart)), int64(10*time.Millisecond))
+
+	<-done
+	<-done
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(200*time.Millisecond))
+	reading, _ := throttle.Reading()
+	assert.Equal(t, int64(997), reading.Remain)
+}
@@ -1,8 +1,41 @@
 package util
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// wechatLocation 微信接口使用的北京时间
+var wechatLocation = time.FixedZone("CST", 8*3600)
 
 // GetCurrTS return current timestamps
 func GetCurrTS() int64 {
 	return time.Now().Unix()
 }
+
+// ParseWeChatTime 解析微信返回的时间字段，支持 Unix 秒级时间戳（如消息推送中的 CreateTime）
+// 及 RFC3339 格式的字符串（如微信支付 v3 中的 success_time），返回北京时间
+func ParseWeChatTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case int64:
+		return time.Unix(v, 0).In(wechatLocation), nil
+	case int:
+		return time.Unix(int64(v), 0).In(wechatLocation), nil
+	case float64:
+		return time.Unix(int64(v), 0).In(wechatLocation), nil
+	case string:
+		v = strings.TrimSpace(v)
+		if unix, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Unix(unix, 0).In(wechatLocation), nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.In(wechatLocation), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported wechat time value: %v(%T)", value, value)
+	}
+}
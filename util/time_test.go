@@ -0,0 +1,25 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWeChatTime(t *testing.T) {
+	expected := time.Date(2024, 5, 20, 13, 14, 0, 0, wechatLocation)
+
+	for _, value := range []interface{}{int64(1716182040), 1716182040, float64(1716182040), "1716182040", "2024-05-20T13:14:00+08:00", "2024-05-20T05:14:00Z"} {
+		parsed, err := ParseWeChatTime(value)
+		assert.Nil(t, err, value)
+		assert.True(t, expected.Equal(parsed), value)
+		assert.Equal(t, "CST", parsed.Location().String(), value)
+		assert.Equal(t, 13, parsed.Hour(), value)
+	}
+
+	_, err := ParseWeChatTime("2024/05/20")
+	assert.NotNil(t, err)
+	_, err = ParseWeChatTime(nil)
+	assert.NotNil(t, err)
+}
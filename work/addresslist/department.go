@@ -1,6 +1,7 @@
 package addresslist
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -81,7 +82,7 @@ func (r *Client) DepartmentCreate(req *DepartmentCreateRequest) (*DepartmentCrea
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(departmentCreateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.DepartmentCreate"), fmt.Sprintf(departmentCreateURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &DepartmentCreateResponse{}
@@ -109,7 +110,7 @@ func (r *Client) DepartmentUpdate(req *DepartmentUpdateRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(departmentUpdateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.DepartmentUpdate"), fmt.Sprintf(departmentUpdateURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DepartmentUpdate")
@@ -126,7 +127,7 @@ func (r *Client) DepartmentDelete(departmentID int) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(departmentDeleteURL, accessToken, departmentID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.DepartmentDelete"), fmt.Sprintf(departmentDeleteURL, accessToken, departmentID)); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DepartmentDelete")
@@ -143,7 +144,7 @@ func (r *Client) DepartmentSimpleList(departmentID int) ([]*DepartmentID, error)
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(departmentSimpleListURL, accessToken, departmentID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.DepartmentSimpleList"), fmt.Sprintf(departmentSimpleListURL, accessToken, departmentID)); err != nil {
 		return nil, err
 	}
 	result := &DepartmentSimpleListResponse{}
@@ -178,7 +179,7 @@ func (r *Client) DepartmentListByID(departmentID int) ([]*Department, error) {
 	}
 
 	// 发起http请求
-	response, err := util.HTTPGet(formatURL)
+	response, err := util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.DepartmentList"), formatURL)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +201,7 @@ func (r *Client) DepartmentGet(departmentID int) (*Department, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(departmentGetURL, accessToken, departmentID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.DepartmentGet"), fmt.Sprintf(departmentGetURL, accessToken, departmentID)); err != nil {
 		return nil, err
 	}
 	result := &DepartmentGetResponse{}
@@ -1,6 +1,7 @@
 package addresslist
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -37,7 +38,7 @@ func (r *Client) GetPermList() (*GetPermListResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPPost(fmt.Sprintf(getPermListURL, accessToken), ""); err != nil {
+	if response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "addresslist.GetPermList"), fmt.Sprintf(getPermListURL, accessToken), nil, nil); err != nil {
 		return nil, err
 	}
 	result := &GetPermListResponse{}
@@ -105,7 +106,7 @@ func (r *Client) GetLinkedCorpUser(req *GetLinkedCorpUserRequest) (*GetLinkedCor
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getLinkedCorpUserURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.GetLinkedCorpUser"), fmt.Sprintf(getLinkedCorpUserURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetLinkedCorpUserResponse{}
@@ -143,7 +144,7 @@ func (r *Client) LinkedCorpSimpleList(req *LinkedCorpSimpleListRequest) (*Linked
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(linkedCorpSimpleListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.LinkedCorpSimpleList"), fmt.Sprintf(linkedCorpSimpleListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &LinkedCorpSimpleListResponse{}
@@ -173,7 +174,7 @@ func (r *Client) LinkedCorpUserList(req *LinkedCorpUserListRequest) (*LinkedCorp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(linkedCorpUserListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.LinkedCorpUserList"), fmt.Sprintf(linkedCorpUserListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &LinkedCorpUserListResponse{}
@@ -211,7 +212,7 @@ func (r *Client) LinkedCorpDepartmentList(req *LinkedCorpDepartmentListRequest)
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(linkedCorpDepartmentListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.LinkedCorpDepartmentList"), fmt.Sprintf(linkedCorpDepartmentListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &LinkedCorpDepartmentListResponse{}
@@ -1,6 +1,7 @@
 package addresslist
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -47,7 +48,7 @@ func (r *Client) CreateTag(req *CreateTagRequest) (*CreateTagResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(createTagURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.CreateTag"), fmt.Sprintf(createTagURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &CreateTagResponse{}
@@ -74,7 +75,7 @@ func (r *Client) UpdateTag(req *UpdateTagRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(updateTagURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.UpdateTag"), fmt.Sprintf(updateTagURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "UpdateTag")
@@ -91,7 +92,7 @@ func (r *Client) DeleteTag(tagID int) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(deleteTagURL, accessToken, tagID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.DeleteTag"), fmt.Sprintf(deleteTagURL, accessToken, tagID)); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DeleteTag")
@@ -123,7 +124,7 @@ func (r *Client) GetTag(tagID int) (*GetTagResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(getTagURL, accessToken, tagID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.GetTag"), fmt.Sprintf(getTagURL, accessToken, tagID)); err != nil {
 		return nil, err
 	}
 	result := &GetTagResponse{}
@@ -157,7 +158,7 @@ func (r *Client) AddTagUsers(req *AddTagUsersRequest) (*AddTagUsersResponse, err
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addTagUsersURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.AddTagUsers"), fmt.Sprintf(addTagUsersURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddTagUsersResponse{}
@@ -191,7 +192,7 @@ func (r *Client) DelTagUsers(req *DelTagUsersRequest) (*DelTagUsersResponse, err
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delTagUsersURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.DelTagUsers"), fmt.Sprintf(delTagUsersURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &DelTagUsersResponse{}
@@ -223,7 +224,7 @@ func (r *Client) ListTag() (*ListTagResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(listTagURL, accessToken)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.ListTag"), fmt.Sprintf(listTagURL, accessToken)); err != nil {
 		return nil, err
 	}
 	result := &ListTagResponse{}
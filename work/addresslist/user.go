@@ -1,6 +1,7 @@
 package addresslist
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -52,7 +53,7 @@ func (r *Client) UserSimpleList(departmentID int) ([]*UserList, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(strings.Join([]string{
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.UserSimpleList"), strings.Join([]string{
 		userSimpleListURL,
 		util.Query(map[string]interface{}{
 			"access_token":  accessToken,
@@ -148,7 +149,7 @@ func (r *Client) UserCreate(req *UserCreateRequest) (*UserCreateResponse, error)
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(userCreateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.UserCreate"), fmt.Sprintf(userCreateURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &UserCreateResponse{}
@@ -196,7 +197,7 @@ func (r *Client) UserUpdate(req *UserUpdateRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(userUpdateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.UserUpdate"), fmt.Sprintf(userUpdateURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "UserUpdate")
@@ -276,7 +277,7 @@ func (r *Client) UserGet(UserID string) (*UserGetResponse, error) {
 	}
 	var response []byte
 
-	if response, err = util.HTTPGet(
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.UserGet"),
 		strings.Join([]string{
 			userGetURL,
 			util.Query(map[string]interface{}{
@@ -309,7 +310,7 @@ func (r *Client) UserDelete(userID string) (*UserDeleteResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(strings.Join([]string{
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "addresslist.UserDelete"), strings.Join([]string{
 		userDeleteURL,
 		util.Query(map[string]interface{}{
 			"access_token": accessToken,
@@ -353,7 +354,7 @@ func (r *Client) UserListID(req *UserListIDRequest) (*UserListIDResponse, error)
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(strings.Join([]string{
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.UserListID"), strings.Join([]string{
 		userListIDURL,
 		util.Query(map[string]interface{}{
 			"access_token": accessToken,
@@ -391,7 +392,7 @@ func (r *Client) ConvertToOpenID(userID string) (string, error) {
 	}
 	var response []byte
 
-	if response, err = util.PostJSON(strings.Join([]string{
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.ConvertToOpenID"), strings.Join([]string{
 		convertToOpenIDURL,
 		util.Query(map[string]interface{}{
 			"access_token": accessToken,
@@ -431,7 +432,7 @@ func (r *Client) ConvertToUserID(openID string) (string, error) {
 	}
 	var response []byte
 
-	if response, err = util.PostJSON(strings.Join([]string{
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "addresslist.ConvertToUserID"), strings.Join([]string{
 		convertToUserIDURL,
 		util.Query(map[string]interface{}{
 			"access_token": accessToken,
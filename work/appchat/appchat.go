@@ -1,6 +1,7 @@
 package appchat
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -76,7 +77,7 @@ func (r *Client) Send(apiName string, request interface{}) (*SendResponse, error
 		return nil, err
 	}
 	// 发起http请求
-	response, err := util.HTTPPost(fmt.Sprintf(sendURL, accessToken), string(jsonData))
+	response, err := util.HTTPPostContext(util.WithOperation(context.Background(), "appchat."+apiName), fmt.Sprintf(sendURL, accessToken), jsonData, nil)
 	if err != nil {
 		return nil, err
 	}
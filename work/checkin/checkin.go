@@ -1,6 +1,7 @@
 package checkin
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -50,7 +51,7 @@ func (r *Client) SetScheduleList(req *SetScheduleListRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(setScheduleListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.SetScheduleList"), fmt.Sprintf(setScheduleListURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "SetScheduleList")
@@ -76,7 +77,7 @@ func (r *Client) PunchCorrection(req *PunchCorrectionRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(punchCorrectionURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.PunchCorrection"), fmt.Sprintf(punchCorrectionURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "PunchCorrection")
@@ -99,7 +100,7 @@ func (r *Client) AddUserFace(req *AddUserFaceRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addUserFaceURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.AddUserFace"), fmt.Sprintf(addUserFaceURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "AddUserFace")
@@ -313,7 +314,7 @@ func (r *Client) AddOption(req *AddOptionRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addOptionURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.AddOption"), fmt.Sprintf(addOptionURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "AddOption")
@@ -336,7 +337,7 @@ func (r *Client) UpdateOption(req *UpdateOptionRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(updateOptionURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.UpdateOption"), fmt.Sprintf(updateOptionURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "UpdateOption")
@@ -360,7 +361,7 @@ func (r *Client) ClearOption(req *ClearOptionRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(clearOptionURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.ClearOption"), fmt.Sprintf(clearOptionURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "ClearOption")
@@ -383,7 +384,7 @@ func (r *Client) DelOption(req *DelOptionRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delOptionURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.DelOption"), fmt.Sprintf(delOptionURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DelOption")
@@ -421,7 +422,7 @@ func (r *Client) AddRecord(req *AddRecordRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addRecordURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.AddRecord"), fmt.Sprintf(addRecordURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "AddRecord")
@@ -1,6 +1,7 @@
 package checkin
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -70,7 +71,7 @@ func (r *Client) GetCheckinData(req *GetCheckinDataRequest) (*GetCheckinDataResp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getCheckinDataURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.GetCheckinData"), fmt.Sprintf(getCheckinDataURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetCheckinDataResponse{}
@@ -195,7 +196,7 @@ func (r *Client) GetDayData(req *GetCheckinDataRequest) (result *GetDayDataRespo
 	if accessToken, err = r.GetAccessToken(); err != nil {
 		return
 	}
-	if response, err = util.PostJSON(fmt.Sprintf(getDayDataURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.GetDayData"), fmt.Sprintf(getDayDataURL, accessToken), req); err != nil {
 		return
 	}
 
@@ -270,7 +271,7 @@ func (r *Client) GetMonthData(req *GetCheckinDataRequest) (result *GetMonthDataR
 	if accessToken, err = r.GetAccessToken(); err != nil {
 		return
 	}
-	if response, err = util.PostJSON(fmt.Sprintf(getMonthDataURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.GetMonthData"), fmt.Sprintf(getMonthDataURL, accessToken), req); err != nil {
 		return
 	}
 
@@ -492,7 +493,7 @@ func (r *Client) GetCorpOption() (*GetCorpOptionResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPPost(fmt.Sprintf(getCorpOptionURL, accessToken), ""); err != nil {
+	if response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "checkin.GetCorpOption"), fmt.Sprintf(getCorpOptionURL, accessToken), nil, nil); err != nil {
 		return nil, err
 	}
 	result := &GetCorpOptionResponse{}
@@ -564,7 +565,7 @@ func (r *Client) GetOption(req *GetOptionRequest) (*GetOptionResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getOptionURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.GetOption"), fmt.Sprintf(getOptionURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetOptionResponse{}
@@ -632,7 +633,7 @@ func (r *Client) GetScheduleList(req *GetScheduleListRequest) (*GetScheduleListR
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getScheduleListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.GetScheduleList"), fmt.Sprintf(getScheduleListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetScheduleListResponse{}
@@ -673,7 +674,7 @@ func (r *Client) GetHardwareData(req *GetHardwareDataRequest) (*GetHardwareDataR
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getHardwareDataURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "checkin.GetHardwareData"), fmt.Sprintf(getHardwareDataURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetHardwareDataResponse{}
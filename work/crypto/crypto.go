@@ -0,0 +1,82 @@
+// Package crypto 企业微信回调消息加解密，与公众号使用相同的 WXBizMsgCrypt 方案，ReceiveID 为 corpid
+package crypto
+
+import (
+	"crypto/subtle"
+	"encoding/xml"
+	"errors"
+	"strconv"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// ErrInvalidSignature 回调请求的签名校验失败
+var ErrInvalidSignature = errors.New("work crypto: invalid msg_signature")
+
+// EncryptedMsg 加密的回调消息
+type EncryptedMsg struct {
+	XMLName    struct{} `xml:"xml"`
+	ToUserName string   `xml:"ToUserName"` // 企业微信的 CorpID，第三方应用回调时为 SuiteID
+	AgentID    string   `xml:"AgentID"`    // 接收的应用 id
+	Encrypt    string   `xml:"Encrypt"`    // 消息结构体加密后的字符串
+}
+
+// ResponseEncryptedMsg 加密的被动回复消息
+type ResponseEncryptedMsg struct {
+	XMLName      struct{} `xml:"xml"`
+	Encrypt      string   `xml:"Encrypt"`
+	MsgSignature string   `xml:"MsgSignature"`
+	TimeStamp    int64    `xml:"TimeStamp"`
+	Nonce        string   `xml:"Nonce"`
+}
+
+// MsgCrypt 企业微信回调消息加解密
+type MsgCrypt struct {
+	token          string
+	encodingAESKey string
+	receiveID      string
+}
+
+// NewMsgCrypt 实例化，receiveID 在企业自建应用回调时为 corpid，第三方应用回调时为 suiteid
+func NewMsgCrypt(token, encodingAESKey, receiveID string) *MsgCrypt {
+	return &MsgCrypt{token: token, encodingAESKey: encodingAESKey, receiveID: receiveID}
+}
+
+// VerifyURL 校验回调 URL 验证请求的签名，返回解密后的 echostr 明文
+func (c *MsgCrypt) VerifyURL(msgSignature, timestamp, nonce, echoStr string) ([]byte, error) {
+	return c.decrypt(msgSignature, timestamp, nonce, echoStr)
+}
+
+// DecryptMsg 校验签名并解密回调消息，body 为回调请求的原始 xml，返回解密后的消息 xml
+func (c *MsgCrypt) DecryptMsg(msgSignature, timestamp, nonce string, body []byte) ([]byte, error) {
+	var msg EncryptedMsg
+	if err := xml.Unmarshal(body, &msg); err != nil {
+		return nil, err
+	}
+	return c.decrypt(msgSignature, timestamp, nonce, msg.Encrypt)
+}
+
+// EncryptMsg 加密被动回复的消息 xml，返回可直接响应给企业微信的加密 xml
+func (c *MsgCrypt) EncryptMsg(replyMsg []byte, timestamp int64, nonce string) ([]byte, error) {
+	encrypted, err := util.EncryptMsg([]byte(util.RandomStr(16)), replyMsg, c.receiveID, c.encodingAESKey)
+	if err != nil {
+		return nil, err
+	}
+	timestampStr := strconv.FormatInt(timestamp, 10)
+	return xml.Marshal(&ResponseEncryptedMsg{
+		Encrypt:      string(encrypted),
+		MsgSignature: util.Signature(c.token, timestampStr, nonce, string(encrypted)),
+		TimeStamp:    timestamp,
+		Nonce:        nonce,
+	})
+}
+
+// decrypt 校验签名后解密，并校验 receiveID
+func (c *MsgCrypt) decrypt(msgSignature, timestamp, nonce, encrypted string) ([]byte, error) {
+	expected := util.Signature(c.token, timestamp, nonce, encrypted)
+	if subtle.ConstantTimeCompare([]byte(msgSignature), []byte(expected)) != 1 {
+		return nil, ErrInvalidSignature
+	}
+	_, plaintext, err := util.DecryptMsg(c.receiveID, encrypted, c.encodingAESKey)
+	return plaintext, err
+}
@@ -0,0 +1,78 @@
+package crypto
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	mockToken          = "QDG6eK"
+	mockEncodingAESKey = "jWmYm7qr5nMoAUwZRjGtBxmz3KA1tkAj3ykkR6q2B2C"
+	mockCorpID         = "wx5823bf96d3bd56c7"
+	mockTimestamp      = "1409659813"
+	mockNonce          = "1372623149"
+	mockMsg            = "<xml><ToUserName><![CDATA[wx5823bf96d3bd56c7]]></ToUserName><AgentID>218</AgentID>" +
+		"<MsgType><![CDATA[text]]></MsgType><Content><![CDATA[hello]]></Content></xml>"
+)
+
+// newWorkCallback 构造企业微信回调消息：Encrypt 以 corpid 作为 ReceiveID 加密，签名为 sha1(sort(token, timestamp, nonce, encrypt))
+func newWorkCallback(t *testing.T, receiveID string) (body []byte, msgSignature string) {
+	encrypted, err := util.EncryptMsg([]byte("abcdefghijklmnop"), []byte(mockMsg), receiveID, mockEncodingAESKey)
+	assert.NoError(t, err)
+	body = []byte(fmt.Sprintf("<xml><ToUserName><![CDATA[%s]]></ToUserName><Encrypt><![CDATA[%s]]></Encrypt>"+
+		"<AgentID><![CDATA[218]]></AgentID></xml>", receiveID, encrypted))
+	return body, util.Signature(mockToken, mockTimestamp, mockNonce, string(encrypted))
+}
+
+func TestDecryptMsg(t *testing.T) {
+	body, msgSignature := newWorkCallback(t, mockCorpID)
+	msgCrypt := NewMsgCrypt(mockToken, mockEncodingAESKey, mockCorpID)
+
+	plaintext, err := msgCrypt.DecryptMsg(msgSignature, mockTimestamp, mockNonce, body)
+	assert.NoError(t, err)
+	assert.Equal(t, mockMsg, string(plaintext))
+
+	_, err = msgCrypt.DecryptMsg("invalid-signature", mockTimestamp, mockNonce, body)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+
+	// 以其他企业的 corpid 加密的消息
+	body, msgSignature = newWorkCallback(t, "ww-other-corp")
+	_, err = msgCrypt.DecryptMsg(msgSignature, mockTimestamp, mockNonce, body)
+	assert.Error(t, err)
+}
+
+func TestVerifyURL(t *testing.T) {
+	echoStr, err := util.EncryptMsg([]byte("abcdefghijklmnop"), []byte("1616140317555161061"), mockCorpID, mockEncodingAESKey)
+	assert.NoError(t, err)
+	msgSignature := util.Signature(mockToken, mockTimestamp, mockNonce, string(echoStr))
+
+	plaintext, err := NewMsgCrypt(mockToken, mockEncodingAESKey, mockCorpID).VerifyURL(msgSignature, mockTimestamp, mockNonce, string(echoStr))
+	assert.NoError(t, err)
+	assert.Equal(t, "1616140317555161061", string(plaintext))
+}
+
+func TestEncryptMsg(t *testing.T) {
+	msgCrypt := NewMsgCrypt(mockToken, mockEncodingAESKey, mockCorpID)
+	data, err := msgCrypt.EncryptMsg([]byte(mockMsg), 1409659813, mockNonce)
+	assert.NoError(t, err)
+
+	var resp ResponseEncryptedMsg
+	assert.NoError(t, xml.Unmarshal(data, &resp))
+	assert.Equal(t, int64(1409659813), resp.TimeStamp)
+	assert.Equal(t, mockNonce, resp.Nonce)
+	_, err = base64.StdEncoding.DecodeString(resp.Encrypt)
+	assert.NoError(t, err)
+
+	// 加密结果可被解密
+	plaintext, err := msgCrypt.VerifyURL(resp.MsgSignature, strconv.FormatInt(resp.TimeStamp, 10), resp.Nonce, resp.Encrypt)
+	assert.NoError(t, err)
+	assert.Equal(t, mockMsg, string(plaintext))
+}
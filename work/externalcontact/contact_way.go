@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -98,7 +99,7 @@ func (r *Client) AddContactWay(req *AddContactWayRequest) (*AddContactWayRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addContactWayURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.AddContactWay"), fmt.Sprintf(addContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddContactWayResponse{}
@@ -147,7 +148,7 @@ func (r *Client) GetContactWay(req *GetContactWayRequest) (*GetContactWayRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getContactWayURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetContactWay"), fmt.Sprintf(getContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetContactWayResponse{}
@@ -187,7 +188,7 @@ func (r *Client) UpdateContactWay(req *UpdateContactWayRequest) (*UpdateContactW
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(updateContactWayURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.UpdateContactWay"), fmt.Sprintf(updateContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &UpdateContactWayResponse{}
@@ -226,7 +227,7 @@ func (r *Client) ListContactWay(req *ListContactWayRequest) (*ListContactWayResp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(listContactWayURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.ListContactWay"), fmt.Sprintf(listContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ListContactWayResponse{}
@@ -256,7 +257,7 @@ func (r *Client) DelContactWay(req *DelContactWayRequest) (*DelContactWayRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delContactWayURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.DelContactWay"), fmt.Sprintf(delContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &DelContactWayResponse{}
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -52,7 +53,7 @@ func (r *Client) ListLink(req *ListLinkRequest) (*ListLinkResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(listLinkURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.ListLink"), fmt.Sprintf(listLinkURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ListLinkResponse{}
@@ -98,7 +99,7 @@ func (r *Client) GetCustomerAcquisition(req *GetCustomerAcquisitionRequest) (*Ge
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getCustomerAcquisitionURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetCustomerAcquisition"), fmt.Sprintf(getCustomerAcquisitionURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetCustomerAcquisitionResponse{}
@@ -131,7 +132,7 @@ func (r *Client) CreateCustomerAcquisitionLink(req *CreateCustomerAcquisitionLin
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(createCustomerAcquisitionLinkURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.CreateCustomerAcquisitionLink"), fmt.Sprintf(createCustomerAcquisitionLinkURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &CreateCustomerAcquisitionLinkResponse{}
@@ -164,7 +165,7 @@ func (r *Client) UpdateCustomerAcquisitionLink(req *UpdateCustomerAcquisitionLin
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(updateCustomerAcquisitionLinkURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.UpdateCustomerAcquisitionLink"), fmt.Sprintf(updateCustomerAcquisitionLinkURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &UpdateCustomerAcquisitionLinkResponse{}
@@ -194,7 +195,7 @@ func (r *Client) DeleteCustomerAcquisitionLink(req *DeleteCustomerAcquisitionLin
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(deleteCustomerAcquisitionLinkURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.DeleteCustomerAcquisitionLink"), fmt.Sprintf(deleteCustomerAcquisitionLinkURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &DeleteCustomerAcquisitionLinkResponse{}
@@ -235,7 +236,7 @@ func (r *Client) GetCustomerInfoWithCustomerAcquisitionLink(req *GetCustomerInfo
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getCustomerInfoWithCustomerAcquisitionLinkURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetCustomerInfoWithCustomerAcquisitionLink"), fmt.Sprintf(getCustomerInfoWithCustomerAcquisitionLinkURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetCustomerInfoWithCustomerAcquisitionLinkResponse{}
@@ -269,7 +270,7 @@ func (r *Client) CustomerAcquisitionQuota() (*CustomerAcquisitionQuotaResponse,
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(customerAcquisitionQuotaURL, accessToken)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "externalcontact.CustomerAcquisitionQuota"), fmt.Sprintf(customerAcquisitionQuotaURL, accessToken)); err != nil {
 		return nil, err
 	}
 	result := &CustomerAcquisitionQuotaResponse{}
@@ -303,7 +304,7 @@ func (r *Client) CustomerAcquisitionStatistic(req *CustomerAcquisitionStatisticR
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(customerAcquisitionStatisticURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.CustomerAcquisitionStatistic"), fmt.Sprintf(customerAcquisitionStatisticURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &CustomerAcquisitionStatisticResponse{}
@@ -342,7 +343,7 @@ func (r *Client) GetChatInfo(req *GetChatInfoRequest) (*GetChatInfoResponse, err
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(customerAcquisitionGetChatInfoURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetChatInfo"), fmt.Sprintf(customerAcquisitionGetChatInfoURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetChatInfoResponse{}
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -44,7 +45,7 @@ func (r *Client) GetExternalUserList(userID string) ([]string, error) {
 		return nil, err
 	}
 	var response []byte
-	response, err = util.HTTPGet(fmt.Sprintf("%s?access_token=%v&userid=%v", fetchExternalContactUserListURL, accessToken, userID))
+	response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "externalcontact.GetExternalUserList"), fmt.Sprintf("%s?access_token=%v&userid=%v", fetchExternalContactUserListURL, accessToken, userID))
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +157,7 @@ func (r *Client) GetExternalUserDetail(externalUserID string, nextCursor ...stri
 	if len(nextCursor) > 0 {
 		cursor = nextCursor[0]
 	}
-	response, err = util.HTTPGet(fmt.Sprintf("%s?access_token=%v&external_userid=%v&cursor=%v", fetchExternalContactUserDetailURL, accessToken, externalUserID, cursor))
+	response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "externalcontact.get_external_user_detail"), fmt.Sprintf("%s?access_token=%v&external_userid=%v&cursor=%v", fetchExternalContactUserDetailURL, accessToken, externalUserID, cursor))
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +225,7 @@ func (r *Client) BatchGetExternalUserDetails(request BatchGetExternalUserDetails
 	if err != nil {
 		return nil, err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", fetchBatchExternalContactUserDetailURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.BatchGetExternalUserDetails"), fmt.Sprintf("%s?access_token=%v", fetchBatchExternalContactUserDetailURL, accessToken), jsonData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -256,7 +257,7 @@ func (r *Client) UpdateUserRemark(request UpdateUserRemarkRequest) error {
 	if err != nil {
 		return err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", updateUserRemarkURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.UpdateUserRemark"), fmt.Sprintf("%s?access_token=%v", updateUserRemarkURL, accessToken), jsonData, nil)
 	if err != nil {
 		return err
 	}
@@ -292,7 +293,7 @@ func (r *Client) ListCustomerStrategy(req *ListCustomerStrategyRequest) (*ListCu
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(listCustomerStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.ListCustomerStrategy"), fmt.Sprintf(listCustomerStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ListCustomerStrategyResponse{}
@@ -357,7 +358,7 @@ func (r *Client) GetCustomerStrategy(req *GetCustomerStrategyRequest) (*GetCusto
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getCustomerStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetCustomerStrategy"), fmt.Sprintf(getCustomerStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetCustomerStrategyResponse{}
@@ -397,7 +398,7 @@ func (r *Client) GetRangeCustomerStrategy(req *GetRangeCustomerStrategyRequest)
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getRangeCustomerStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetRangeCustomerStrategy"), fmt.Sprintf(getRangeCustomerStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetRangeCustomerStrategyResponse{}
@@ -431,7 +432,7 @@ func (r *Client) CreateCustomerStrategy(req *CreateCustomerStrategyRequest) (*Cr
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(createCustomerStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.CreateCustomerStrategy"), fmt.Sprintf(createCustomerStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &CreateCustomerStrategyResponse{}
@@ -460,7 +461,7 @@ func (r *Client) EditCustomerStrategy(req *EditCustomerStrategyRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(editCustomerStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.EditCustomerStrategy"), fmt.Sprintf(editCustomerStrategyURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "EditCustomerStrategy")
@@ -482,7 +483,7 @@ func (r *Client) DelCustomerStrategy(req *DelCustomerStrategyRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delCustomerStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.DelCustomerStrategy"), fmt.Sprintf(delCustomerStrategyURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DelCustomerStrategy")
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -25,7 +26,7 @@ func (r *Client) GetFollowUserList() ([]string, error) {
 		return nil, err
 	}
 	var response []byte
-	response, err = util.HTTPGet(fmt.Sprintf("%s?access_token=%s", fetchFollowUserListURL, accessToken))
+	response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "externalcontact.GetFollowUserList"), fmt.Sprintf("%s?access_token=%s", fetchFollowUserListURL, accessToken))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -39,7 +40,7 @@ func (r *Client) GetGroupChatList(req *GroupChatListRequest) (*GroupChatListResp
 		return nil, err
 	}
 	var response []byte
-	response, err = util.PostJSON(fmt.Sprintf("%s/list?access_token=%s", groupChatURL, accessToken), req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetGroupChatList"), fmt.Sprintf("%s/list?access_token=%s", groupChatURL, accessToken), req)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +101,7 @@ func (r *Client) GetGroupChatDetail(req *GroupChatDetailRequest) (*GroupChatDeta
 		return nil, err
 	}
 	var response []byte
-	response, err = util.PostJSON(fmt.Sprintf("%s/get?access_token=%s", groupChatURL, accessToken), req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetGroupChatDetail"), fmt.Sprintf("%s/get?access_token=%s", groupChatURL, accessToken), req)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +130,7 @@ func (r *Client) OpengIDToChatID(req *OpengIDToChatIDRequest) (*OpengIDToChatIDR
 		return nil, err
 	}
 	var response []byte
-	response, err = util.PostJSON(fmt.Sprintf("%s?access_token=%s", opengIDToChatIDURL, accessToken), req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetGroupChatDetail"), fmt.Sprintf("%s?access_token=%s", opengIDToChatIDURL, accessToken), req)
 	if err != nil {
 		return nil, err
 	}
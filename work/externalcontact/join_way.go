@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -39,7 +40,7 @@ func (r *Client) AddJoinWay(req *AddJoinWayRequest) (*AddJoinWayResponse, error)
 	if accessToken, err = r.GetAccessToken(); err != nil {
 		return nil, err
 	}
-	response, err = util.PostJSON(fmt.Sprintf("%s/add_join_way?access_token=%s", groupChatURL, accessToken), req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.AddJoinWay"), fmt.Sprintf("%s/add_join_way?access_token=%s", groupChatURL, accessToken), req)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +85,7 @@ func (r *Client) GetJoinWay(req *JoinWayConfigRequest) (*GetJoinWayResponse, err
 	if accessToken, err = r.GetAccessToken(); err != nil {
 		return nil, err
 	}
-	response, err = util.PostJSON(fmt.Sprintf("%s/get_join_way?access_token=%s", groupChatURL, accessToken), req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetJoinWay"), fmt.Sprintf("%s/get_join_way?access_token=%s", groupChatURL, accessToken), req)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +117,7 @@ func (r *Client) UpdateJoinWay(req *UpdateJoinWayRequest) error {
 	if accessToken, err = r.GetAccessToken(); err != nil {
 		return err
 	}
-	response, err = util.PostJSON(fmt.Sprintf("%s/update_join_way?access_token=%s", groupChatURL, accessToken), req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.UpdateJoinWay"), fmt.Sprintf("%s/update_join_way?access_token=%s", groupChatURL, accessToken), req)
 	if err != nil {
 		return err
 	}
@@ -134,7 +135,7 @@ func (r *Client) DelJoinWay(req *JoinWayConfigRequest) error {
 	if accessToken, err = r.GetAccessToken(); err != nil {
 		return err
 	}
-	response, err = util.PostJSON(fmt.Sprintf("%s/del_join_way?access_token=%s", groupChatURL, accessToken), req)
+	response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.DelJoinWay"), fmt.Sprintf("%s/del_join_way?access_token=%s", groupChatURL, accessToken), req)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -108,7 +109,7 @@ func (r *Client) AddMomentTask(req *AddMomentTaskRequest) (*AddMomentTaskRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addMomentTaskURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.AddMomentTask"), fmt.Sprintf(addMomentTaskURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddMomentTaskResponse{}
@@ -155,7 +156,7 @@ func (r *Client) GetMomentTaskResult(jobID string) (*GetMomentTaskResultResponse
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(getMomentTaskResultURL, accessToken, jobID)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context.Background(), "externalcontact.GetMomentTaskResult"), fmt.Sprintf(getMomentTaskResultURL, accessToken, jobID)); err != nil {
 		return nil, err
 	}
 	result := &GetMomentTaskResultResponse{}
@@ -179,7 +180,7 @@ func (r *Client) CancelMomentTask(req *CancelMomentTaskRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(cancelMomentTaskURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.CancelMomentTask"), fmt.Sprintf(cancelMomentTaskURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "CancelMomentTask")
@@ -256,7 +257,7 @@ func (r *Client) GetMomentList(req *GetMomentListRequest) (*GetMomentListRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getMomentListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetMomentList"), fmt.Sprintf(getMomentListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetMomentListResponse{}
@@ -295,7 +296,7 @@ func (r *Client) GetMomentTask(req *GetMomentTaskRequest) (*GetMomentTaskRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getMomentTaskURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetMomentTask"), fmt.Sprintf(getMomentTaskURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetMomentTaskResponse{}
@@ -335,7 +336,7 @@ func (r *Client) GetMomentCustomerList(req *GetMomentCustomerListRequest) (*GetM
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getMomentCustomerListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetMomentCustomerList"), fmt.Sprintf(getMomentCustomerListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetMomentCustomerListResponse{}
@@ -374,7 +375,7 @@ func (r *Client) GetMomentSendResult(req *GetMomentSendResultRequest) (*GetMomen
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getMomentSendResultURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetMomentSendResult"), fmt.Sprintf(getMomentSendResultURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetMomentSendResultResponse{}
@@ -420,7 +421,7 @@ func (r *Client) GetMomentComments(req *GetMomentCommentsRequest) (*GetMomentCom
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getMomentCommentsURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetMomentComments"), fmt.Sprintf(getMomentCommentsURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetMomentCommentsResponse{}
@@ -457,7 +458,7 @@ func (r *Client) ListMomentStrategy(req *ListMomentStrategyRequest) (*ListMoment
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(listMomentStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.ListMomentStrategy"), fmt.Sprintf(listMomentStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ListMomentStrategyResponse{}
@@ -504,7 +505,7 @@ func (r *Client) GetMomentStrategy(req *GetMomentStrategyRequest) (*GetMomentStr
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getMomentStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetMomentStrategy"), fmt.Sprintf(getMomentStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetMomentStrategyResponse{}
@@ -544,7 +545,7 @@ func (r *Client) GetRangeMomentStrategy(req *GetRangeMomentStrategyRequest) (*Ge
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getRangeMomentStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetRangeMomentStrategy"), fmt.Sprintf(getRangeMomentStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetRangeMomentStrategyResponse{}
@@ -578,7 +579,7 @@ func (r *Client) CreateMomentStrategy(req *CreateMomentStrategyRequest) (*Create
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(createMomentStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.CreateMomentStrategy"), fmt.Sprintf(createMomentStrategyURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &CreateMomentStrategyResponse{}
@@ -607,7 +608,7 @@ func (r *Client) EditMomentStrategy(req *EditMomentStrategyRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(editMomentStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.EditMomentStrategy"), fmt.Sprintf(editMomentStrategyURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "EditMomentStrategy")
@@ -629,7 +630,7 @@ func (r *Client) DelMomentStrategy(req *DelMomentStrategyRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delMomentStrategyURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.DelMomentStrategy"), fmt.Sprintf(delMomentStrategyURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DelMomentStrategy")
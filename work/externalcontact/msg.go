@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -117,7 +118,7 @@ func (r *Client) AddMsgTemplate(req *AddMsgTemplateRequest) (*AddMsgTemplateResp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addMsgTemplateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.AddMsgTemplate"), fmt.Sprintf(addMsgTemplateURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddMsgTemplateResponse{}
@@ -164,7 +165,7 @@ func (r *Client) GetGroupMsgListV2(req *GetGroupMsgListV2Request) (*GetGroupMsgL
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getGroupMsgListV2URL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetGroupMsgListV2"), fmt.Sprintf(getGroupMsgListV2URL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetGroupMsgListV2Response{}
@@ -204,7 +205,7 @@ func (r *Client) GetGroupMsgTask(req *GetGroupMsgTaskRequest) (*GetGroupMsgTaskR
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getGroupMsgTaskURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetGroupMsgTask"), fmt.Sprintf(getGroupMsgTaskURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetGroupMsgTaskResponse{}
@@ -247,7 +248,7 @@ func (r *Client) GetGroupMsgSendResult(req *GetGroupMsgSendResultRequest) (*GetG
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getGroupMsgSendResultURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetGroupMsgSendResult"), fmt.Sprintf(getGroupMsgSendResultURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetGroupMsgSendResultResponse{}
@@ -278,7 +279,7 @@ func (r *Client) SendWelcomeMsg(req *SendWelcomeMsgRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(sendWelcomeMsgURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.SendWelcomeMsg"), fmt.Sprintf(sendWelcomeMsgURL, accessToken), req); err != nil {
 		return err
 	}
 	result := &SendWelcomeMsgResponse{}
@@ -314,7 +315,7 @@ func (r *Client) AddGroupWelcomeTemplate(req *AddGroupWelcomeTemplateRequest) (*
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addGroupWelcomeTemplateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.AddGroupWelcomeTemplate"), fmt.Sprintf(addGroupWelcomeTemplateURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddGroupWelcomeTemplateResponse{}
@@ -350,7 +351,7 @@ func (r *Client) EditGroupWelcomeTemplate(req *EditGroupWelcomeTemplateRequest)
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(editGroupWelcomeTemplateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.EditGroupWelcomeTemplate"), fmt.Sprintf(editGroupWelcomeTemplateURL, accessToken), req); err != nil {
 		return err
 	}
 	result := &EditGroupWelcomeTemplateResponse{}
@@ -384,7 +385,7 @@ func (r *Client) GetGroupWelcomeTemplate(req *GetGroupWelcomeTemplateRequest) (*
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getGroupWelcomeTemplateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetGroupWelcomeTemplate"), fmt.Sprintf(getGroupWelcomeTemplateURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetGroupWelcomeTemplateResponse{}
@@ -414,7 +415,7 @@ func (r *Client) DelGroupWelcomeTemplate(req *DelGroupWelcomeTemplateRequest) er
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delGroupWelcomeTemplateURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.DelGroupWelcomeTemplate"), fmt.Sprintf(delGroupWelcomeTemplateURL, accessToken), req); err != nil {
 		return err
 	}
 	result := &DelGroupWelcomeTemplateResponse{}
@@ -437,7 +438,7 @@ func (r *Client) RemindGroupMsgSend(req *RemindGroupMsgSendRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(remindGroupMsgSendURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.RemindGroupMsgSend"), fmt.Sprintf(remindGroupMsgSendURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "RemindGroupMsgSend")
@@ -459,7 +460,7 @@ func (r *Client) CancelGroupMsgSend(req *CancelGroupMsgSendRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(cancelGroupMsgSendURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.CancelGroupMsgSend"), fmt.Sprintf(cancelGroupMsgSendURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "CancelGroupMsgSend")
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -54,7 +55,7 @@ func (r *Client) GetUserBehaviorData(req *GetUserBehaviorRequest) ([]BehaviorDat
 	if err != nil {
 		return nil, err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", getUserBehaviorDataURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.GetUserBehaviorData"), fmt.Sprintf("%s?access_token=%v", getUserBehaviorDataURL, accessToken), jsonData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +118,7 @@ func (r *Client) GetGroupChatStat(req *GetGroupChatStatRequest) (*GetGroupChatSt
 	if err != nil {
 		return nil, err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", getGroupChatStatURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.GetGroupChatStat"), fmt.Sprintf("%s?access_token=%v", getGroupChatStatURL, accessToken), jsonData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +158,7 @@ func (r *Client) GetGroupChatStatByDay(req *GetGroupChatStatByDayRequest) ([]Get
 	if err != nil {
 		return nil, err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", getGroupChatStatByDayURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.GetGroupChatStatByDay"), fmt.Sprintf("%s?access_token=%v", getGroupChatStatByDayURL, accessToken), jsonData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -71,7 +72,7 @@ func (r *Client) GetCropTagList(req GetCropTagRequest) ([]TagGroup, error) {
 	if err != nil {
 		return nil, err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", getCropTagURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.GetCropTagList"), fmt.Sprintf("%s?access_token=%v", getCropTagURL, accessToken), jsonData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +115,7 @@ func (r *Client) AddCropTag(req AddCropTagRequest) (*TagGroup, error) {
 	if err != nil {
 		return nil, err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", addCropTagURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.AddCropTag"), fmt.Sprintf("%s?access_token=%v", addCropTagURL, accessToken), jsonData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +144,7 @@ func (r *Client) EditCropTag(req EditCropTagRequest) error {
 	if err != nil {
 		return err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", editCropTagURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.EditCropTag"), fmt.Sprintf("%s?access_token=%v", editCropTagURL, accessToken), jsonData, nil)
 	if err != nil {
 		return err
 	}
@@ -169,7 +170,7 @@ func (r *Client) DeleteCropTag(req DeleteCropTagRequest) error {
 	if err != nil {
 		return err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", delCropTagURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.DeleteCropTag"), fmt.Sprintf("%s?access_token=%v", delCropTagURL, accessToken), jsonData, nil)
 	if err != nil {
 		return err
 	}
@@ -197,7 +198,7 @@ func (r *Client) MarkTag(request MarkTagRequest) error {
 	if err != nil {
 		return err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", markCropTagURL, accessToken), string(jsonData))
+	response, err = util.HTTPPostContext(util.WithOperation(context.Background(), "externalcontact.MarkTag"), fmt.Sprintf("%s?access_token=%v", markCropTagURL, accessToken), jsonData, nil)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (r *Client) GetStrategyTagList(req *GetStrategyTagListRequest) (*GetStrateg
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getStrategyTagListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetStrategyTagList"), fmt.Sprintf(getStrategyTagListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetStrategyTagListResponse{}
@@ -303,7 +304,7 @@ func (r *Client) AddStrategyTag(req *AddStrategyTagRequest) (*AddStrategyTagResp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addStrategyTagURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.AddStrategyTag"), fmt.Sprintf(addStrategyTagURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddStrategyTagResponse{}
@@ -329,7 +330,7 @@ func (r *Client) EditStrategyTag(req *EditStrategyTagRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(editStrategyTagURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.EditStrategyTag"), fmt.Sprintf(editStrategyTagURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "EditStrategyTag")
@@ -352,7 +353,7 @@ func (r *Client) DelStrategyTag(req *DelStrategyTagRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delStrategyTagURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.DelStrategyTag"), fmt.Sprintf(delStrategyTagURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DelStrategyTag")
@@ -1,6 +1,7 @@
 package externalcontact
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -54,7 +55,7 @@ func (r *Client) TransferCustomer(req *TransferCustomerRequest) (*TransferCustom
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(transferCustomerURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.TransferCustomer"), fmt.Sprintf(transferCustomerURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &TransferCustomerResponse{}
@@ -94,7 +95,7 @@ func (r *Client) TransferResult(req *TransferResultRequest) (*TransferResultResp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(transferResultURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.TransferResult"), fmt.Sprintf(transferResultURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &TransferResultResponse{}
@@ -132,7 +133,7 @@ func (r *Client) GroupChatOnJobTransfer(req *GroupChatOnJobTransferRequest) (*Gr
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(groupChatOnJobTransferURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GroupChatOnJobTransfer"), fmt.Sprintf(groupChatOnJobTransferURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GroupChatOnJobTransferResponse{}
@@ -172,7 +173,7 @@ func (r *Client) GetUnassignedList(req *GetUnassignedListRequest) (*GetUnassigne
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getUnassignedListURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GetUnassignedList"), fmt.Sprintf(getUnassignedListURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetUnassignedListResponse{}
@@ -204,7 +205,7 @@ func (r *Client) ResignedTransferCustomer(req *ResignedTransferCustomerRequest)
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(resignedTransferCustomerURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.ResignedTransferCustomer"), fmt.Sprintf(resignedTransferCustomerURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ResignedTransferCustomerResponse{}
@@ -237,7 +238,7 @@ func (r *Client) ResignedTransferResult(req *ResignedTransferResultRequest) (*Re
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(resignedTransferResultURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.ResignedTransferResult"), fmt.Sprintf(resignedTransferResultURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ResignedTransferResultResponse{}
@@ -268,7 +269,7 @@ func (r *Client) GroupChatTransfer(req *GroupChatTransferRequest) (*GroupChatTra
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(groupChatTransferURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "externalcontact.GroupChatTransfer"), fmt.Sprintf(groupChatTransferURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GroupChatTransferResponse{}
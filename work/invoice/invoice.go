@@ -1,6 +1,7 @@
 package invoice
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -82,7 +83,7 @@ func (r *Client) GetInvoiceInfo(req *GetInvoiceInfoRequest) (*GetInvoiceInfoResp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getInvoiceInfoURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "invoice.GetInvoiceInfo"), fmt.Sprintf(getInvoiceInfoURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetInvoiceInfoResponse{}
@@ -108,7 +109,7 @@ func (r *Client) UpdateInvoiceStatus(req *UpdateInvoiceStatusRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(updateInvoiceStatusURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "invoice.UpdateInvoiceStatus"), fmt.Sprintf(updateInvoiceStatusURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "UpdateInvoiceStatus")
@@ -138,7 +139,7 @@ func (r *Client) UpdateStatusBatch(req *UpdateStatusBatchRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(updateStatusBatchURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "invoice.UpdateStatusBatch"), fmt.Sprintf(updateStatusBatchURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "UpdateStatusBatch")
@@ -178,7 +179,7 @@ func (r *Client) GetInvoiceInfoBatch(req *GetInvoiceInfoBatchRequest) (*GetInvoi
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getInvoiceInfoBatchURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "invoice.GetInvoiceInfoBatch"), fmt.Sprintf(getInvoiceInfoBatchURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetInvoiceInfoBatchResponse{}
@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -42,14 +43,14 @@ func (r *Client) AccountAdd(options AccountAddOptions) (info AccountAddSchema, e
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(accountAddAddr, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.AccountAdd"), fmt.Sprintf(accountAddAddr, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -69,14 +70,14 @@ func (r *Client) AccountDel(options AccountDelOptions) (info util.CommonError, e
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(accountDelAddr, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.AccountDel"), fmt.Sprintf(accountDelAddr, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -98,14 +99,14 @@ func (r *Client) AccountUpdate(options AccountUpdateOptions) (info util.CommonEr
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(accountUpdateAddr, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.AccountUpdate"), fmt.Sprintf(accountUpdateAddr, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -133,14 +134,14 @@ func (r *Client) AccountList() (info AccountListSchema, err error) {
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.HTTPGet(fmt.Sprintf(accountListAddr, accessToken)); err != nil {
+	if data, err = util.HTTPGetContext(util.WithOperation(context.Background(), "kf.AccountList"), fmt.Sprintf(accountListAddr, accessToken)); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -162,7 +163,7 @@ func (r *Client) AccountPaging(req *AccountPagingRequest) (*AccountListSchema, e
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(accountListAddr, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.AccountPaging"), fmt.Sprintf(accountListAddr, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AccountListSchema{}
@@ -196,14 +197,14 @@ func (r *Client) AddContactWay(options AddContactWayOptions) (info AddContactWay
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(addContactWayAddr, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.AddContactWay"), fmt.Sprintf(addContactWayAddr, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -47,7 +48,7 @@ func (r *Client) AddKnowledgeGroup(req *AddKnowledgeGroupRequest) (*AddKnowledge
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addKnowledgeGroupURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.AddKnowledgeGroup"), fmt.Sprintf(addKnowledgeGroupURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddKnowledgeGroupResponse{}
@@ -71,7 +72,7 @@ func (r *Client) DelKnowledgeGroup(req *DelKnowledgeGroupRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delKnowledgeGroupURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.DelKnowledgeGroup"), fmt.Sprintf(delKnowledgeGroupURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DelKnowledgeGroup")
@@ -94,7 +95,7 @@ func (r *Client) ModKnowledgeGroup(req *ModKnowledgeGroupRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(modKnowledgeGroupURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.ModKnowledgeGroup"), fmt.Sprintf(modKnowledgeGroupURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "ModKnowledgeGroup")
@@ -133,7 +134,7 @@ func (r *Client) ListKnowledgeGroup(req *ListKnowledgeGroupRequest) (*ListKnowle
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(listKnowledgeGroupURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.ListKnowledgeGroup"), fmt.Sprintf(listKnowledgeGroupURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ListKnowledgeGroupResponse{}
@@ -227,7 +228,7 @@ func (r *Client) AddKnowledgeIntent(req *AddKnowledgeIntentRequest) (*AddKnowled
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(addKnowledgeIntentURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.AddKnowledgeIntent"), fmt.Sprintf(addKnowledgeIntentURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddKnowledgeIntentResponse{}
@@ -251,7 +252,7 @@ func (r *Client) DelKnowledgeIntent(req *DelKnowledgeIntentRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(delKnowledgeIntentURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.DelKnowledgeIntent"), fmt.Sprintf(delKnowledgeIntentURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "DelKnowledgeIntent")
@@ -276,7 +277,7 @@ func (r *Client) ModKnowledgeIntent(req *ModKnowledgeIntentRequest) error {
 		return err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(modKnowledgeIntentURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.ModKnowledgeIntent"), fmt.Sprintf(modKnowledgeIntentURL, accessToken), req); err != nil {
 		return err
 	}
 	return util.DecodeWithCommonError(response, "ModKnowledgeIntent")
@@ -350,7 +351,7 @@ func (r *Client) ListKnowledgeIntent(req *ListKnowledgeIntentRequest) (*ListKnow
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(listKnowledgeIntentURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.ListKnowledgeIntent"), fmt.Sprintf(listKnowledgeIntentURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ListKnowledgeIntentResponse{}
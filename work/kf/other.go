@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,14 +35,14 @@ func (r *Client) GetCorpQualification() (info CorpQualificationSchema, err error
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.HTTPGet(fmt.Sprintf(corpQualification, accessToken)); err != nil {
+	if data, err = util.HTTPGetContext(util.WithOperation(context.Background(), "kf.GetCorpQualification"), fmt.Sprintf(corpQualification, accessToken)); err != nil {
 		return info, err
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -34,14 +35,14 @@ func (r *Client) SendMsg(options interface{}) (info SendMsgSchema, err error) {
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(sendMsgAddr, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.SendMsg"), fmt.Sprintf(sendMsgAddr, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -43,14 +44,14 @@ func (r *Client) ReceptionistAdd(options ReceptionistOptions) (info Receptionist
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(receptionistAddAddr, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.ReceptionistAdd"), fmt.Sprintf(receptionistAddAddr, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return
 }
@@ -66,7 +67,7 @@ func (r *Client) ReceptionistDel(options ReceptionistOptions) (info Receptionist
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(fmt.Sprintf(receptionistDelAddr, accessToken), options)
+	data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.ReceptionistDel"), fmt.Sprintf(receptionistDelAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -74,7 +75,7 @@ func (r *Client) ReceptionistDel(options ReceptionistOptions) (info Receptionist
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return
 }
@@ -101,7 +102,7 @@ func (r *Client) ReceptionistList(kfID string) (info ReceptionistListSchema, err
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(fmt.Sprintf(receptionistListAddr, accessToken, kfID))
+	data, err = util.HTTPGetContext(util.WithOperation(context.Background(), "kf.ReceptionistList"), fmt.Sprintf(receptionistListAddr, accessToken, kfID))
 	if err != nil {
 		return
 	}
@@ -109,7 +110,7 @@ func (r *Client) ReceptionistList(kfID string) (info ReceptionistListSchema, err
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return
 }
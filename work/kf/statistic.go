@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -55,7 +56,7 @@ func (r *Client) GetCorpStatistic(req *GetCorpStatisticRequest) (*GetCorpStatist
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getCorpStatisticURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.GetCorpStatistic"), fmt.Sprintf(getCorpStatisticURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetCorpStatisticResponse{}
@@ -114,7 +115,7 @@ func (r *Client) GetServicerStatistic(req *GetServicerStatisticRequest) (*GetSer
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getServicerStatisticURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.GetServicerStatistic"), fmt.Sprintf(getServicerStatisticURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetServicerStatisticResponse{}
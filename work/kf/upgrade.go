@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -37,14 +38,14 @@ func (r *Client) UpgradeServiceConfig() (info UpgradeServiceConfigSchema, err er
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.HTTPGet(fmt.Sprintf(upgradeServiceConfigAddr, accessToken)); err != nil {
+	if data, err = util.HTTPGetContext(util.WithOperation(context.Background(), "kf.UpgradeServiceConfig"), fmt.Sprintf(upgradeServiceConfigAddr, accessToken)); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -73,14 +74,14 @@ func (r *Client) UpgradeService(options UpgradeServiceOptions) (info util.Common
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(upgradeService, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.UpgradeService"), fmt.Sprintf(upgradeService, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -105,14 +106,14 @@ func (r *Client) UpgradeMemberService(options UpgradeMemberServiceOptions) (info
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(upgradeService, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.UpgradeMemberService"), fmt.Sprintf(upgradeService, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -138,7 +139,7 @@ func (r *Client) UpgradeGroupChatService(options UpgradeServiceGroupChatOptions)
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(fmt.Sprintf(upgradeService, accessToken), options)
+	data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.UpgradeGroupChatService"), fmt.Sprintf(upgradeService, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -146,7 +147,7 @@ func (r *Client) UpgradeGroupChatService(options UpgradeServiceGroupChatOptions)
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
@@ -166,14 +167,14 @@ func (r *Client) UpgradeServiceCancel(options UpgradeServiceCancelOptions) (info
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	if data, err = util.PostJSON(fmt.Sprintf(upgradeServiceCancel, accessToken), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "kf.UpgradeServiceCancel"), fmt.Sprintf(upgradeServiceCancel, accessToken), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewSDKErr(info.ErrCode.Int64(), info.ErrMsg)
 	}
 	return info, nil
 }
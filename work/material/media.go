@@ -1,6 +1,7 @@
 package material
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -186,15 +187,16 @@ func (r *Client) GetTempFile(mediaID string) ([]byte, error) {
 		return nil, err
 	}
 	url := fmt.Sprintf(getTempFile, accessToken, mediaID)
-	response, err := util.HTTPGet(url)
+	response, contentType, err := util.HTTPGetBytesContext(util.WithOperation(context.Background(), "material.GetTempFile"), url)
 	if err != nil {
 		return nil, err
 	}
 
 	// 检查响应是否为错误信息
-	err = util.DecodeWithCommonError(response, "GetTempFile")
-	if err != nil {
-		return nil, err
+	if util.IsJSONResponse(contentType, response) {
+		if err = util.DecodeWithCommonError(response, "GetTempFile"); err != nil {
+			return nil, err
+		}
 	}
 
 	// 如果不是错误响应，则返回原始数据
@@ -1,6 +1,7 @@
 package oauth
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -87,7 +88,7 @@ func (ctr *Oauth) UserFromCode(code string) (result ResUserInfo, err error) {
 		return
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(oauthUserInfoURL, accessToken, code)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "oauth.UserFromCode"), fmt.Sprintf(oauthUserInfoURL, accessToken, code)); err != nil {
 		return
 	}
 	err = json.Unmarshal(response, &result)
@@ -119,7 +120,7 @@ func (ctr *Oauth) GetUserInfo(code string) (*GetUserInfoResponse, error) {
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.HTTPGet(fmt.Sprintf(getUserInfoURL, accessToken, code)); err != nil {
+	if response, err = util.HTTPGetContext(util.WithOperation(context2.Background(), "oauth.GetUserInfo"), fmt.Sprintf(getUserInfoURL, accessToken, code)); err != nil {
 		return nil, err
 	}
 	result := &GetUserInfoResponse{}
@@ -156,7 +157,7 @@ func (ctr *Oauth) GetUserDetail(req *GetUserDetailRequest) (*GetUserDetailRespon
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(getUserDetailURL, accessToken), req); err != nil {
+	if response, err = util.PostJSONContext(util.WithOperation(context2.Background(), "oauth.GetUserDetail"), fmt.Sprintf(getUserDetailURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetUserDetailResponse{}
@@ -1,6 +1,7 @@
 package robot
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -16,7 +17,7 @@ const (
 // @see https://developer.work.weixin.qq.com/document/path/91770
 func (r *Client) RobotBroadcast(webhookKey string, options interface{}) (info util.CommonError, err error) {
 	var data []byte
-	if data, err = util.PostJSON(fmt.Sprintf(webhookSendURL, webhookKey), options); err != nil {
+	if data, err = util.PostJSONContext(util.WithOperation(context.Background(), "robot.RobotBroadcast"), fmt.Sprintf(webhookSendURL, webhookKey), options); err != nil {
 		return
 	}
 	if err = json.Unmarshal(data, &info); err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/silenceper/wechat/v2/work/checkin"
 	"github.com/silenceper/wechat/v2/work/config"
 	"github.com/silenceper/wechat/v2/work/context"
+	"github.com/silenceper/wechat/v2/work/crypto"
 	"github.com/silenceper/wechat/v2/work/externalcontact"
 	"github.com/silenceper/wechat/v2/work/invoice"
 	"github.com/silenceper/wechat/v2/work/jsapi"
@@ -53,6 +54,11 @@ func (wk *Work) GetKF() (*kf.Client, error) {
 	return kf.NewClient(wk.ctx.Config)
 }
 
+// GetMsgCrypt 回调消息加解密，使用配置中的 Token、EncodingAESKey，ReceiveID 为 CorpID
+func (wk *Work) GetMsgCrypt() *crypto.MsgCrypt {
+	return crypto.NewMsgCrypt(wk.ctx.Token, wk.ctx.EncodingAESKey, wk.ctx.CorpID)
+}
+
 // JsSdk get JsSdk
 func (wk *Work) JsSdk() *jsapi.Js {
 	return jsapi.NewJs(wk.ctx)